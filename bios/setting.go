@@ -0,0 +1,9 @@
+package bios
+
+// Setting BIOS配置参数
+type Setting struct {
+	// Items BIOS配置项，key为配置项名称，value为期望的配置项取值。
+	Items map[string]string `json:"items"`
+	// BootOrder 启动顺序，按优先级由高到低排列的启动设备。
+	BootOrder []string `json:"boot_order"`
+}
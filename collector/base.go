@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/licairong/cloudboot-provider-framework/collector/smbios"
 	"github.com/licairong/cloudboot-provider-framework/util"
 	strutil "github.com/licairong/cloudboot-provider-framework/util/strings"
 )
@@ -101,6 +102,19 @@ func readFileIgnoreErr(filename string) []byte {
 // # Stick PC (36)
 func chassis() (chassisType string, height int) {
 	chassisType = UnknownServer
+	// 优先解析SMBIOS表，表不可用时使用dmidecode。
+	if tbl, err := smbios.Open(smbios.DefaultRoot); err == nil {
+		if c := tbl.Chassis(); c != nil {
+			switch c.Type {
+			case smbios.ChassisRackMount:
+				chassisType = RackServer
+			case smbios.ChassisBlade:
+				chassisType = BladeServer
+			}
+			return chassisType, c.Height
+		}
+	}
+
 	// dmidecode -t chassis
 	if output, _ := executor.Exec(nil, "dmidecode", "-t", "chassis"); output != nil {
 		scanner := bufio.NewScanner(bytes.NewReader(output))
@@ -0,0 +1,501 @@
+package bootos
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/oob/ipmi"
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+var _ collector.Collector = (*bootos)(nil)
+
+func init() {
+	collector.Register(name, New())
+}
+
+const (
+	// name 采集器名称
+	name = collector.DefaultCollector
+)
+
+// bootos 基于BootOS中sysfs、procfs及dmidecode、lspci、ethtool等工具的采集器。
+// DMI相关信息优先解析sysfs导出的SMBIOS表，表不可用时使用dmidecode。
+type bootos struct {
+	opts     *collector.Options
+	log      util.Logger
+	executor util.Executor
+}
+
+// New 返回采集器实例
+func New(setters ...func(*collector.Options)) collector.Collector {
+	var opts collector.Options
+	for i := range setters {
+		setters[i](&opts)
+	}
+	if opts.Root == "" {
+		opts.Root = "/"
+	}
+	if opts.Executor == nil {
+		opts.Executor = util.NewBash() // 默认的执行器实现
+		opts.Executor.SetLog(opts.Log)
+	}
+	return &bootos{
+		opts:     &opts,
+		log:      opts.Log,
+		executor: opts.Executor,
+	}
+}
+
+// path 返回根目录下的系统文件路径
+func (c *bootos) path(elem ...string) string {
+	return filepath.Join(append([]string{c.opts.Root}, elem...)...)
+}
+
+// readFile 读取根目录下的系统文件并去除首尾空白，读取失败时返回空字符串。
+func (c *bootos) readFile(elem ...string) string {
+	data, _ := ioutil.ReadFile(c.path(elem...))
+	return string(bytes.TrimSpace(data))
+}
+
+// exec 执行命令
+func (c *bootos) exec(cmd string, args ...string) ([]byte, error) {
+	return c.executor.Exec(nil, cmd, args...)
+}
+
+// Destroy 资源回收并销毁采集器
+func (c *bootos) Destroy() error {
+	return nil
+}
+
+// SetLog 更换日志实现
+func (c *bootos) SetLog(log util.Logger) {
+	c.log = log
+	c.executor.SetLog(log)
+}
+
+// BASE 采集并返回当前设备基本信息
+func (c *bootos) BASE() (*collector.Base, error) {
+	return collector.BASE()
+}
+
+// CPU 采集并返回当前设备的CPU信息
+func (c *bootos) CPU() (*collector.CPU, error) {
+	var cpu collector.CPU
+	if tbl, err := c.smbiosTable(); err == nil {
+		cpu.Items = processorsFromSMBIOS(tbl)
+	} else {
+		output, err := c.exec("dmidecode", "-t", "processor", "-t", "cache")
+		if err != nil {
+			return nil, err
+		}
+		cpu.Items = parseProcessors(output)
+	}
+	info := parseCPUInfo([]byte(c.readFile("proc", "cpuinfo")))
+	for _, p := range cpu.Items {
+		cpu.TotalPhysicals++
+		cpu.TotalCores += p.Cores
+		cpu.TotalThreads += p.Threads
+		if info.Flags != nil {
+			p.Flags = info.Flags
+		}
+	}
+	if info.Threads > 0 {
+		cpu.TotalThreads = info.Threads
+	}
+	return &cpu, nil
+}
+
+// Memory 采集并返回当前设备内存信息
+func (c *bootos) Memory() (*collector.Memory, error) {
+	if tbl, err := c.smbiosTable(); err == nil {
+		return memoryFromSMBIOS(tbl), nil
+	}
+	output, err := c.exec("dmidecode", "-t", "memory")
+	if err != nil {
+		return nil, err
+	}
+	return parseMemory(output), nil
+}
+
+// Motherboard 采集并返回当前设备的主板信息
+func (c *bootos) Motherboard() (*collector.Motherboard, error) {
+	if tbl, err := c.smbiosTable(); err == nil {
+		return motherboardFromSMBIOS(tbl), nil
+	}
+	output, err := c.exec("dmidecode", "-t", "baseboard")
+	if err != nil {
+		return nil, err
+	}
+	return parseMotherboard(output), nil
+}
+
+// blockDevices 返回磁盘列表
+func (c *bootos) blockDevices() ([]blockDevice, error) {
+	output, err := c.exec("lsblk", "-d", "-b", "-n", "-P", "-o", "NAME,TYPE,SIZE,MODEL,SERIAL,WWN,TRAN,ROTA,VENDOR,REV")
+	if err != nil {
+		return nil, err
+	}
+	var disks []blockDevice
+	for _, dev := range parseLsblk(output) {
+		if dev.isDisk() {
+			disks = append(disks, dev)
+		}
+	}
+	return disks, nil
+}
+
+// byPathNames 返回磁盘名称与by-path持久化名称的映射
+func (c *bootos) byPathNames() map[string]string {
+	names := make(map[string]string)
+	dir := c.path("dev", "disk", "by-path")
+	files, _ := ioutil.ReadDir(dir)
+	for _, f := range files {
+		target, err := os.Readlink(filepath.Join(dir, f.Name()))
+		if err != nil {
+			continue
+		}
+		names[filepath.Base(target)] = f.Name()
+	}
+	return names
+}
+
+// LogicalDisk 采集并返回当前设备的逻辑磁盘信息
+func (c *bootos) LogicalDisk() (*collector.LogicalDisk, error) {
+	disks, err := c.blockDevices()
+	if err != nil {
+		return nil, err
+	}
+	byPath := c.byPathNames()
+
+	var ld collector.LogicalDisk
+	for _, dev := range disks {
+		ld.TotalSize += dev.size()
+		ld.Items = append(ld.Items, &collector.LogicalDrive{
+			Name:       "/dev/" + dev["NAME"],
+			ByPathName: byPath[dev["NAME"]],
+			Size:       formatDiskSize(dev.size()),
+		})
+	}
+	return &ld, nil
+}
+
+// PhysicalDisk 采集并返回操作系统可见的物理磁盘信息。位于RAID控制器之后的物理磁盘需由RAID插件采集。
+func (c *bootos) PhysicalDisk(uri, user_name, password string) (*collector.PhysicalDisk, error) {
+	disks, err := c.blockDevices()
+	if err != nil {
+		return nil, err
+	}
+	var pd collector.PhysicalDisk
+	for _, dev := range disks {
+		if dev["TRAN"] == "" {
+			// RAID控制器上的虚拟磁盘
+			continue
+		}
+		pd.TotalSize += dev.size()
+		pd.Items = append(pd.Items, dev.toPhysicalDrive())
+	}
+	return &pd, nil
+}
+
+// pciDevices 返回PCI设备列表
+func (c *bootos) pciDevices() ([]*pciDevice, error) {
+	output, err := c.exec("lspci", "-vmm", "-D")
+	if err != nil {
+		return nil, err
+	}
+	return parseLspci(output), nil
+}
+
+// NIC 采集并返回当前设备的物理网卡信息
+func (c *bootos) NIC() (*collector.NIC, error) {
+	dir := c.path("sys", "class", "net")
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	devices, _ := c.pciDevices()
+	slots, _ := c.slots()
+	lldp, _ := c.LLDP()
+
+	var nic collector.NIC
+	for _, f := range files {
+		iface := f.Name()
+		target, err := os.Readlink(filepath.Join(dir, iface, "device"))
+		if err != nil {
+			// 虚拟网卡
+			continue
+		}
+		port := collector.NICPort{
+			Location:   iface,
+			MAC:        c.readFile("sys", "class", "net", iface, "address"),
+			BusAddress: filepath.Base(target),
+			Link:       "no",
+			Type:       "Ethernet",
+		}
+		if c.readFile("sys", "class", "net", iface, "carrier") == "1" {
+			port.Link = "yes"
+		}
+		if c.readFile("sys", "class", "net", iface, "type") == "32" {
+			port.Type = "InfiniBand"
+		}
+		if speed, _ := strconv.Atoi(c.readFile("sys", "class", "net", iface, "speed")); speed > 0 {
+			port.Speed = strconv.Itoa(speed) + "Mb/s"
+		}
+		if i := strings.LastIndex(port.BusAddress, "."); i >= 0 {
+			fn, _ := strconv.Atoi(port.BusAddress[i+1:])
+			port.Port = fn + 1
+		}
+		for _, slot := range slots {
+			if slot.BusAddress != "" && busPrefix(slot.BusAddress) == busPrefix(port.BusAddress) {
+				port.PCISlot = slot.ID
+			}
+		}
+		if dev := findPCIDevice(devices, port.BusAddress); dev != nil {
+			port.Manufacturer, port.Model = dev.Vendor, dev.Device
+		}
+		if output, err := c.exec("ethtool", "-i", iface); err == nil {
+			port.FirmwareVersion = parseEthtoolInfo(output).FirmwareVersion
+		}
+		if output, err := c.exec("ip", "-o", "-4", "addr", "show", "dev", iface); err == nil {
+			port.IP = parseIPv4(output)
+		}
+		if lldp != nil {
+			port.SwitchRef = switchRef(*lldp, iface, port.MAC)
+		}
+		nic.Items = append(nic.Items, &port)
+	}
+	return &nic, nil
+}
+
+// busPrefix 返回PCI总线地址中除功能号外的部分，如"0000:01:00.1"返回"0000:01:00"。
+func busPrefix(addr string) string {
+	if i := strings.LastIndex(addr, "."); i >= 0 {
+		return addr[:i]
+	}
+	return addr
+}
+
+// HBA 采集并返回当前设备的HBA卡信息。若当前设备不包含HBA卡，则返回值都为nil。
+func (c *bootos) HBA() (*collector.HBA, error) {
+	dir := c.path("sys", "class", "fc_host")
+	files, err := ioutil.ReadDir(dir)
+	if err != nil || len(files) == 0 {
+		return nil, nil
+	}
+	var hba collector.HBA
+	for _, f := range files {
+		host := f.Name()
+		fw := c.readFile("sys", "class", "scsi_host", host, "fw_version")
+		if fw == "" {
+			fw = c.readFile("sys", "class", "scsi_host", host, "fwrev")
+		}
+		hba.Items = append(hba.Items, &collector.HBAPort{
+			Host:            host,
+			State:           c.readFile("sys", "class", "fc_host", host, "port_state"),
+			WWPN:            c.readFile("sys", "class", "fc_host", host, "port_name"),
+			WWNN:            c.readFile("sys", "class", "fc_host", host, "node_name"),
+			FirmwareVersion: fw,
+		})
+	}
+	return &hba, nil
+}
+
+// OOB 采集并返回当前设备的OOB信息。
+func (c *bootos) OOB() (*collector.OOB, error) {
+	w := ipmi.NewWorker(oob.WithExecutor(c.executor), oob.WithLog(c.log))
+	network, err := w.Network()
+	if err != nil {
+		return nil, err
+	}
+	info := collector.OOB{
+		Network: &collector.OOBNetwork{
+			IPSrc:   network.IPSrc,
+			IP:      network.IP,
+			MAC:     network.MAC,
+			Netmask: network.Netmask,
+			Gateway: network.Gateway,
+		},
+	}
+	if users, err := w.Users(); err == nil {
+		for _, u := range users {
+			user := collector.OOBUser{ID: u.ID, Name: u.Name}
+			if u.Access != nil {
+				user.PrivilegeLevel = u.Access.PrivilegeLevel
+			}
+			info.User = append(info.User, &user)
+		}
+	}
+	if bmc, err := w.BMC(); err == nil {
+		info.FirmwareVersion = bmc.FirmwareReversion
+	}
+	return &info, nil
+}
+
+// BIOS 采集并返回当前设备的BIOS信息。
+func (c *bootos) BIOS() (*collector.BIOS, error) {
+	if tbl, err := c.smbiosTable(); err == nil {
+		return biosFromSMBIOS(tbl), nil
+	}
+	output, err := c.exec("dmidecode", "-t", "bios")
+	if err != nil {
+		return nil, err
+	}
+	return parseBIOS(output), nil
+}
+
+// RAID 采集并返回当前设备的RAID控制器信息。
+func (c *bootos) RAID(uri, user_name, password string) (*collector.RAID, error) {
+	devices, err := c.pciDevices()
+	if err != nil {
+		return nil, err
+	}
+	return &collector.RAID{Items: parseRaidControllers(devices)}, nil
+}
+
+// slots 返回PCI插槽列表
+func (c *bootos) slots() ([]*collector.SystemSlot, error) {
+	if tbl, err := c.smbiosTable(); err == nil {
+		return slotsFromSMBIOS(tbl), nil
+	}
+	output, err := c.exec("dmidecode", "-t", "slot")
+	if err != nil {
+		return nil, err
+	}
+	return parseSlots(output), nil
+}
+
+// PCI 采集并返回当前设备的所有PCI插槽信息。
+func (c *bootos) PCI() (*collector.PCI, error) {
+	slots, err := c.slots()
+	if err != nil {
+		return nil, err
+	}
+	devices, _ := c.pciDevices()
+	for _, slot := range slots {
+		if slot.BusAddress == "" {
+			continue
+		}
+		if dev := findPCIDevice(devices, slot.BusAddress); dev != nil {
+			slot.PCIDevice = &collector.PCIDevice{
+				Name:         dev.SDevice,
+				Type:         dev.Class,
+				Manufacturer: dev.Vendor,
+				Model:        dev.Device,
+			}
+		}
+	}
+	return &collector.PCI{TotalSlots: len(slots), Items: slots}, nil
+}
+
+// Fan 采集并返回当前设备的所有风扇信息。
+func (c *bootos) Fan() (*collector.Fan, error) {
+	output, err := c.exec("ipmitool", "sdr", "type", "fan")
+	if err != nil {
+		return nil, err
+	}
+	return &collector.Fan{Items: parseFans(output)}, nil
+}
+
+// PowerSupply 采集并返回当前设备的电源信息。
+func (c *bootos) PowerSupply() (*collector.PowerSupply, error) {
+	if tbl, err := c.smbiosTable(); err == nil {
+		return &collector.PowerSupply{Items: powerSuppliesFromSMBIOS(tbl)}, nil
+	}
+	output, err := c.exec("dmidecode", "-t", "39")
+	if err != nil {
+		return nil, err
+	}
+	return &collector.PowerSupply{Items: parsePowerSupplies(output)}, nil
+}
+
+// LLDP 采集并返回当前设备LLDP信息。
+func (c *bootos) LLDP() (*collector.LLDP, error) {
+	output, err := c.exec("lldpctl", "-f", "keyvalue")
+	if err != nil {
+		return nil, err
+	}
+	lldp := parseLLDP(output)
+	return &lldp, nil
+}
+
+// IDRAC 采集并返回Dell iDRAC信息。BootOS采集器暂不支持。
+func (c *bootos) IDRAC() (*collector.IDRAC, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// ILO 采集并返回HP iLO信息。BootOS采集器暂不支持。
+func (c *bootos) ILO() (*collector.ILO, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// Backplane 采集并返回背板信息。BootOS采集器暂不支持。
+func (c *bootos) Backplane() (*collector.Backplane, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// EventLogs 采集并返回带外事件日志。
+func (c *bootos) EventLogs() ([]*oob.EventLog, error) {
+	return ipmi.NewWorker(oob.WithExecutor(c.executor), oob.WithLog(c.log)).EventLogs(0)
+}
+
+// Extra 执行采集脚本并返回采集到的信息。若执行采集脚本时发生错误，则丢弃该错误，继续执行后续脚本。
+func (c *bootos) Extra(scripts [][]byte) *collector.Extra {
+	extra := make(collector.Extra)
+	for _, script := range scripts {
+		item, err := c.runScript(script)
+		if err != nil {
+			if c.log != nil {
+				c.log.Error(err)
+			}
+			continue
+		}
+		for k, v := range item {
+			extra[k] = v
+		}
+	}
+	return &extra
+}
+
+// runScript 执行采集脚本并解析其输出的JSON Object
+func (c *bootos) runScript(script []byte) (map[string]interface{}, error) {
+	f, err := ioutil.TempFile("", "collect-*.script")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(script); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err = f.Close(); err != nil {
+		return nil, err
+	}
+	if err = os.Chmod(f.Name(), 0700); err != nil {
+		return nil, err
+	}
+	output, err := c.exec(f.Name())
+	if err != nil {
+		return nil, err
+	}
+	var item map[string]interface{}
+	if err = json.Unmarshal(output, &item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// Check 校验采集器可用性。BootOS采集器无需校验。
+func (c *bootos) Check() error {
+	return nil
+}
+
+// LogoutRedfish BootOS采集器未使用redfish，无需退出。
+func (c *bootos) LogoutRedfish() {}
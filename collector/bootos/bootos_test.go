@@ -0,0 +1,197 @@
+package bootos
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeExecutor 以testdata中的文件内容作为命令输出的执行器
+type fakeExecutor struct {
+	util.Executor
+	outputs map[string]string
+}
+
+func (e *fakeExecutor) Exec(opts *util.ExecutionOptions, cmd string, args ...string) ([]byte, error) {
+	cmdArgs := strings.Join(append([]string{cmd}, args...), " ")
+	file, ok := e.outputs[cmdArgs]
+	if !ok {
+		return nil, errors.New("exec error: command not found")
+	}
+	return readTestdata(file), nil
+}
+
+func (e *fakeExecutor) SetLog(log util.Logger) {}
+
+func newTestCollector() collector.Collector {
+	executor := &fakeExecutor{outputs: map[string]string{
+		"dmidecode -t processor -t cache": "dmidecode_processor_cache.txt",
+		"dmidecode -t slot":               "dmidecode_slot.txt",
+		"dmidecode -t 39":                 "dmidecode_39.txt",
+		"lspci -vmm -D":                   "lspci_vmm_D.txt",
+		"lsblk -d -b -n -P -o NAME,TYPE,SIZE,MODEL,SERIAL,WWN,TRAN,ROTA,VENDOR,REV": "lsblk.txt",
+		"ethtool -i eno1":             "ethtool_i_eno1.txt",
+		"ethtool -i ens1f1":           "ethtool_i_ens1f1.txt",
+		"ip -o -4 addr show dev eno1": "ip_addr_eno1.txt",
+		"lldpctl -f keyvalue":         "lldpctl_keyvalue.txt",
+	}}
+	return New(collector.WithRoot("./testdata/root"), collector.WithExecutor(executor))
+}
+
+func TestCollector(t *testing.T) {
+	Convey("BootOS采集器", t, func() {
+		c := newTestCollector()
+
+		Convey("CPU", func() {
+			cpu, err := c.CPU()
+			So(err, ShouldBeNil)
+			So(cpu.TotalPhysicals, ShouldEqual, 2)
+			So(cpu.TotalCores, ShouldEqual, 16)
+			So(cpu.TotalThreads, ShouldEqual, 4)
+			So(cpu.Items[0].Flags, ShouldContain, "avx2")
+		})
+
+		Convey("磁盘", func() {
+			ld, err := c.LogicalDisk()
+			So(err, ShouldBeNil)
+			So(len(ld.Items), ShouldEqual, 2)
+			So(ld.Items[0], ShouldResemble, &collector.LogicalDrive{
+				Name:       "/dev/sda",
+				ByPathName: "pci-0000:02:00.0-scsi-0:2:0:0",
+				Size:       "599.6 GB, 599550590976 bytes",
+			})
+			So(ld.TotalSize, ShouldEqual, 599550590976+480103981056)
+
+			pd, err := c.PhysicalDisk("", "", "")
+			So(err, ShouldBeNil)
+			So(len(pd.Items), ShouldEqual, 1)
+			So(pd.Items[0].Location, ShouldEqual, "/dev/sdb")
+			So(pd.TotalSize, ShouldEqual, 480103981056)
+		})
+
+		Convey("网卡", func() {
+			nic, err := c.NIC()
+			So(err, ShouldBeNil)
+			So(len(nic.Items), ShouldEqual, 2)
+
+			eno1 := nic.Items[0]
+			So(eno1.Location, ShouldEqual, "eno1")
+			So(eno1.MAC, ShouldEqual, "18:66:da:aa:bb:01")
+			So(eno1.IP, ShouldEqual, "10.0.106.27")
+			So(eno1.BusAddress, ShouldEqual, "0000:01:00.0")
+			So(eno1.Port, ShouldEqual, 1)
+			So(eno1.PCISlot, ShouldEqual, 0)
+			So(eno1.Speed, ShouldEqual, "1000Mb/s")
+			So(eno1.Link, ShouldEqual, "yes")
+			So(eno1.Manufacturer, ShouldEqual, "Broadcom Inc. and subsidiaries")
+			So(eno1.FirmwareVersion, ShouldEqual, "FFV20.6.52 bc 5720-v1.39")
+			So(eno1.SwitchRef.Name, ShouldEqual, "sw-core-01")
+
+			ens1f1 := nic.Items[1]
+			So(ens1f1.Port, ShouldEqual, 2)
+			So(ens1f1.PCISlot, ShouldEqual, 1)
+			So(ens1f1.Speed, ShouldBeEmpty)
+			So(ens1f1.Link, ShouldEqual, "no")
+			So(ens1f1.IP, ShouldBeEmpty)
+			So(ens1f1.SwitchRef, ShouldBeNil)
+		})
+
+		Convey("HBA", func() {
+			hba, err := c.HBA()
+			So(err, ShouldBeNil)
+			So(hba.Items, ShouldResemble, []*collector.HBAPort{{
+				Host:            "host11",
+				State:           "Online",
+				WWPN:            "0x21000024ff3dd9a4",
+				WWNN:            "0x20000024ff3dd9a4",
+				FirmwareVersion: "8.07.00 (d0d5)",
+			}})
+		})
+
+		Convey("PCI插槽", func() {
+			pci, err := c.PCI()
+			So(err, ShouldBeNil)
+			So(pci.TotalSlots, ShouldEqual, 2)
+			So(pci.Items[0].PCIDevice.Name, ShouldEqual, "Ethernet Converged Network Adapter X710-2")
+			So(pci.Items[1].PCIDevice, ShouldBeNil)
+		})
+
+		Convey("RAID控制器", func() {
+			raid, err := c.RAID("", "", "")
+			So(err, ShouldBeNil)
+			So(len(raid.Items), ShouldEqual, 1)
+		})
+
+		Convey("采集命令执行失败", func() {
+			_, err := c.Memory()
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("暂不支持", func() {
+			_, err := c.IDRAC()
+			So(err, ShouldEqual, collector.ErrNotSupported)
+		})
+	})
+}
+
+func TestSMBIOS(t *testing.T) {
+	Convey("优先解析SMBIOS表", t, func() {
+		c := New(collector.WithRoot("./testdata/smbios"), collector.WithExecutor(&fakeExecutor{}))
+
+		Convey("CPU", func() {
+			cpu, err := c.CPU()
+			So(err, ShouldBeNil)
+			So(cpu.TotalPhysicals, ShouldEqual, 2)
+			So(cpu.TotalCores, ShouldEqual, 16)
+			So(cpu.TotalThreads, ShouldEqual, 32)
+
+			expected := parseProcessors(readTestdata("dmidecode_processor_cache.txt"))
+			expected[0].Flags = nil
+			So(cpu.Items[0], ShouldResemble, expected[0])
+		})
+
+		Convey("内存", func() {
+			mem, err := c.Memory()
+			So(err, ShouldBeNil)
+			So(mem.NumberOfDevices, ShouldEqual, 3)
+			So(mem.NumberOfUsedDevices, ShouldEqual, 2)
+			So(mem.MaximumSize, ShouldEqual, 1536*1024*1024*1024)
+			So(mem.Items[0], ShouldResemble, parseMemory(readTestdata("dmidecode_memory.txt")).Items[0])
+		})
+
+		Convey("主板", func() {
+			board, err := c.Motherboard()
+			So(err, ShouldBeNil)
+			expected := parseMotherboard(readTestdata("dmidecode_baseboard.txt"))
+			So(board.SerialNumber, ShouldEqual, expected.SerialNumber)
+			So(board.OnboardDevices[0], ShouldResemble, expected.OnboardDevices[0])
+			So(board.OnboardDevices[1].Status, ShouldEqual, "Disabled")
+		})
+
+		Convey("BIOS", func() {
+			bios, err := c.BIOS()
+			So(err, ShouldBeNil)
+			So(bios.Manufacturer, ShouldEqual, "Dell Inc.")
+			So(bios.FirmwareVersion, ShouldEqual, "2.8.0")
+			So(bios.Characteristics, ShouldContain, "UEFI is supported")
+		})
+
+		Convey("PCI插槽", func() {
+			slots, err := c.(*bootos).slots()
+			So(err, ShouldBeNil)
+			So(len(slots), ShouldEqual, 2)
+			So(slots[0], ShouldResemble, parseSlots(readTestdata("dmidecode_slot.txt"))[0])
+			So(slots[1].BusAddress, ShouldBeEmpty)
+		})
+
+		Convey("电源", func() {
+			psu, err := c.PowerSupply()
+			So(err, ShouldBeNil)
+			So(psu.Items, ShouldResemble, parsePowerSupplies(readTestdata("dmidecode_39.txt")))
+		})
+	})
+}
@@ -0,0 +1,270 @@
+package bootos
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	byteutil "github.com/licairong/cloudboot-provider-framework/util/bytes"
+)
+
+// DMI类型
+const (
+	dmiBIOS             = 0
+	dmiBaseboard        = 2
+	dmiProcessor        = 4
+	dmiCache            = 7
+	dmiSystemSlot       = 9
+	dmiMemoryArray      = 16
+	dmiMemoryDevice     = 17
+	dmiPowerSupply      = 39
+	dmiOnboardDeviceExt = 41
+)
+
+// dmiSection dmidecode输出中的单个结构
+type dmiSection struct {
+	Handle string
+	Type   int
+	Title  string
+	Props  map[string]string   // 单值属性
+	Lists  map[string][]string // 多值属性，如Characteristics、Flags。
+}
+
+// get 返回属性值。dmidecode中表示未知或未填写的值均返回空字符串。
+func (s *dmiSection) get(key string) string {
+	return dmiValue(s.Props[key])
+}
+
+// dmiValue 将表示未知或未填写的值转换为空字符串
+func dmiValue(v string) string {
+	switch v {
+	case "Not Specified", "Not Provided", "Unknown", "None", "To Be Filled By O.E.M.", "Not Present", "<OUT OF SPEC>":
+		return ""
+	default:
+		return v
+	}
+}
+
+// getInt 返回整型属性值
+func (s *dmiSection) getInt(key string) int {
+	n, _ := strconv.Atoi(s.get(key))
+	return n
+}
+
+// parseDMI 解析dmidecode输出
+func parseDMI(output []byte) (sections []*dmiSection) {
+	var cur *dmiSection
+	var listKey string
+
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "Handle "):
+			// Handle 0x0400, DMI type 4, 42 bytes
+			cur = &dmiSection{Props: make(map[string]string), Lists: make(map[string][]string)}
+			fields := strings.Split(line, ",")
+			cur.Handle = strings.TrimPrefix(fields[0], "Handle ")
+			if len(fields) > 1 {
+				cur.Type, _ = strconv.Atoi(strings.TrimPrefix(strings.TrimSpace(fields[1]), "DMI type "))
+			}
+			sections = append(sections, cur)
+			listKey = ""
+		case cur == nil || strings.TrimSpace(line) == "":
+			continue
+		case strings.HasPrefix(line, "\t\t"):
+			if listKey != "" {
+				cur.Lists[listKey] = append(cur.Lists[listKey], strings.TrimSpace(line))
+			}
+		case strings.HasPrefix(line, "\t"):
+			kv := strings.SplitN(strings.TrimSpace(line), ":", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+			if value == "" {
+				listKey = key
+				continue
+			}
+			listKey = ""
+			cur.Props[key] = value
+		default:
+			cur.Title = strings.TrimSpace(line)
+		}
+	}
+	return sections
+}
+
+// filterDMI 返回指定类型的结构
+func filterDMI(sections []*dmiSection, typ int) (items []*dmiSection) {
+	for _, s := range sections {
+		if s.Type == typ {
+			items = append(items, s)
+		}
+	}
+	return items
+}
+
+// parseSize 解析"16384 MB"、"16 GB"、"512 kB"等格式的容量，返回字节数。
+func parseSize(s string) int64 {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0
+	}
+	size, err := byteutil.Parse2Byte(fields[0], fields[1])
+	if err != nil {
+		return 0
+	}
+	return int64(size)
+}
+
+// parseProcessors 解析'dmidecode -t processor -t cache'的输出
+func parseProcessors(output []byte) []*collector.Processor {
+	sections := parseDMI(output)
+	caches := make(map[string]string)
+	for _, s := range filterDMI(sections, dmiCache) {
+		caches[s.Handle] = s.get("Installed Size")
+	}
+
+	var items []*collector.Processor
+	for _, s := range filterDMI(sections, dmiProcessor) {
+		if !strings.HasPrefix(s.get("Status"), "Populated") {
+			continue
+		}
+		items = append(items, &collector.Processor{
+			SocketDesignation: s.get("Socket Designation"),
+			Manufacturer:      s.get("Manufacturer"),
+			Family:            s.get("Family"),
+			Model:             s.get("Version"),
+			Type:              s.get("Type"),
+			MaxSpeed:          s.get("Max Speed"),
+			CurrentSpeed:      s.get("Current Speed"),
+			Cores:             s.getInt("Core Count"),
+			EnabledCores:      s.getInt("Core Enabled"),
+			Threads:           s.getInt("Thread Count"),
+			Voltage:           s.get("Voltage"),
+			Flags:             s.Lists["Flags"],
+			L1Cache:           caches[s.get("L1 Cache Handle")],
+			L2Cache:           caches[s.get("L2 Cache Handle")],
+			L3Cache:           caches[s.get("L3 Cache Handle")],
+		})
+	}
+	return items
+}
+
+// parseMemory 解析'dmidecode -t memory'的输出
+func parseMemory(output []byte) *collector.Memory {
+	sections := parseDMI(output)
+
+	var mem collector.Memory
+	for _, s := range filterDMI(sections, dmiMemoryArray) {
+		if s.get("Use") != "" && s.get("Use") != "System Memory" {
+			continue
+		}
+		mem.MaximumSize += parseSize(s.get("Maximum Capacity"))
+	}
+	for _, s := range filterDMI(sections, dmiMemoryDevice) {
+		mem.NumberOfDevices++
+		size := parseSize(s.get("Size"))
+		if size <= 0 {
+			continue
+		}
+		mem.NumberOfUsedDevices++
+		mem.TotalSize += size
+		mem.Items = append(mem.Items, &collector.MemoryDevice{
+			Location:          s.get("Locator"),
+			Size:              size,
+			Type:              s.get("Type"),
+			Speed:             s.get("Speed"),
+			Manufacturer:      s.get("Manufacturer"),
+			SerialNumber:      s.get("Serial Number"),
+			PartNumber:        s.get("Part Number"),
+			AssetTag:          s.get("Asset Tag"),
+			ConfiguredVoltage: strings.TrimSpace(strings.TrimSuffix(s.get("Configured Voltage"), "V")),
+		})
+	}
+	return &mem
+}
+
+// parseMotherboard 解析'dmidecode -t baseboard'的输出
+func parseMotherboard(output []byte) *collector.Motherboard {
+	sections := parseDMI(output)
+
+	var board collector.Motherboard
+	if items := filterDMI(sections, dmiBaseboard); len(items) > 0 {
+		board.Manufacturer = items[0].get("Manufacturer")
+		board.ProductName = items[0].get("Product Name")
+		board.SerialNumber = strings.Trim(items[0].get("Serial Number"), ".")
+		board.FirmwareVersion = items[0].get("Version")
+	}
+	for _, s := range filterDMI(sections, dmiOnboardDeviceExt) {
+		board.OnboardDevices = append(board.OnboardDevices, &collector.OnboardDevice{
+			ReferenceDesignation: s.get("Reference Designation"),
+			Type:                 s.get("Type"),
+			Status:               s.get("Status"),
+			BusAddress:           s.get("Bus Address"),
+		})
+	}
+	return &board
+}
+
+// parseBIOS 解析'dmidecode -t bios'的输出
+func parseBIOS(output []byte) *collector.BIOS {
+	items := filterDMI(parseDMI(output), dmiBIOS)
+	if len(items) == 0 {
+		return nil
+	}
+	return &collector.BIOS{
+		Manufacturer:    items[0].get("Vendor"),
+		FirmwareVersion: items[0].get("Version"),
+		ReleaseDate:     items[0].get("Release Date"),
+		Characteristics: items[0].Lists["Characteristics"],
+	}
+}
+
+// parseSlots 解析'dmidecode -t slot'的输出
+func parseSlots(output []byte) []*collector.SystemSlot {
+	var items []*collector.SystemSlot
+	for i, s := range filterDMI(parseDMI(output), dmiSystemSlot) {
+		id := s.getInt("ID")
+		if id == 0 {
+			id = i + 1
+		}
+		items = append(items, &collector.SystemSlot{
+			ID:           id,
+			Designation:  s.get("Designation"),
+			Type:         s.get("Type"),
+			CurrentUsage: s.get("Current Usage"),
+			Length:       s.get("Length"),
+			BusAddress:   s.get("Bus Address"),
+		})
+	}
+	return items
+}
+
+// parsePowerSupplies 解析'dmidecode -t 39'的输出
+func parsePowerSupplies(output []byte) []*collector.SystemPowerSupply {
+	var items []*collector.SystemPowerSupply
+	for _, s := range filterDMI(parseDMI(output), dmiPowerSupply) {
+		if strings.HasPrefix(s.Props["Status"], "Not Present") {
+			continue
+		}
+		items = append(items, &collector.SystemPowerSupply{
+			Location:         s.get("Location"),
+			Name:             s.get("Name"),
+			Manufacturer:     s.get("Manufacturer"),
+			SerialNumber:     s.get("Serial Number"),
+			PartNumber:       s.get("Model Part Number"),
+			AssetTag:         s.get("Asset Tag"),
+			FirmwareVersion:  s.get("Revision"),
+			Model:            s.get("Name"),
+			InputVoltage:     s.get("Input Voltage Range Switching"),
+			TotalOutputPower: s.get("Max Power Capacity"),
+			Plugged:          s.get("Plugged"),
+			HotReplaceable:   s.get("Hot Replaceable"),
+		})
+	}
+	return items
+}
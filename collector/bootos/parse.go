@@ -0,0 +1,290 @@
+package bootos
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+)
+
+// cpuInfo /proc/cpuinfo中的汇总信息
+type cpuInfo struct {
+	Threads int      // 逻辑处理器数量
+	Flags   []string // 指令集
+}
+
+// parseCPUInfo 解析/proc/cpuinfo的内容
+func parseCPUInfo(data []byte) (info cpuInfo) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		kv := strings.SplitN(scanner.Text(), ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch strings.TrimSpace(kv[0]) {
+		case "processor":
+			info.Threads++
+		case "flags", "Features":
+			if info.Flags == nil {
+				info.Flags = strings.Fields(kv[1])
+			}
+		}
+	}
+	return info
+}
+
+// pciDevice 'lspci -vmm -D'输出的单个PCI设备
+type pciDevice struct {
+	Slot    string
+	Class   string
+	Vendor  string
+	Device  string
+	SVendor string
+	SDevice string
+	Rev     string
+}
+
+// parseLspci 解析'lspci -vmm -D'的输出
+func parseLspci(output []byte) (devices []*pciDevice) {
+	var cur *pciDevice
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			cur = nil
+			continue
+		}
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		if cur == nil {
+			cur = new(pciDevice)
+			devices = append(devices, cur)
+		}
+		value := strings.TrimSpace(kv[1])
+		switch kv[0] {
+		case "Slot":
+			cur.Slot = value
+		case "Class":
+			cur.Class = value
+		case "Vendor":
+			cur.Vendor = value
+		case "Device":
+			cur.Device = value
+		case "SVendor":
+			cur.SVendor = value
+		case "SDevice":
+			cur.SDevice = value
+		case "Rev":
+			cur.Rev = value
+		}
+	}
+	return devices
+}
+
+// findPCIDevice 返回指定总线地址的PCI设备。总线地址可省略PCI域，如"01:00.0"。
+func findPCIDevice(devices []*pciDevice, addr string) *pciDevice {
+	addr = strings.ToLower(addr)
+	for _, dev := range devices {
+		slot := strings.ToLower(dev.Slot)
+		if slot == addr || strings.TrimPrefix(slot, "0000:") == strings.TrimPrefix(addr, "0000:") {
+			return dev
+		}
+	}
+	return nil
+}
+
+// raidControllerClasses 视为RAID控制器的PCI设备类型
+var raidControllerClasses = []string{"RAID bus controller", "Serial Attached SCSI controller"}
+
+// parseRaidControllers 从PCI设备中筛选RAID控制器。控制器固件版本等信息需由RAID插件借助厂商工具采集。
+func parseRaidControllers(devices []*pciDevice) []*collector.RaidController {
+	var items []*collector.RaidController
+	for _, dev := range devices {
+		for _, class := range raidControllerClasses {
+			if dev.Class != class {
+				continue
+			}
+			items = append(items, &collector.RaidController{
+				ID:           strconv.Itoa(len(items)),
+				Manufacturer: dev.Vendor,
+				Model:        dev.Device,
+				PCIAddress:   dev.Slot,
+			})
+		}
+	}
+	return items
+}
+
+// blockDevice 'lsblk -P'输出的单个块设备
+type blockDevice map[string]string
+
+var lsblkPair = regexp.MustCompile(`([A-Z:-]+)="([^"]*)"`)
+
+// parseLsblk 解析'lsblk -d -b -n -P -o NAME,TYPE,SIZE,MODEL,SERIAL,WWN,TRAN,ROTA,VENDOR,REV'的输出
+func parseLsblk(output []byte) (devices []blockDevice) {
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		pairs := lsblkPair.FindAllStringSubmatch(scanner.Text(), -1)
+		if len(pairs) == 0 {
+			continue
+		}
+		dev := make(blockDevice, len(pairs))
+		for _, pair := range pairs {
+			dev[pair[1]] = strings.TrimSpace(strings.Replace(pair[2], `\x20`, " ", -1))
+		}
+		devices = append(devices, dev)
+	}
+	return devices
+}
+
+// size 返回块设备容量（单位Byte）
+func (dev blockDevice) size() int64 {
+	n, _ := strconv.ParseInt(dev["SIZE"], 10, 64)
+	return n
+}
+
+// isDisk 返回是否是磁盘。光驱、U盘等设备不视为磁盘。
+func (dev blockDevice) isDisk() bool {
+	return dev["TYPE"] == "disk" && dev["TRAN"] != "usb"
+}
+
+// formatDiskSize 返回形如'599.6 GB, 599550590976 bytes'的磁盘容量
+func formatDiskSize(size int64) string {
+	return strconv.FormatFloat(float64(size)/1e9, 'f', 1, 64) + " GB, " + strconv.FormatInt(size, 10) + " bytes"
+}
+
+// toPhysicalDrive 转换为物理驱动器
+func (dev blockDevice) toPhysicalDrive() *collector.PhysicalDrive {
+	mediaType := "HDD"
+	if dev["ROTA"] == "0" {
+		mediaType = "SSD"
+	}
+	return &collector.PhysicalDrive{
+		Location:        "/dev/" + dev["NAME"],
+		Manufacturer:    dev["VENDOR"],
+		Model:           dev["MODEL"],
+		WWN:             dev["WWN"],
+		SerialNumber:    dev["SERIAL"],
+		BusType:         strings.ToUpper(dev["TRAN"]),
+		MediaType:       mediaType,
+		Size:            dev.size(),
+		FirmwareVersion: dev["REV"],
+	}
+}
+
+// ethtoolInfo 'ethtool -i'的输出
+type ethtoolInfo struct {
+	Driver          string
+	Version         string
+	FirmwareVersion string
+	BusInfo         string
+}
+
+// parseEthtoolInfo 解析'ethtool -i <iface>'的输出
+func parseEthtoolInfo(output []byte) (info ethtoolInfo) {
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		kv := strings.SplitN(scanner.Text(), ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		value := strings.TrimSpace(kv[1])
+		switch kv[0] {
+		case "driver":
+			info.Driver = value
+		case "version":
+			info.Version = value
+		case "firmware-version":
+			info.FirmwareVersion = value
+		case "bus-info":
+			info.BusInfo = value
+		}
+	}
+	return info
+}
+
+var inetAddr = regexp.MustCompile(`inet ([0-9.]+)/`)
+
+// parseIPv4 解析'ip -o -4 addr show dev <iface>'的输出，返回首个IPv4地址。
+func parseIPv4(output []byte) string {
+	if m := inetAddr.FindSubmatch(output); m != nil {
+		return string(m[1])
+	}
+	return ""
+}
+
+// parseFans 解析'ipmitool sdr type fan'的输出，忽略非转速类传感器（如风扇冗余状态）。
+func parseFans(output []byte) []*collector.FanItem {
+	var items []*collector.FanItem
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "|")
+		if len(fields) != 5 {
+			continue
+		}
+		reading := strings.TrimSpace(fields[4])
+		if !strings.HasSuffix(reading, "RPM") {
+			continue
+		}
+		items = append(items, &collector.FanItem{
+			Location: strings.TrimSpace(fields[0]),
+			Speed:    reading,
+		})
+	}
+	return items
+}
+
+// parseLLDP 解析'lldpctl -f keyvalue'的输出，按网口分组。
+func parseLLDP(output []byte) collector.LLDP {
+	lldp := make(collector.LLDP)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		kv := strings.SplitN(scanner.Text(), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		// lldp.eth0.chassis.name=switch
+		keys := strings.SplitN(kv[0], ".", 3)
+		if len(keys) != 3 || keys[0] != "lldp" {
+			continue
+		}
+		port, ok := lldp[keys[1]].(map[string]interface{})
+		if !ok {
+			port = make(map[string]interface{})
+			lldp[keys[1]] = port
+		}
+		port[keys[2]] = kv[1]
+	}
+	return lldp
+}
+
+// switchRef 返回LLDP采集到的网口所连接交换机信息
+func switchRef(lldp collector.LLDP, iface, mac string) *collector.SwitchRef {
+	port, ok := lldp[iface].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	get := func(key string) string {
+		s, _ := port[key].(string)
+		return s
+	}
+	return &collector.SwitchRef{
+		Name:          get("chassis.name"),
+		Mac:           get("chassis.mac"),
+		NICMac:        mac,
+		BridgeEnabled: get("chassis.Bridge.enabled"),
+		RouteEnabled:  get("chassis.Router.enabled"),
+		MgmtIP:        get("chassis.mgmt-ip"),
+		Descr:         get("chassis.descr"),
+		TTL:           get("port.ttl"),
+		PortIfName:    get("port.ifname"),
+		PortMFS:       get("port.mfs"),
+		Vlan:          get("vlan.vlan-id"),
+	}
+}
@@ -0,0 +1,196 @@
+package bootos
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func readTestdata(name string) []byte {
+	data, err := ioutil.ReadFile("./testdata/" + name)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func TestParseDMI(t *testing.T) {
+	Convey("解析dmidecode输出", t, func() {
+		Convey("CPU及缓存", func() {
+			items := parseProcessors(readTestdata("dmidecode_processor_cache.txt"))
+			So(len(items), ShouldEqual, 2)
+			So(items[0], ShouldResemble, &collector.Processor{
+				SocketDesignation: "CPU1",
+				Manufacturer:      "Intel",
+				Family:            "Xeon",
+				Model:             "Intel(R) Xeon(R) CPU E5-2620 v4 @ 2.10GHz",
+				Type:              "Central Processor",
+				MaxSpeed:          "4000 MHz",
+				CurrentSpeed:      "2100 MHz",
+				Cores:             8,
+				EnabledCores:      8,
+				Threads:           16,
+				Voltage:           "1.8 V",
+				Flags: []string{
+					"FPU (Floating-point unit on-chip)",
+					"VME (Virtual mode extension)",
+					"DE (Debugging extension)",
+				},
+				L1Cache: "512 kB",
+				L2Cache: "2048 kB",
+				L3Cache: "20480 kB",
+			})
+			So(items[1].SocketDesignation, ShouldEqual, "CPU2")
+			So(items[1].L3Cache, ShouldEqual, "20480 kB")
+		})
+
+		Convey("内存", func() {
+			mem := parseMemory(readTestdata("dmidecode_memory.txt"))
+			So(mem.NumberOfDevices, ShouldEqual, 3)
+			So(mem.NumberOfUsedDevices, ShouldEqual, 2)
+			So(mem.MaximumSize, ShouldEqual, 1536*1024*1024*1024)
+			So(mem.TotalSize, ShouldEqual, 32*1024*1024*1024)
+			So(mem.Items[0], ShouldResemble, &collector.MemoryDevice{
+				Location:          "A1",
+				Size:              16 * 1024 * 1024 * 1024,
+				Type:              "DDR4",
+				Speed:             "2400 MT/s",
+				Manufacturer:      "00CE00B300CE",
+				SerialNumber:      "35A6A8C2",
+				PartNumber:        "M393A2G40EB1-CRC",
+				AssetTag:          "01150363",
+				ConfiguredVoltage: "1.2",
+			})
+			So(mem.Items[1].AssetTag, ShouldBeEmpty)
+		})
+
+		Convey("主板", func() {
+			board := parseMotherboard(readTestdata("dmidecode_baseboard.txt"))
+			So(board.Manufacturer, ShouldEqual, "Dell Inc.")
+			So(board.ProductName, ShouldEqual, "072T6D")
+			So(board.SerialNumber, ShouldEqual, "CN7475164K0187")
+			So(board.FirmwareVersion, ShouldEqual, "A08")
+			So(len(board.OnboardDevices), ShouldEqual, 2)
+			So(board.OnboardDevices[1], ShouldResemble, &collector.OnboardDevice{
+				ReferenceDesignation: "Integrated RAID",
+				Type:                 "SAS Controller",
+				Status:               "Enabled",
+				BusAddress:           "0000:02:00.0",
+			})
+		})
+
+		Convey("BIOS", func() {
+			bios := parseBIOS(readTestdata("dmidecode_bios.txt"))
+			So(bios.Manufacturer, ShouldEqual, "Dell Inc.")
+			So(bios.FirmwareVersion, ShouldEqual, "2.8.0")
+			So(bios.ReleaseDate, ShouldEqual, "06/26/2018")
+			So(bios.Characteristics, ShouldContain, "UEFI is supported")
+			So(parseBIOS(nil), ShouldBeNil)
+		})
+
+		Convey("PCI插槽", func() {
+			slots := parseSlots(readTestdata("dmidecode_slot.txt"))
+			So(len(slots), ShouldEqual, 2)
+			So(slots[0], ShouldResemble, &collector.SystemSlot{
+				ID:           1,
+				Designation:  "PCIe Slot 1",
+				Type:         "x8 PCI Express 3 x16",
+				CurrentUsage: "In Use",
+				Length:       "Long",
+				BusAddress:   "0000:04:00.0",
+			})
+		})
+
+		Convey("电源", func() {
+			items := parsePowerSupplies(readTestdata("dmidecode_39.txt"))
+			So(len(items), ShouldEqual, 1)
+			So(items[0].Location, ShouldEqual, "PSU1")
+			So(items[0].PartNumber, ShouldEqual, "0Y9VFCA01")
+			So(items[0].FirmwareVersion, ShouldEqual, "00.0D.77")
+			So(items[0].TotalOutputPower, ShouldEqual, "750 W")
+			So(items[0].AssetTag, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestParseTools(t *testing.T) {
+	Convey("解析系统工具输出", t, func() {
+		Convey("cpuinfo", func() {
+			info := parseCPUInfo(readTestdata("root/proc/cpuinfo"))
+			So(info.Threads, ShouldEqual, 4)
+			So(info.Flags, ShouldContain, "avx2")
+		})
+
+		Convey("lspci", func() {
+			devices := parseLspci(readTestdata("lspci_vmm_D.txt"))
+			So(len(devices), ShouldEqual, 6)
+			So(findPCIDevice(devices, "02:00.0").SDevice, ShouldEqual, "PERC H730P Mini")
+			So(findPCIDevice(devices, "0000:09:00.0"), ShouldBeNil)
+
+			ctrls := parseRaidControllers(devices)
+			So(len(ctrls), ShouldEqual, 1)
+			So(ctrls[0], ShouldResemble, &collector.RaidController{
+				ID:           "0",
+				Manufacturer: "Broadcom / LSI",
+				Model:        "MegaRAID SAS-3 3108 [Invader]",
+				PCIAddress:   "0000:02:00.0",
+			})
+		})
+
+		Convey("lsblk", func() {
+			devices := parseLsblk(readTestdata("lsblk.txt"))
+			So(len(devices), ShouldEqual, 4)
+			So(devices[0].isDisk(), ShouldBeTrue)
+			So(devices[2].isDisk(), ShouldBeFalse)
+			So(devices[3].isDisk(), ShouldBeFalse)
+			So(devices[1].toPhysicalDrive(), ShouldResemble, &collector.PhysicalDrive{
+				Location:        "/dev/sdb",
+				Manufacturer:    "ATA",
+				Model:           "INTEL SSDSC2KB480G8",
+				WWN:             "0x55cd2e414f8b1c2a",
+				SerialNumber:    "PHYF8123456A480BGN",
+				BusType:         "SATA",
+				MediaType:       "SSD",
+				Size:            480103981056,
+				FirmwareVersion: "0110",
+			})
+			So(formatDiskSize(devices[0].size()), ShouldEqual, "599.6 GB, 599550590976 bytes")
+		})
+
+		Convey("ethtool", func() {
+			info := parseEthtoolInfo(readTestdata("ethtool_i_eno1.txt"))
+			So(info, ShouldResemble, ethtoolInfo{
+				Driver:          "tg3",
+				Version:         "3.137",
+				FirmwareVersion: "FFV20.6.52 bc 5720-v1.39",
+				BusInfo:         "0000:01:00.0",
+			})
+		})
+
+		Convey("IP地址", func() {
+			So(parseIPv4(readTestdata("ip_addr_eno1.txt")), ShouldEqual, "10.0.106.27")
+			So(parseIPv4(nil), ShouldBeEmpty)
+		})
+
+		Convey("风扇", func() {
+			items := parseFans(readTestdata("ipmitool_sdr_type_fan.txt"))
+			So(items, ShouldResemble, []*collector.FanItem{
+				{Location: "Fan1 RPM", Speed: "5880 RPM"},
+				{Location: "Fan2 RPM", Speed: "5760 RPM"},
+			})
+		})
+
+		Convey("LLDP", func() {
+			lldp := parseLLDP(readTestdata("lldpctl_keyvalue.txt"))
+			ref := switchRef(lldp, "eno1", "18:66:da:aa:bb:01")
+			So(ref.Name, ShouldEqual, "sw-core-01")
+			So(ref.PortIfName, ShouldEqual, "Ethernet12")
+			So(ref.MgmtIP, ShouldEqual, "10.0.0.1")
+			So(ref.BridgeEnabled, ShouldEqual, "on")
+			So(ref.Vlan, ShouldEqual, "100")
+			So(switchRef(lldp, "eno2", ""), ShouldBeNil)
+		})
+	})
+}
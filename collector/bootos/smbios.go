@@ -0,0 +1,182 @@
+package bootos
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/collector/smbios"
+)
+
+// smbiosTable 解析根目录下sysfs导出的SMBIOS表
+func (c *bootos) smbiosTable() (*smbios.Table, error) {
+	return smbios.Open(c.path("sys", "firmware", "dmi", "tables"))
+}
+
+// yesNo 返回与dmidecode一致的布尔值描述
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
+
+// withUnit 返回带单位的数值，数值为0时返回空字符串。
+func withUnit(n int64, unit string) string {
+	if n <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d %s", n, unit)
+}
+
+// processorsFromSMBIOS 将SMBIOS处理器及缓存信息转换为采集器的处理器信息
+func processorsFromSMBIOS(tbl *smbios.Table) []*collector.Processor {
+	caches := make(map[uint16]string)
+	for _, c := range tbl.Caches() {
+		caches[c.Handle] = withUnit(c.InstalledSize/1024, "kB")
+	}
+
+	var items []*collector.Processor
+	for _, p := range tbl.Processors() {
+		if !p.Populated {
+			continue
+		}
+		items = append(items, &collector.Processor{
+			SocketDesignation: dmiValue(p.SocketDesignation),
+			Manufacturer:      dmiValue(p.Manufacturer),
+			Family:            p.Family,
+			Model:             dmiValue(p.Version),
+			Type:              p.Type,
+			MaxSpeed:          withUnit(int64(p.MaxSpeed), "MHz"),
+			CurrentSpeed:      withUnit(int64(p.CurrentSpeed), "MHz"),
+			Cores:             p.CoreCount,
+			EnabledCores:      p.CoreEnabled,
+			Threads:           p.ThreadCount,
+			Voltage:           p.Voltage,
+			L1Cache:           caches[p.L1CacheHandle],
+			L2Cache:           caches[p.L2CacheHandle],
+			L3Cache:           caches[p.L3CacheHandle],
+		})
+	}
+	return items
+}
+
+// memoryFromSMBIOS 将SMBIOS内存阵列及内存设备信息转换为采集器的内存信息
+func memoryFromSMBIOS(tbl *smbios.Table) *collector.Memory {
+	var mem collector.Memory
+	for _, a := range tbl.MemoryArrays() {
+		if a.Use != smbios.MemoryArrayUseSystem {
+			continue
+		}
+		mem.MaximumSize += a.MaximumCapacity
+	}
+	for _, m := range tbl.MemoryDevices() {
+		mem.NumberOfDevices++
+		if m.Size <= 0 {
+			continue
+		}
+		mem.NumberOfUsedDevices++
+		mem.TotalSize += m.Size
+
+		var voltage string
+		if m.ConfiguredVoltage > 0 {
+			voltage = strconv.FormatFloat(float64(m.ConfiguredVoltage)/1000, 'f', -1, 64)
+		}
+		mem.Items = append(mem.Items, &collector.MemoryDevice{
+			Location:          dmiValue(m.Locator),
+			Size:              m.Size,
+			Type:              m.Type,
+			Speed:             withUnit(int64(m.Speed), "MT/s"),
+			Manufacturer:      dmiValue(m.Manufacturer),
+			SerialNumber:      dmiValue(m.SerialNumber),
+			PartNumber:        dmiValue(m.PartNumber),
+			AssetTag:          dmiValue(m.AssetTag),
+			ConfiguredVoltage: voltage,
+		})
+	}
+	return &mem
+}
+
+// motherboardFromSMBIOS 将SMBIOS主板及板载设备信息转换为采集器的主板信息
+func motherboardFromSMBIOS(tbl *smbios.Table) *collector.Motherboard {
+	var board collector.Motherboard
+	if items := tbl.Baseboards(); len(items) > 0 {
+		board.Manufacturer = dmiValue(items[0].Manufacturer)
+		board.ProductName = dmiValue(items[0].ProductName)
+		board.SerialNumber = strings.Trim(dmiValue(items[0].SerialNumber), ".")
+		board.FirmwareVersion = dmiValue(items[0].Version)
+	}
+	for _, d := range tbl.OnboardDevices() {
+		status := "Disabled"
+		if d.Enabled {
+			status = "Enabled"
+		}
+		board.OnboardDevices = append(board.OnboardDevices, &collector.OnboardDevice{
+			ReferenceDesignation: dmiValue(d.ReferenceDesignation),
+			Type:                 d.Type,
+			Status:               status,
+			BusAddress:           d.BusAddress,
+		})
+	}
+	return &board
+}
+
+// biosFromSMBIOS 将SMBIOS BIOS信息转换为采集器的BIOS信息
+func biosFromSMBIOS(tbl *smbios.Table) *collector.BIOS {
+	bios := tbl.BIOS()
+	if bios == nil {
+		return nil
+	}
+	return &collector.BIOS{
+		Manufacturer:    dmiValue(bios.Vendor),
+		FirmwareVersion: dmiValue(bios.Version),
+		ReleaseDate:     dmiValue(bios.ReleaseDate),
+		Characteristics: bios.Characteristics,
+	}
+}
+
+// slotsFromSMBIOS 将SMBIOS系统插槽信息转换为采集器的插槽信息
+func slotsFromSMBIOS(tbl *smbios.Table) []*collector.SystemSlot {
+	var items []*collector.SystemSlot
+	for i, s := range tbl.SystemSlots() {
+		id := s.ID
+		if id == 0 {
+			id = i + 1
+		}
+		items = append(items, &collector.SystemSlot{
+			ID:           id,
+			Designation:  dmiValue(s.Designation),
+			Type:         s.Type,
+			CurrentUsage: s.CurrentUsage,
+			Length:       s.Length,
+			BusAddress:   s.BusAddress,
+		})
+	}
+	return items
+}
+
+// powerSuppliesFromSMBIOS 将SMBIOS系统电源信息转换为采集器的电源信息
+func powerSuppliesFromSMBIOS(tbl *smbios.Table) []*collector.SystemPowerSupply {
+	var items []*collector.SystemPowerSupply
+	for _, p := range tbl.PowerSupplies() {
+		if !p.Present {
+			continue
+		}
+		items = append(items, &collector.SystemPowerSupply{
+			Location:         dmiValue(p.Location),
+			Name:             dmiValue(p.Name),
+			Manufacturer:     dmiValue(p.Manufacturer),
+			SerialNumber:     dmiValue(p.SerialNumber),
+			PartNumber:       dmiValue(p.ModelPartNumber),
+			AssetTag:         dmiValue(p.AssetTag),
+			FirmwareVersion:  dmiValue(p.Revision),
+			Model:            dmiValue(p.Name),
+			InputVoltage:     p.InputVoltageRangeSwitching,
+			TotalOutputPower: withUnit(int64(p.MaxPowerCapacity), "W"),
+			Plugged:          yesNo(p.Plugged),
+			HotReplaceable:   yesNo(p.HotReplaceable),
+		})
+	}
+	return items
+}
@@ -23,8 +23,8 @@ const (
 var (
 	// ErrUnregisteredCollector 未注册的采集器
 	ErrUnregisteredCollector = errors.New("unregistered collector")
-	// ErrNotSupported 暂不支持
-	ErrNotSupported = errors.New("not supported yet")
+	// ErrNotSupported 暂不支持，与oob.ErrNotSupported为同一错误。
+	ErrNotSupported = oob.ErrNotSupported
 )
 
 // Collector 设备信息采集器
@@ -0,0 +1,63 @@
+package collector
+
+import (
+	"net/http"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// Options 采集器选项
+type Options struct {
+	Root       string        // sysfs、procfs等系统文件的根目录，默认为"/"。
+	Hostname   string        // 带外主机名/IP，Redfish等远程采集器使用。
+	Username   string        // 带外用户名
+	Password   string        // 带外密码
+	HTTPClient *http.Client  // 远程采集器使用的HTTP客户端
+	Debug      bool          // 若开启debug，会将关键日志信息写入console。
+	Log        util.Logger   // 日志实例
+	Executor   util.Executor // 执行器实例
+}
+
+// WithRoot 设置sysfs、procfs等系统文件的根目录
+func WithRoot(root string) func(*Options) {
+	return func(opts *Options) {
+		opts.Root = root
+	}
+}
+
+// WithRemote 设置远程采集参数
+func WithRemote(hostname, username, password string) func(*Options) {
+	return func(opts *Options) {
+		opts.Hostname = hostname
+		opts.Username = username
+		opts.Password = password
+	}
+}
+
+// WithHTTPClient 设置远程采集器使用的HTTP客户端
+func WithHTTPClient(client *http.Client) func(*Options) {
+	return func(opts *Options) {
+		opts.HTTPClient = client
+	}
+}
+
+// WithLog 设置日志实例
+func WithLog(log util.Logger) func(*Options) {
+	return func(opts *Options) {
+		opts.Log = log
+	}
+}
+
+// WithExecutor 设置执行器实例
+func WithExecutor(executor util.Executor) func(*Options) {
+	return func(opts *Options) {
+		opts.Executor = executor
+	}
+}
+
+// WithDebug 设置采集器debug模式
+func WithDebug(debug bool) func(*Options) {
+	return func(opts *Options) {
+		opts.Debug = debug
+	}
+}
@@ -0,0 +1,178 @@
+package redfish
+
+import (
+	rf "github.com/licairong/cloudboot-provider-framework/util/redfish"
+)
+
+// status 资源状态
+type status struct {
+	State  string `json:"State"`
+	Health string `json:"Health"`
+}
+
+// absent 返回资源是否不在位
+func (s status) absent() bool {
+	return s.State == "Absent"
+}
+
+// serviceRoot 服务根
+type serviceRoot struct {
+	Systems        rf.ODataID `json:"Systems"`
+	Chassis        rf.ODataID `json:"Chassis"`
+	Managers       rf.ODataID `json:"Managers"`
+	AccountService rf.ODataID `json:"AccountService"`
+}
+
+// computerSystem 计算机系统
+type computerSystem struct {
+	Manufacturer       string     `json:"Manufacturer"`
+	Model              string     `json:"Model"`
+	SerialNumber       string     `json:"SerialNumber"`
+	BiosVersion        string     `json:"BiosVersion"`
+	Processors         rf.ODataID `json:"Processors"`
+	Memory             rf.ODataID `json:"Memory"`
+	Storage            rf.ODataID `json:"Storage"`
+	EthernetInterfaces rf.ODataID `json:"EthernetInterfaces"`
+}
+
+// processor 处理器
+type processor struct {
+	Socket         string `json:"Socket"`
+	ProcessorType  string `json:"ProcessorType"`
+	InstructionSet string `json:"InstructionSet"`
+	Manufacturer   string `json:"Manufacturer"`
+	Model          string `json:"Model"`
+	MaxSpeedMHz    int    `json:"MaxSpeedMHz"`
+	TotalCores     int    `json:"TotalCores"`
+	TotalThreads   int    `json:"TotalThreads"`
+	Status         status `json:"Status"`
+}
+
+// memory 内存
+type memory struct {
+	DeviceLocator     string `json:"DeviceLocator"`
+	CapacityMiB       int64  `json:"CapacityMiB"`
+	MemoryDeviceType  string `json:"MemoryDeviceType"`
+	OperatingSpeedMhz int    `json:"OperatingSpeedMhz"`
+	Manufacturer      string `json:"Manufacturer"`
+	SerialNumber      string `json:"SerialNumber"`
+	PartNumber        string `json:"PartNumber"`
+	Status            status `json:"Status"`
+}
+
+// identifier 持久化标识
+type identifier struct {
+	DurableName       string `json:"DurableName"`
+	DurableNameFormat string `json:"DurableNameFormat"`
+}
+
+// storage 存储子系统
+type storage struct {
+	ID                 string              `json:"Id"`
+	StorageControllers []storageController `json:"StorageControllers"`
+	Drives             []rf.ODataID        `json:"Drives"`
+}
+
+// storageController 存储控制器
+type storageController struct {
+	MemberID        string `json:"MemberId"`
+	Manufacturer    string `json:"Manufacturer"`
+	Model           string `json:"Model"`
+	SerialNumber    string `json:"SerialNumber"`
+	FirmwareVersion string `json:"FirmwareVersion"`
+	Status          status `json:"Status"`
+}
+
+// drive 物理硬盘
+type drive struct {
+	ID                 string       `json:"Id"`
+	Name               string       `json:"Name"`
+	Manufacturer       string       `json:"Manufacturer"`
+	Model              string       `json:"Model"`
+	SerialNumber       string       `json:"SerialNumber"`
+	PartNumber         string       `json:"PartNumber"`
+	Revision           string       `json:"Revision"`
+	CapacityBytes      int64        `json:"CapacityBytes"`
+	Protocol           string       `json:"Protocol"`
+	MediaType          string       `json:"MediaType"`
+	NegotiatedSpeedGbs float64      `json:"NegotiatedSpeedGbs"`
+	Identifiers        []identifier `json:"Identifiers"`
+	PhysicalLocation   struct {
+		PartLocation struct {
+			LocationOrdinalValue *int   `json:"LocationOrdinalValue"`
+			ServiceLabel         string `json:"ServiceLabel"`
+		} `json:"PartLocation"`
+	} `json:"PhysicalLocation"`
+	Status status `json:"Status"`
+}
+
+// ipv4Address IPv4地址
+type ipv4Address struct {
+	Address       string `json:"Address"`
+	SubnetMask    string `json:"SubnetMask"`
+	AddressOrigin string `json:"AddressOrigin"`
+	Gateway       string `json:"Gateway"`
+}
+
+// ethernetInterface 网口
+type ethernetInterface struct {
+	ID            string        `json:"Id"`
+	Name          string        `json:"Name"`
+	MACAddress    string        `json:"MACAddress"`
+	SpeedMbps     int           `json:"SpeedMbps"`
+	LinkStatus    string        `json:"LinkStatus"`
+	IPv4Addresses []ipv4Address `json:"IPv4Addresses"`
+	Status        status        `json:"Status"`
+}
+
+// chassis 机箱
+type chassis struct {
+	ChassisType  string     `json:"ChassisType"`
+	Manufacturer string     `json:"Manufacturer"`
+	Model        string     `json:"Model"`
+	SerialNumber string     `json:"SerialNumber"`
+	Power        rf.ODataID `json:"Power"`
+	Thermal      rf.ODataID `json:"Thermal"`
+}
+
+// power 电源
+type power struct {
+	PowerSupplies []struct {
+		MemberID           string  `json:"MemberId"`
+		Name               string  `json:"Name"`
+		Manufacturer       string  `json:"Manufacturer"`
+		Model              string  `json:"Model"`
+		SerialNumber       string  `json:"SerialNumber"`
+		PartNumber         string  `json:"PartNumber"`
+		FirmwareVersion    string  `json:"FirmwareVersion"`
+		PowerCapacityWatts float64 `json:"PowerCapacityWatts"`
+		LineInputVoltage   float64 `json:"LineInputVoltage"`
+		HotPluggable       *bool   `json:"HotPluggable"`
+		Status             status  `json:"Status"`
+	} `json:"PowerSupplies"`
+}
+
+// thermal 散热
+type thermal struct {
+	Fans []struct {
+		Name         string  `json:"Name"`
+		FanName      string  `json:"FanName"` // 早期版本使用FanName
+		Reading      float64 `json:"Reading"`
+		ReadingUnits string  `json:"ReadingUnits"`
+		Status       status  `json:"Status"`
+	} `json:"Fans"`
+}
+
+// manager 管理控制器（BMC）
+type manager struct {
+	FirmwareVersion    string     `json:"FirmwareVersion"`
+	EthernetInterfaces rf.ODataID `json:"EthernetInterfaces"`
+}
+
+// account 带外用户
+type account struct {
+	ID       string `json:"Id"`
+	UserName string `json:"UserName"`
+	RoleID   string `json:"RoleId"`
+	Enabled  bool   `json:"Enabled"`
+}
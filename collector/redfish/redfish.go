@@ -0,0 +1,613 @@
+// Package redfish 基于BMC Redfish接口的设备信息采集器
+package redfish
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	rf "github.com/licairong/cloudboot-provider-framework/util/redfish"
+)
+
+var _ collector.Collector = (*redfish)(nil)
+
+const (
+	// Name 采集器名称
+	Name = "REDFISH"
+)
+
+// redfish 基于BMC Redfish接口的采集器。首次采集时创建会话，LogoutRedfish或Destroy时删除会话。
+type redfish struct {
+	opts   *collector.Options
+	log    util.Logger
+	client *rf.Client
+	root   *serviceRoot
+}
+
+// New 返回采集器实例。须通过collector.WithRemote指定BMC地址及用户名、密码。
+func New(setters ...func(*collector.Options)) collector.Collector {
+	var opts collector.Options
+	for i := range setters {
+		setters[i](&opts)
+	}
+	return &redfish{
+		opts:   &opts,
+		log:    opts.Log,
+		client: newClient(&opts, opts.Hostname, opts.Username, opts.Password),
+	}
+}
+
+func newClient(opts *collector.Options, hostname, username, password string) *rf.Client {
+	client := rf.NewClient(hostname, username, password)
+	client.HTTPClient = opts.HTTPClient
+	return client
+}
+
+// serviceRoot 返回服务根。采集器自身客户端的服务根将被缓存。
+func (c *redfish) serviceRoot(client *rf.Client) (*serviceRoot, error) {
+	if client == c.client && c.root != nil {
+		return c.root, nil
+	}
+	var root serviceRoot
+	if err := client.Get(rf.ServiceRoot, &root); err != nil {
+		return nil, err
+	}
+	if client == c.client {
+		c.root = &root
+	}
+	return &root, nil
+}
+
+// first 返回资源集合中的首个成员路径
+func first(client *rf.Client, path string) (string, error) {
+	members, err := client.Members(path)
+	if err != nil {
+		return "", err
+	}
+	if len(members) == 0 {
+		return "", fmt.Errorf("redfish: no members in %s", path)
+	}
+	return members[0], nil
+}
+
+// system 返回首个计算机系统
+func (c *redfish) system(client *rf.Client) (*computerSystem, error) {
+	root, err := c.serviceRoot(client)
+	if err != nil {
+		return nil, err
+	}
+	path, err := first(client, root.Systems.ID)
+	if err != nil {
+		return nil, err
+	}
+	var sys computerSystem
+	if err = client.Get(path, &sys); err != nil {
+		return nil, err
+	}
+	return &sys, nil
+}
+
+// chassis 返回首个机箱
+func (c *redfish) chassis() (*chassis, error) {
+	root, err := c.serviceRoot(c.client)
+	if err != nil {
+		return nil, err
+	}
+	path, err := first(c.client, root.Chassis.ID)
+	if err != nil {
+		return nil, err
+	}
+	var ch chassis
+	if err = c.client.Get(path, &ch); err != nil {
+		return nil, err
+	}
+	return &ch, nil
+}
+
+// manager 返回首个管理控制器
+func (c *redfish) manager() (*manager, error) {
+	root, err := c.serviceRoot(c.client)
+	if err != nil {
+		return nil, err
+	}
+	path, err := first(c.client, root.Managers.ID)
+	if err != nil {
+		return nil, err
+	}
+	var mgr manager
+	if err = c.client.Get(path, &mgr); err != nil {
+		return nil, err
+	}
+	return &mgr, nil
+}
+
+// each 依次获取资源集合中的成员
+func each(client *rf.Client, path string, fn func(path string) error) error {
+	members, err := client.Members(path)
+	if err != nil {
+		return err
+	}
+	for _, member := range members {
+		if err = fn(member); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Destroy 资源回收并销毁采集器
+func (c *redfish) Destroy() error {
+	return c.client.Logout()
+}
+
+// SetLog 更换日志实现
+func (c *redfish) SetLog(log util.Logger) {
+	c.log = log
+}
+
+// BASE 采集并返回当前设备基本信息
+func (c *redfish) BASE() (*collector.Base, error) {
+	sys, err := c.system(c.client)
+	if err != nil {
+		return nil, err
+	}
+	b := collector.Base{
+		SN:           sys.SerialNumber,
+		Manufacturer: util.ManufacturerName(sys.Manufacturer),
+		Model:        sys.Model,
+		ChassisType:  collector.UnknownServer,
+	}
+	if ch, err := c.chassis(); err == nil {
+		switch ch.ChassisType {
+		case "RackMount":
+			b.ChassisType = collector.RackServer
+		case "Blade":
+			b.ChassisType = collector.BladeServer
+		}
+	}
+	return &b, nil
+}
+
+// CPU 采集并返回当前设备的CPU信息
+func (c *redfish) CPU() (*collector.CPU, error) {
+	sys, err := c.system(c.client)
+	if err != nil {
+		return nil, err
+	}
+	var cpu collector.CPU
+	err = each(c.client, sys.Processors.ID, func(path string) error {
+		var p processor
+		if err := c.client.Get(path, &p); err != nil {
+			return err
+		}
+		if p.Status.absent() || (p.ProcessorType != "" && p.ProcessorType != "CPU") {
+			return nil
+		}
+		cpu.TotalPhysicals++
+		cpu.TotalCores += p.TotalCores
+		cpu.TotalThreads += p.TotalThreads
+		cpu.Items = append(cpu.Items, &collector.Processor{
+			SocketDesignation: p.Socket,
+			Manufacturer:      p.Manufacturer,
+			Model:             p.Model,
+			Type:              "Central Processor",
+			MaxSpeed:          withUnit(float64(p.MaxSpeedMHz), "MHz"),
+			Cores:             p.TotalCores,
+			EnabledCores:      p.TotalCores,
+			Threads:           p.TotalThreads,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &cpu, nil
+}
+
+// Memory 采集并返回当前设备内存信息
+func (c *redfish) Memory() (*collector.Memory, error) {
+	sys, err := c.system(c.client)
+	if err != nil {
+		return nil, err
+	}
+	var mem collector.Memory
+	err = each(c.client, sys.Memory.ID, func(path string) error {
+		var m memory
+		if err := c.client.Get(path, &m); err != nil {
+			return err
+		}
+		mem.NumberOfDevices++
+		if m.Status.absent() || m.CapacityMiB <= 0 {
+			return nil
+		}
+		size := m.CapacityMiB << 20
+		mem.NumberOfUsedDevices++
+		mem.TotalSize += size
+		mem.Items = append(mem.Items, &collector.MemoryDevice{
+			Location:     m.DeviceLocator,
+			Size:         size,
+			Type:         m.MemoryDeviceType,
+			Speed:        withUnit(float64(m.OperatingSpeedMhz), "MT/s"),
+			Manufacturer: m.Manufacturer,
+			SerialNumber: m.SerialNumber,
+			PartNumber:   m.PartNumber,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &mem, nil
+}
+
+// Motherboard 采集并返回当前设备的主板信息
+func (c *redfish) Motherboard() (*collector.Motherboard, error) {
+	ch, err := c.chassis()
+	if err != nil {
+		return nil, err
+	}
+	return &collector.Motherboard{
+		Manufacturer: ch.Manufacturer,
+		ProductName:  ch.Model,
+		SerialNumber: ch.SerialNumber,
+	}, nil
+}
+
+// LogicalDisk 逻辑磁盘为操作系统视角的信息，Redfish无法采集。
+func (c *redfish) LogicalDisk() (*collector.LogicalDisk, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// clientFor 返回指定BMC的客户端。uri为空时返回采集器自身的客户端，否则返回临时客户端，调用方须负责登出。
+func (c *redfish) clientFor(uri, username, password string) (client *rf.Client, temporary bool) {
+	if uri == "" {
+		return c.client, false
+	}
+	return newClient(c.opts, uri, username, password), true
+}
+
+// storages 返回存储子系统列表
+func (c *redfish) storages(client *rf.Client) ([]*storage, error) {
+	sys, err := c.system(client)
+	if err != nil {
+		return nil, err
+	}
+	var items []*storage
+	err = each(client, sys.Storage.ID, func(path string) error {
+		var s storage
+		if err := client.Get(path, &s); err != nil {
+			return err
+		}
+		items = append(items, &s)
+		return nil
+	})
+	return items, err
+}
+
+// PhysicalDisk 采集并返回当前设备的物理磁盘信息。uri非空时采集指定BMC。
+func (c *redfish) PhysicalDisk(uri, user_name, password string) (*collector.PhysicalDisk, error) {
+	client, temporary := c.clientFor(uri, user_name, password)
+	if temporary {
+		defer client.Logout()
+	}
+	storages, err := c.storages(client)
+	if err != nil {
+		return nil, err
+	}
+	var pd collector.PhysicalDisk
+	for _, s := range storages {
+		for _, link := range s.Drives {
+			var d drive
+			if err = client.Get(link.ID, &d); err != nil {
+				return nil, err
+			}
+			if d.Status.absent() {
+				continue
+			}
+			pd.TotalSize += d.CapacityBytes
+			pd.Items = append(pd.Items, d.toPhysicalDrive(s.ID))
+		}
+	}
+	return &pd, nil
+}
+
+// toPhysicalDrive 转换为采集器的物理硬盘信息
+func (d *drive) toPhysicalDrive(controllerID string) *collector.PhysicalDrive {
+	pd := collector.PhysicalDrive{
+		Location:        d.Name,
+		Slot:            d.ID,
+		Manufacturer:    d.Manufacturer,
+		Model:           d.Model,
+		SerialNumber:    d.SerialNumber,
+		BusType:         d.Protocol,
+		MediaType:       d.MediaType,
+		Size:            d.CapacityBytes,
+		PartNumber:      d.PartNumber,
+		FirmwareVersion: d.Revision,
+		FirmwareState:   d.Status.State,
+		ControllerID:    controllerID,
+		TransferSpeed:   withUnit(d.NegotiatedSpeedGbs, "Gb/s"),
+	}
+	if loc := d.PhysicalLocation.PartLocation; loc.LocationOrdinalValue != nil {
+		pd.Slot = strconv.Itoa(*loc.LocationOrdinalValue)
+		if loc.ServiceLabel != "" {
+			pd.Location = loc.ServiceLabel
+		}
+	}
+	for _, id := range d.Identifiers {
+		if id.DurableNameFormat == "NAA" {
+			pd.WWN = "0x" + strings.ToLower(id.DurableName)
+			break
+		}
+	}
+	return &pd
+}
+
+// NIC 采集并返回当前设备的网卡信息
+func (c *redfish) NIC() (*collector.NIC, error) {
+	sys, err := c.system(c.client)
+	if err != nil {
+		return nil, err
+	}
+	var nic collector.NIC
+	err = each(c.client, sys.EthernetInterfaces.ID, func(path string) error {
+		var eth ethernetInterface
+		if err := c.client.Get(path, &eth); err != nil {
+			return err
+		}
+		if eth.Status.absent() {
+			return nil
+		}
+		port := collector.NICPort{
+			Location: eth.ID,
+			MAC:      strings.ToLower(eth.MACAddress),
+			Speed:    withUnit(float64(eth.SpeedMbps), "Mb/s"),
+			Type:     "Ethernet",
+			Link:     "no",
+		}
+		if eth.LinkStatus == "LinkUp" {
+			port.Link = "yes"
+		}
+		if len(eth.IPv4Addresses) > 0 {
+			port.IP = eth.IPv4Addresses[0].Address
+		}
+		nic.Items = append(nic.Items, &port)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nic, nil
+}
+
+// HBA Redfish未提供HBA卡的FC端口信息
+func (c *redfish) HBA() (*collector.HBA, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// privilegeLevels Redfish预置角色对应的IPMI权限级别
+var privilegeLevels = map[string]int{
+	"Administrator": 4,
+	"Operator":      3,
+	"ReadOnly":      2,
+}
+
+// OOB 采集并返回当前设备的OOB信息。
+func (c *redfish) OOB() (*collector.OOB, error) {
+	mgr, err := c.manager()
+	if err != nil {
+		return nil, err
+	}
+	info := collector.OOB{FirmwareVersion: mgr.FirmwareVersion}
+
+	if path, err := first(c.client, mgr.EthernetInterfaces.ID); err == nil {
+		var eth ethernetInterface
+		if err = c.client.Get(path, &eth); err == nil && len(eth.IPv4Addresses) > 0 {
+			addr := eth.IPv4Addresses[0]
+			info.Network = &collector.OOBNetwork{
+				IPSrc:   addr.AddressOrigin + " Address", // 与ipmitool一致，如'Static Address'。
+				IP:      addr.Address,
+				MAC:     strings.ToLower(eth.MACAddress),
+				Netmask: addr.SubnetMask,
+				Gateway: addr.Gateway,
+			}
+		}
+	}
+
+	root, err := c.serviceRoot(c.client)
+	if err != nil {
+		return nil, err
+	}
+	var accounts struct {
+		Accounts rf.ODataID `json:"Accounts"`
+	}
+	if root.AccountService.ID == "" || c.client.Get(root.AccountService.ID, &accounts) != nil {
+		return &info, nil
+	}
+	_ = each(c.client, accounts.Accounts.ID, func(path string) error {
+		var a account
+		if err := c.client.Get(path, &a); err != nil || a.UserName == "" {
+			return err
+		}
+		id, _ := strconv.Atoi(a.ID)
+		info.User = append(info.User, &collector.OOBUser{
+			ID:             id,
+			Name:           a.UserName,
+			PrivilegeLevel: privilegeLevels[a.RoleID],
+		})
+		return nil
+	})
+	return &info, nil
+}
+
+// BIOS 采集并返回当前设备的BIOS信息。
+func (c *redfish) BIOS() (*collector.BIOS, error) {
+	sys, err := c.system(c.client)
+	if err != nil {
+		return nil, err
+	}
+	return &collector.BIOS{
+		Manufacturer:    sys.Manufacturer,
+		FirmwareVersion: sys.BiosVersion,
+	}, nil
+}
+
+// RAID 采集并返回当前设备的RAID控制器信息。uri非空时采集指定BMC。
+func (c *redfish) RAID(uri, user_name, password string) (*collector.RAID, error) {
+	client, temporary := c.clientFor(uri, user_name, password)
+	if temporary {
+		defer client.Logout()
+	}
+	storages, err := c.storages(client)
+	if err != nil {
+		return nil, err
+	}
+	var raid collector.RAID
+	for _, s := range storages {
+		for _, ctrl := range s.StorageControllers {
+			if ctrl.Status.absent() {
+				continue
+			}
+			id := s.ID
+			if len(s.StorageControllers) > 1 {
+				id += "." + ctrl.MemberID
+			}
+			raid.Items = append(raid.Items, &collector.RaidController{
+				ID:              id,
+				Manufacturer:    ctrl.Manufacturer,
+				Model:           ctrl.Model,
+				FirmwareVersion: ctrl.FirmwareVersion,
+				SerialNumber:    ctrl.SerialNumber,
+			})
+		}
+	}
+	return &raid, nil
+}
+
+// PCI 暂不支持
+func (c *redfish) PCI() (*collector.PCI, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// Fan 采集并返回当前设备的所有风扇信息。
+func (c *redfish) Fan() (*collector.Fan, error) {
+	ch, err := c.chassis()
+	if err != nil {
+		return nil, err
+	}
+	var th thermal
+	if err = c.client.Get(ch.Thermal.ID, &th); err != nil {
+		return nil, err
+	}
+	var fan collector.Fan
+	for _, f := range th.Fans {
+		if f.Status.absent() {
+			continue
+		}
+		name := f.Name
+		if name == "" {
+			name = f.FanName
+		}
+		unit := f.ReadingUnits
+		if unit == "Percent" {
+			unit = "%"
+		}
+		fan.Items = append(fan.Items, &collector.FanItem{
+			Location: name,
+			Speed:    withUnit(f.Reading, unit),
+		})
+	}
+	return &fan, nil
+}
+
+// PowerSupply 采集并返回当前设备的电源信息。
+func (c *redfish) PowerSupply() (*collector.PowerSupply, error) {
+	ch, err := c.chassis()
+	if err != nil {
+		return nil, err
+	}
+	var pw power
+	if err = c.client.Get(ch.Power.ID, &pw); err != nil {
+		return nil, err
+	}
+	var psu collector.PowerSupply
+	for _, p := range pw.PowerSupplies {
+		if p.Status.absent() {
+			continue
+		}
+		item := collector.SystemPowerSupply{
+			Location:         p.Name,
+			Name:             p.Name,
+			Manufacturer:     p.Manufacturer,
+			SerialNumber:     p.SerialNumber,
+			PartNumber:       p.PartNumber,
+			FirmwareVersion:  p.FirmwareVersion,
+			Model:            p.Model,
+			InputVoltage:     withUnit(p.LineInputVoltage, "V"),
+			TotalOutputPower: withUnit(p.PowerCapacityWatts, "W"),
+			Plugged:          "Yes",
+		}
+		if p.HotPluggable != nil {
+			item.HotReplaceable = "No"
+			if *p.HotPluggable {
+				item.HotReplaceable = "Yes"
+			}
+		}
+		psu.Items = append(psu.Items, &item)
+	}
+	return &psu, nil
+}
+
+// LLDP 交换机邻居信息须在操作系统内采集
+func (c *redfish) LLDP() (*collector.LLDP, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// IDRAC 暂不支持
+func (c *redfish) IDRAC() (*collector.IDRAC, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// ILO 暂不支持
+func (c *redfish) ILO() (*collector.ILO, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// Backplane 暂不支持
+func (c *redfish) Backplane() (*collector.Backplane, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// EventLogs 暂不支持
+func (c *redfish) EventLogs() ([]*oob.EventLog, error) {
+	return nil, collector.ErrNotSupported
+}
+
+// Extra 采集脚本须在操作系统内执行
+func (c *redfish) Extra(scripts [][]byte) *collector.Extra {
+	return nil
+}
+
+// Check 登录BMC并获取计算机系统，检查Redfish服务是否可用。
+func (c *redfish) Check() error {
+	_, err := c.system(c.client)
+	return err
+}
+
+// LogoutRedfish 删除会话
+func (c *redfish) LogoutRedfish() {
+	if err := c.client.Logout(); err != nil && c.log != nil {
+		c.log.Warnf("redfish logout: %s", err)
+	}
+}
+
+// withUnit 返回带单位的数值，数值为0时返回空字符串。
+func withUnit(n float64, unit string) string {
+	if n <= 0 {
+		return ""
+	}
+	return strconv.FormatFloat(n, 'f', -1, 64) + " " + unit
+}
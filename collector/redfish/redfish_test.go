@@ -0,0 +1,257 @@
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const sessionsPath = "/redfish/v1/SessionService/Sessions"
+
+// mockBMC 以testdata/mockup中的DMTF mockup响应请求的BMC
+type mockBMC struct {
+	*httptest.Server
+	mux      sync.Mutex
+	seq      int
+	sessions map[string]string // 会话路径 -> 令牌
+}
+
+func newMockBMC() *mockBMC {
+	bmc := &mockBMC{sessions: make(map[string]string)}
+	bmc.Server = httptest.NewTLSServer(http.HandlerFunc(bmc.serve))
+	return bmc
+}
+
+func (bmc *mockBMC) serve(w http.ResponseWriter, r *http.Request) {
+	bmc.mux.Lock()
+	defer bmc.mux.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case r.Method == http.MethodPost && path == sessionsPath:
+		var creds struct{ UserName, Password string }
+		_ = json.NewDecoder(r.Body).Decode(&creds)
+		if creds.UserName != "root" || creds.Password != "calvin" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		bmc.seq++
+		session := fmt.Sprintf("%s/%d", sessionsPath, bmc.seq)
+		token := fmt.Sprintf("token-%d", bmc.seq)
+		bmc.sessions[session] = token
+		w.Header().Set("X-Auth-Token", token)
+		w.Header().Set("Location", session)
+		w.WriteHeader(http.StatusCreated)
+		return
+	case path != "/redfish/v1" && !bmc.authorized(r.Header.Get("X-Auth-Token")):
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	case r.Method == http.MethodDelete && strings.HasPrefix(path, sessionsPath+"/"):
+		delete(bmc.sessions, path)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join("testdata", "mockup", path, "index.json"))
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+func (bmc *mockBMC) authorized(token string) bool {
+	for _, t := range bmc.sessions {
+		if t == token {
+			return true
+		}
+	}
+	return false
+}
+
+func (bmc *mockBMC) activeSessions() int {
+	bmc.mux.Lock()
+	defer bmc.mux.Unlock()
+	return len(bmc.sessions)
+}
+
+func TestCollector(t *testing.T) {
+	Convey("Redfish采集器", t, func() {
+		bmc := newMockBMC()
+		defer bmc.Close()
+
+		c := New(collector.WithRemote(bmc.URL, "root", "calvin"), collector.WithHTTPClient(bmc.Client()))
+		defer c.Destroy()
+
+		Convey("基本信息", func() {
+			base, err := c.BASE()
+			So(err, ShouldBeNil)
+			So(base, ShouldResemble, &collector.Base{
+				SN:           "437XR1138R2",
+				Manufacturer: "Contoso",
+				Model:        "3500",
+				ChassisType:  collector.RackServer,
+			})
+		})
+
+		Convey("CPU", func() {
+			cpu, err := c.CPU()
+			So(err, ShouldBeNil)
+			So(cpu.TotalPhysicals, ShouldEqual, 1)
+			So(cpu.TotalCores, ShouldEqual, 8)
+			So(cpu.TotalThreads, ShouldEqual, 16)
+			So(cpu.Items[0].SocketDesignation, ShouldEqual, "CPU 1")
+			So(cpu.Items[0].MaxSpeed, ShouldEqual, "3700 MHz")
+		})
+
+		Convey("内存", func() {
+			mem, err := c.Memory()
+			So(err, ShouldBeNil)
+			So(mem.NumberOfDevices, ShouldEqual, 3)
+			So(mem.NumberOfUsedDevices, ShouldEqual, 2)
+			So(mem.TotalSize, ShouldEqual, 96*1024*1024*1024)
+			So(mem.Items[0], ShouldResemble, &collector.MemoryDevice{
+				Location:     "PROC 1 DIMM 1",
+				Size:         32 * 1024 * 1024 * 1024,
+				Type:         "DDR4",
+				Speed:        "2400 MT/s",
+				Manufacturer: "Contoso",
+				SerialNumber: "1A2B3B40",
+				PartNumber:   "M393A4K40CB2-CTD",
+			})
+		})
+
+		Convey("物理磁盘及RAID控制器", func() {
+			pd, err := c.PhysicalDisk("", "", "")
+			So(err, ShouldBeNil)
+			So(len(pd.Items), ShouldEqual, 2)
+			So(pd.TotalSize, ShouldEqual, 899527000000+480103981056)
+			So(pd.Items[1], ShouldResemble, &collector.PhysicalDrive{
+				Location:        "Disk.Bay.1",
+				Slot:            "1",
+				Manufacturer:    "Contoso",
+				Model:           "C456",
+				WWN:             "0x55cd2e414f8b1c2a",
+				SerialNumber:    "9876543",
+				BusType:         "SATA",
+				MediaType:       "SSD",
+				Size:            480103981056,
+				PartNumber:      "C456-2222",
+				FirmwareVersion: "100A",
+				FirmwareState:   "Enabled",
+				ControllerID:    "1",
+				TransferSpeed:   "6 Gb/s",
+			})
+
+			raid, err := c.RAID("", "", "")
+			So(err, ShouldBeNil)
+			So(raid.Items, ShouldResemble, []*collector.RaidController{{
+				ID:              "1",
+				Manufacturer:    "Contoso",
+				Model:           "12Gbs Integrated RAID",
+				FirmwareVersion: "1.0.0.7",
+				SerialNumber:    "2M220100SL",
+			}})
+		})
+
+		Convey("指定BMC采集后登出临时会话", func() {
+			pd, err := c.PhysicalDisk(bmc.URL, "root", "calvin")
+			So(err, ShouldBeNil)
+			So(len(pd.Items), ShouldEqual, 2)
+			So(bmc.activeSessions(), ShouldEqual, 0)
+
+			_, err = c.RAID(bmc.URL, "root", "wrong")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("网卡", func() {
+			nic, err := c.NIC()
+			So(err, ShouldBeNil)
+			So(len(nic.Items), ShouldEqual, 2)
+			So(nic.Items[0], ShouldResemble, &collector.NICPort{
+				Location: "1",
+				MAC:      "12:44:6a:3b:04:11",
+				IP:       "192.168.0.10",
+				Speed:    "1000 Mb/s",
+				Type:     "Ethernet",
+				Link:     "yes",
+			})
+			So(nic.Items[1].Link, ShouldEqual, "no")
+		})
+
+		Convey("带外", func() {
+			info, err := c.OOB()
+			So(err, ShouldBeNil)
+			So(info.FirmwareVersion, ShouldEqual, "4.4.6521")
+			So(info.Network, ShouldResemble, &collector.OOBNetwork{
+				IPSrc:   "DHCP Address",
+				IP:      "10.0.106.27",
+				MAC:     "12:44:6a:3b:04:11",
+				Netmask: "255.255.255.0",
+				Gateway: "10.0.106.1",
+			})
+			So(info.User, ShouldResemble, []*collector.OOBUser{
+				{ID: 2, Name: "Administrator", PrivilegeLevel: 4},
+				{ID: 3, Name: "operator", PrivilegeLevel: 3},
+			})
+		})
+
+		Convey("BIOS及主板", func() {
+			bios, err := c.BIOS()
+			So(err, ShouldBeNil)
+			So(bios.FirmwareVersion, ShouldEqual, "P79 v1.45 (12/06/2017)")
+
+			board, err := c.Motherboard()
+			So(err, ShouldBeNil)
+			So(board.ProductName, ShouldEqual, "3500RX")
+		})
+
+		Convey("风扇及电源", func() {
+			fan, err := c.Fan()
+			So(err, ShouldBeNil)
+			So(fan.Items, ShouldResemble, []*collector.FanItem{
+				{Location: "BaseBoard System Fan", Speed: "2100 RPM"},
+				{Location: "BaseBoard System Fan Backup", Speed: "35 %"},
+			})
+
+			psu, err := c.PowerSupply()
+			So(err, ShouldBeNil)
+			So(len(psu.Items), ShouldEqual, 1)
+			So(psu.Items[0].TotalOutputPower, ShouldEqual, "800 W")
+			So(psu.Items[0].InputVoltage, ShouldEqual, "120 V")
+			So(psu.Items[0].HotReplaceable, ShouldEqual, "Yes")
+		})
+
+		Convey("登出", func() {
+			So(c.Check(), ShouldBeNil)
+			So(bmc.activeSessions(), ShouldEqual, 1)
+			c.LogoutRedfish()
+			So(bmc.activeSessions(), ShouldEqual, 0)
+
+			// 登出后再次采集将重新登录
+			_, err := c.CPU()
+			So(err, ShouldBeNil)
+			So(bmc.activeSessions(), ShouldEqual, 1)
+		})
+
+		Convey("用户名密码错误", func() {
+			c := New(collector.WithRemote(bmc.URL, "root", "wrong"), collector.WithHTTPClient(bmc.Client()))
+			So(c.Check(), ShouldNotBeNil)
+		})
+
+		Convey("暂不支持", func() {
+			_, err := c.LogicalDisk()
+			So(err, ShouldEqual, collector.ErrNotSupported)
+		})
+	})
+}
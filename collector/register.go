@@ -0,0 +1,131 @@
+package collector
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// Matcher 判断采集器是否适用于指定设备
+type Matcher func(base *Base) bool
+
+// registration 采集器注册信息
+type registration struct {
+	name      string
+	collector Collector
+	matchers  []Matcher
+}
+
+// collectorPool 采集器池，按注册顺序排列。
+var collectorPool []*registration
+var mux sync.Mutex
+
+// Register 注册采集器实例。matchers为空时该采集器仅能通过名称选用，否则在自动选择时须满足全部matcher。
+func Register(name string, collector Collector, matchers ...Matcher) {
+	mux.Lock()
+	defer mux.Unlock()
+	if collector == nil {
+		panic("collector: Register collector is nil")
+	}
+	name = strings.ToUpper(name)
+	for _, r := range collectorPool {
+		if r.name == name {
+			panic("collector: Register called twice for collector " + name)
+		}
+	}
+	collectorPool = append(collectorPool, &registration{
+		name:      name,
+		collector: collector,
+		matchers:  matchers,
+	})
+}
+
+// Registered 返回已注册的采集器名称
+func Registered() (items []string) {
+	mux.Lock()
+	defer mux.Unlock()
+
+	for _, r := range collectorPool {
+		items = append(items, r.name)
+	}
+	return items
+}
+
+// SelectCollector 根据采集器名称获取相应的Collector
+func SelectCollector(name string) Collector {
+	mux.Lock()
+	defer mux.Unlock()
+
+	name = strings.ToUpper(name)
+	for _, r := range collectorPool {
+		if r.name == name {
+			return r.collector
+		}
+	}
+	return nil
+}
+
+// AutoSelect 根据设备基本信息选择采集器。
+// 按注册顺序返回首个满足全部matcher且自检（Check）通过的采集器，均不满足时返回默认采集器。
+func AutoSelect(base *Base) (name string, collector Collector, err error) {
+	mux.Lock()
+	candidates := make([]*registration, len(collectorPool))
+	copy(candidates, collectorPool)
+	mux.Unlock()
+
+	if base != nil {
+		for _, r := range candidates {
+			if r.name == DefaultCollector || !r.match(base) {
+				continue
+			}
+			if r.collector.Check() == nil {
+				return r.name, r.collector, nil
+			}
+		}
+	}
+	if collector = SelectCollector(DefaultCollector); collector == nil {
+		return "", nil, ErrUnregisteredCollector
+	}
+	return DefaultCollector, collector, nil
+}
+
+// match 返回设备是否满足全部matcher
+func (r *registration) match(base *Base) bool {
+	if len(r.matchers) == 0 {
+		return false
+	}
+	for _, m := range r.matchers {
+		if !m(base) {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchVM 匹配虚拟机
+func MatchVM() Matcher {
+	return func(base *Base) bool {
+		return base.IsVM
+	}
+}
+
+// MatchBareMetal 匹配物理机
+func MatchBareMetal() Matcher {
+	return func(base *Base) bool {
+		return !base.IsVM
+	}
+}
+
+// MatchManufacturer 匹配指定厂商的设备，厂商名称经util.ManufacturerName统一后比较。
+func MatchManufacturer(manufacturers ...string) Matcher {
+	return func(base *Base) bool {
+		actual := util.ManufacturerName(base.Manufacturer)
+		for _, m := range manufacturers {
+			if strings.EqualFold(util.ManufacturerName(m), actual) {
+				return true
+			}
+		}
+		return false
+	}
+}
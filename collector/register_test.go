@@ -0,0 +1,69 @@
+package collector
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeCollector 仅实现Check方法的Collector
+type fakeCollector struct {
+	Collector
+	err error
+}
+
+func (c *fakeCollector) Check() error {
+	return c.err
+}
+
+func TestRegister(t *testing.T) {
+	Convey("采集器注册及选择", t, func() {
+		collectorPool = nil
+		defer func() { collectorPool = nil }()
+
+		bootos := &fakeCollector{}
+		redfish := &fakeCollector{}
+		idrac := &fakeCollector{err: errors.New("idrac unreachable")}
+
+		Convey("未注册默认采集器", func() {
+			_, _, err := AutoSelect(&Base{})
+			So(err, ShouldEqual, ErrUnregisteredCollector)
+		})
+
+		Register("bootos", bootos)
+		Register("idrac", idrac, MatchBareMetal(), MatchManufacturer("Dell Inc."))
+		Register("redfish", redfish, MatchBareMetal(), MatchManufacturer(`Dell`, "HPE"))
+
+		Convey("重复注册", func() {
+			So(func() { Register("BOOTOS", bootos) }, ShouldPanic)
+			So(func() { Register("nil", nil) }, ShouldPanic)
+		})
+
+		Convey("按名称选择", func() {
+			So(Registered(), ShouldResemble, []string{"BOOTOS", "IDRAC", "REDFISH"})
+			So(SelectCollector("Redfish"), ShouldEqual, redfish)
+			So(SelectCollector("ilo"), ShouldBeNil)
+		})
+
+		Convey("自检失败时选择后续匹配的采集器", func() {
+			name, c, err := AutoSelect(&Base{Manufacturer: "Dell Inc."})
+			So(err, ShouldBeNil)
+			So(name, ShouldEqual, "REDFISH")
+			So(c, ShouldEqual, redfish)
+		})
+
+		Convey("无匹配时回退到默认采集器", func() {
+			name, c, err := AutoSelect(&Base{IsVM: true, Manufacturer: "Dell Inc."})
+			So(err, ShouldBeNil)
+			So(name, ShouldEqual, DefaultCollector)
+			So(c, ShouldEqual, bootos)
+
+			name, _, _ = AutoSelect(&Base{Manufacturer: "Huawei"})
+			So(name, ShouldEqual, DefaultCollector)
+
+			name, _, _ = AutoSelect(nil)
+			So(name, ShouldEqual, DefaultCollector)
+		})
+	})
+}
@@ -0,0 +1,224 @@
+// Package smbios 解析sysfs中导出的SMBIOS/DMI表，支持SMBIOS 2.x及3.x。
+package smbios
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+)
+
+// DefaultRoot 内核导出SMBIOS表的sysfs目录
+const DefaultRoot = "/sys/firmware/dmi/tables"
+
+const (
+	// entryPointFile 入口点结构文件
+	entryPointFile = "smbios_entry_point"
+	// tableFile 结构表文件
+	tableFile = "DMI"
+	// endOfTable 表结束结构类型
+	endOfTable = 127
+)
+
+var (
+	// ErrInvalidEntryPoint 无法识别的入口点结构
+	ErrInvalidEntryPoint = errors.New("invalid smbios entry point")
+	// ErrChecksum 入口点结构校验和错误
+	ErrChecksum = errors.New("smbios entry point checksum mismatch")
+	// ErrTruncated 结构表内容不完整
+	ErrTruncated = errors.New("smbios structure table truncated")
+)
+
+// EntryPoint SMBIOS入口点
+type EntryPoint struct {
+	Major         int    // SMBIOS主版本号
+	Minor         int    // SMBIOS次版本号
+	TableAddress  uint64 // 结构表物理地址
+	TableLength   int    // 结构表长度（3.x为最大长度）
+	NumStructures int    // 结构数量（仅2.x）
+}
+
+// Version 返回形如"3.0"的SMBIOS版本
+func (ep *EntryPoint) Version() string {
+	return fmt.Sprintf("%d.%d", ep.Major, ep.Minor)
+}
+
+// ParseEntryPoint 解析32位（_SM_）或64位（_SM3_）入口点结构
+func ParseEntryPoint(data []byte) (*EntryPoint, error) {
+	switch {
+	case bytes.HasPrefix(data, []byte("_SM3_")):
+		if len(data) < 0x18 || len(data) < int(data[0x06]) {
+			return nil, ErrInvalidEntryPoint
+		}
+		if checksum(data[:data[0x06]]) != 0 {
+			return nil, ErrChecksum
+		}
+		return &EntryPoint{
+			Major:        int(data[0x07]),
+			Minor:        int(data[0x08]),
+			TableLength:  int(binary.LittleEndian.Uint32(data[0x0C:])),
+			TableAddress: binary.LittleEndian.Uint64(data[0x10:]),
+		}, nil
+	case bytes.HasPrefix(data, []byte("_SM_")):
+		if len(data) < 0x1F || len(data) < int(data[0x05]) {
+			return nil, ErrInvalidEntryPoint
+		}
+		if checksum(data[:data[0x05]]) != 0 || checksum(data[0x10:0x1F]) != 0 {
+			return nil, ErrChecksum
+		}
+		if !bytes.Equal(data[0x10:0x15], []byte("_DMI_")) {
+			return nil, ErrInvalidEntryPoint
+		}
+		return &EntryPoint{
+			Major:         int(data[0x06]),
+			Minor:         int(data[0x07]),
+			TableLength:   int(binary.LittleEndian.Uint16(data[0x16:])),
+			TableAddress:  uint64(binary.LittleEndian.Uint32(data[0x18:])),
+			NumStructures: int(binary.LittleEndian.Uint16(data[0x1C:])),
+		}, nil
+	}
+	return nil, ErrInvalidEntryPoint
+}
+
+func checksum(data []byte) (sum byte) {
+	for _, b := range data {
+		sum += b
+	}
+	return sum
+}
+
+// Structure SMBIOS结构
+type Structure struct {
+	Type      uint8
+	Handle    uint16
+	Formatted []byte   // 格式化区域，含4字节结构头。
+	Strings   []string // 字符串集合
+}
+
+// byte 返回偏移量处的字节，超出格式化区域时返回0。
+func (s *Structure) byte(off int) uint8 {
+	if off >= len(s.Formatted) {
+		return 0
+	}
+	return s.Formatted[off]
+}
+
+// word 返回偏移量处的WORD，超出格式化区域时返回0。
+func (s *Structure) word(off int) uint16 {
+	if off+2 > len(s.Formatted) {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(s.Formatted[off:])
+}
+
+// dword 返回偏移量处的DWORD，超出格式化区域时返回0。
+func (s *Structure) dword(off int) uint32 {
+	if off+4 > len(s.Formatted) {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(s.Formatted[off:])
+}
+
+// qword 返回偏移量处的QWORD，超出格式化区域时返回0。
+func (s *Structure) qword(off int) uint64 {
+	if off+8 > len(s.Formatted) {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(s.Formatted[off:])
+}
+
+// has 返回格式化区域是否包含自偏移量起的n个字节。用于区分不同SMBIOS版本中长度不同的结构。
+func (s *Structure) has(off, n int) bool {
+	return off+n <= len(s.Formatted)
+}
+
+// str 返回偏移量处的字符串编号所引用的字符串。编号为0或越界时返回空字符串。
+func (s *Structure) str(off int) string {
+	i := int(s.byte(off))
+	if i == 0 || i > len(s.Strings) {
+		return ""
+	}
+	return s.Strings[i-1]
+}
+
+// ParseStructures 解析结构表
+func ParseStructures(data []byte) ([]*Structure, error) {
+	var items []*Structure
+	for len(data) >= 4 {
+		length := int(data[1])
+		if length < 4 || length > len(data) {
+			return items, ErrTruncated
+		}
+		s := Structure{
+			Type:      data[0],
+			Handle:    binary.LittleEndian.Uint16(data[2:]),
+			Formatted: data[:length],
+		}
+		// 字符串集合以两个连续的0字节结束
+		end := bytes.Index(data[length:], []byte{0, 0})
+		if end < 0 {
+			return items, ErrTruncated
+		}
+		for _, str := range bytes.Split(data[length:length+end], []byte{0}) {
+			if len(str) > 0 {
+				s.Strings = append(s.Strings, string(bytes.TrimSpace(str)))
+			}
+		}
+		items = append(items, &s)
+		data = data[length+end+2:]
+		if s.Type == endOfTable {
+			break
+		}
+	}
+	return items, nil
+}
+
+// Table SMBIOS表
+type Table struct {
+	EntryPoint *EntryPoint
+	Structures []*Structure
+}
+
+// Open 读取并解析root目录下的smbios_entry_point及DMI文件。root为空时使用DefaultRoot。
+func Open(root string) (*Table, error) {
+	if root == "" {
+		root = DefaultRoot
+	}
+	ep, err := ioutil.ReadFile(filepath.Join(root, entryPointFile))
+	if err != nil {
+		return nil, err
+	}
+	dmi, err := ioutil.ReadFile(filepath.Join(root, tableFile))
+	if err != nil {
+		return nil, err
+	}
+	return Parse(ep, dmi)
+}
+
+// Parse 解析入口点结构及结构表
+func Parse(entryPoint, dmi []byte) (*Table, error) {
+	ep, err := ParseEntryPoint(entryPoint)
+	if err != nil {
+		return nil, err
+	}
+	if ep.TableLength > 0 && ep.TableLength < len(dmi) {
+		dmi = dmi[:ep.TableLength]
+	}
+	structures, err := ParseStructures(dmi)
+	if err != nil {
+		return nil, err
+	}
+	return &Table{EntryPoint: ep, Structures: structures}, nil
+}
+
+// filter 返回指定类型的结构
+func (t *Table) filter(typ uint8) (items []*Structure) {
+	for _, s := range t.Structures {
+		if s.Type == typ {
+			items = append(items, s)
+		}
+	}
+	return items
+}
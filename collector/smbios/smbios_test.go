@@ -0,0 +1,222 @@
+package smbios
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParseEntryPoint(t *testing.T) {
+	Convey("解析入口点结构", t, func() {
+		Convey("无法识别的入口点", func() {
+			_, err := ParseEntryPoint([]byte("hello world"))
+			So(err, ShouldEqual, ErrInvalidEntryPoint)
+		})
+
+		Convey("校验和错误", func() {
+			data := []byte("_SM3_\x00\x18\x03\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00")
+			_, err := ParseEntryPoint(data)
+			So(err, ShouldEqual, ErrChecksum)
+		})
+	})
+}
+
+func TestParseStructures(t *testing.T) {
+	Convey("解析结构表", t, func() {
+		Convey("结构长度越界", func() {
+			_, err := ParseStructures([]byte{0x00, 0x20, 0x00, 0x00, 0x01})
+			So(err, ShouldEqual, ErrTruncated)
+		})
+
+		Convey("字符串集合未结束", func() {
+			_, err := ParseStructures([]byte{0x7F, 0x04, 0x00, 0x7F, 'a', 0x00})
+			So(err, ShouldEqual, ErrTruncated)
+		})
+
+		Convey("无字符串的结构", func() {
+			items, err := ParseStructures([]byte{0x7F, 0x04, 0x00, 0x7F, 0x00, 0x00})
+			So(err, ShouldBeNil)
+			So(len(items), ShouldEqual, 1)
+			So(items[0].Type, ShouldEqual, endOfTable)
+			So(items[0].Handle, ShouldEqual, 0x7F00)
+			So(items[0].Strings, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestBIOSROMSize(t *testing.T) {
+	Convey("BIOS ROM大小", t, func() {
+		bios := func(formatted ...byte) *BIOS {
+			return (&Table{Structures: []*Structure{{Type: TypeBIOS, Formatted: formatted}}}).BIOS()
+		}
+		header := []byte{TypeBIOS, 0x12, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+
+		So(bios(append(header, 0x3F)...).ROMSize, ShouldEqual, 4<<20)
+		So(bios(append(header, 0xFE)...).ROMSize, ShouldEqual, 255*64<<10)
+
+		Convey("3.1之前的结构", func() {
+			So(bios(append(header, 0xFF)...).ROMSize, ShouldEqual, 16<<20)
+		})
+
+		Convey("扩展ROM大小", func() {
+			ext := make([]byte, 0x1A)
+			copy(ext, append(header, 0xFF))
+			ext[0x18], ext[0x19] = 0x20, 0x00 // 32MB
+			So(bios(ext...).ROMSize, ShouldEqual, 32<<20)
+			ext[0x18], ext[0x19] = 0x02, 0x40 // 2GB
+			So(bios(ext...).ROMSize, ShouldEqual, 2<<30)
+		})
+	})
+}
+
+func TestTable(t *testing.T) {
+	for _, dir := range []string{"dmi2", "dmi3"} {
+		Convey("解析"+dir+"结构表", t, func() {
+			tbl, err := Open("./testdata/" + dir)
+			So(err, ShouldBeNil)
+			if dir == "dmi3" {
+				So(tbl.EntryPoint.Version(), ShouldEqual, "3.0")
+			} else {
+				So(tbl.EntryPoint.Version(), ShouldEqual, "2.8")
+				So(tbl.EntryPoint.NumStructures, ShouldEqual, len(tbl.Structures))
+			}
+
+			Convey("BIOS", func() {
+				bios := tbl.BIOS()
+				So(bios, ShouldNotBeNil)
+				So(bios.Vendor, ShouldEqual, "Dell Inc.")
+				So(bios.Version, ShouldEqual, "2.8.0")
+				So(bios.ReleaseDate, ShouldEqual, "06/26/2018")
+				So(bios.ROMSize, ShouldEqual, 16<<20)
+				So(bios.Characteristics, ShouldContain, "PCI is supported")
+				So(bios.Characteristics, ShouldContain, "BIOS is upgradeable")
+				So(bios.Characteristics, ShouldContain, "USB legacy is supported")
+				So(bios.Characteristics, ShouldContain, "UEFI is supported")
+				So(bios.Characteristics, ShouldNotContain, "System is a virtual machine")
+			})
+
+			Convey("系统", func() {
+				sys := tbl.System()
+				So(sys, ShouldNotBeNil)
+				So(sys.Manufacturer, ShouldEqual, "Dell Inc.")
+				So(sys.ProductName, ShouldEqual, "PowerEdge R730")
+				So(sys.SerialNumber, ShouldEqual, "FK2F47K")
+				So(sys.UUID, ShouldEqual, "4C4C4544-004B-3710-804B-B4C04F464B32")
+				So(sys.Family, ShouldEqual, "PowerEdge")
+			})
+
+			Convey("主板", func() {
+				boards := tbl.Baseboards()
+				So(len(boards), ShouldEqual, 1)
+				So(boards[0].ProductName, ShouldEqual, "072T6D")
+				So(boards[0].Version, ShouldEqual, "A08")
+				So(boards[0].SerialNumber, ShouldEqual, "..CN7475164K0187.")
+			})
+
+			Convey("机箱", func() {
+				chassis := tbl.Chassis()
+				So(chassis, ShouldNotBeNil)
+				So(chassis.Type, ShouldEqual, ChassisRackMount)
+				So(chassis.Lock, ShouldBeFalse)
+				So(chassis.SerialNumber, ShouldEqual, "FK2F47K")
+				So(chassis.Height, ShouldEqual, 2)
+			})
+
+			Convey("处理器", func() {
+				cpus := tbl.Processors()
+				So(len(cpus), ShouldEqual, 3)
+				So(cpus[0].SocketDesignation, ShouldEqual, "CPU1")
+				So(cpus[0].Type, ShouldEqual, "Central Processor")
+				So(cpus[0].Family, ShouldEqual, "Xeon")
+				So(cpus[0].Version, ShouldEqual, "Intel(R) Xeon(R) CPU E5-2620 v4 @ 2.10GHz")
+				So(cpus[0].Voltage, ShouldEqual, "1.8 V")
+				So(cpus[0].MaxSpeed, ShouldEqual, 4000)
+				So(cpus[0].CurrentSpeed, ShouldEqual, 2100)
+				So(cpus[0].Populated, ShouldBeTrue)
+				So(cpus[0].Enabled, ShouldBeTrue)
+				So(cpus[0].CoreCount, ShouldEqual, 8)
+				So(cpus[0].CoreEnabled, ShouldEqual, 8)
+				So(cpus[0].ThreadCount, ShouldEqual, 16)
+				So(cpus[1].L3CacheHandle, ShouldEqual, 0x0712)
+				So(cpus[2].Populated, ShouldBeFalse)
+			})
+
+			Convey("缓存", func() {
+				caches := tbl.Caches()
+				So(len(caches), ShouldEqual, 6)
+				So(caches[2].Handle, ShouldEqual, 0x0702)
+				So(caches[2].Level, ShouldEqual, 3)
+				So(caches[2].Enabled, ShouldBeTrue)
+				So(caches[2].InstalledSize, ShouldEqual, 20480*1024)
+			})
+
+			Convey("系统插槽", func() {
+				slots := tbl.SystemSlots()
+				So(len(slots), ShouldEqual, 2)
+				So(slots[0].Designation, ShouldEqual, "PCIe Slot 1")
+				So(slots[0].Type, ShouldEqual, "x8 PCI Express 3 x16")
+				So(slots[0].CurrentUsage, ShouldEqual, "In Use")
+				So(slots[0].Length, ShouldEqual, "Long")
+				So(slots[0].ID, ShouldEqual, 1)
+				So(slots[0].BusAddress, ShouldEqual, "0000:04:00.0")
+				So(slots[1].CurrentUsage, ShouldEqual, "Available")
+				So(slots[1].BusAddress, ShouldBeEmpty)
+			})
+
+			Convey("内存", func() {
+				arrays := tbl.MemoryArrays()
+				So(len(arrays), ShouldEqual, 1)
+				So(arrays[0].Use, ShouldEqual, MemoryArrayUseSystem)
+				So(arrays[0].MaximumCapacity, ShouldEqual, int64(1536)<<30)
+				So(arrays[0].NumberOfDevices, ShouldEqual, 3)
+
+				mems := tbl.MemoryDevices()
+				So(len(mems), ShouldEqual, 3)
+				So(mems[0].Locator, ShouldEqual, "A1")
+				So(mems[0].Size, ShouldEqual, int64(16384)<<20)
+				So(mems[0].Type, ShouldEqual, "DDR4")
+				So(mems[0].Speed, ShouldEqual, 2400)
+				So(mems[0].ConfiguredSpeed, ShouldEqual, 2133)
+				So(mems[0].ConfiguredVoltage, ShouldEqual, 1200)
+				So(mems[0].Manufacturer, ShouldEqual, "00CE00B300CE")
+				So(mems[0].PartNumber, ShouldEqual, "M393A2G40EB1-CRC")
+				So(mems[1].Size, ShouldEqual, int64(65536)<<20)
+				So(mems[2].Size, ShouldEqual, 0)
+			})
+
+			Convey("电源", func() {
+				psus := tbl.PowerSupplies()
+				So(len(psus), ShouldEqual, 2)
+				So(psus[0].Location, ShouldEqual, "PSU1")
+				So(psus[0].Manufacturer, ShouldEqual, "DELL")
+				So(psus[0].ModelPartNumber, ShouldEqual, "0Y9VFCA01")
+				So(psus[0].Revision, ShouldEqual, "00.0D.77")
+				So(psus[0].MaxPowerCapacity, ShouldEqual, 750)
+				So(psus[0].HotReplaceable, ShouldBeTrue)
+				So(psus[0].Present, ShouldBeTrue)
+				So(psus[0].Plugged, ShouldBeTrue)
+				So(psus[0].InputVoltageRangeSwitching, ShouldEqual, "Auto-switch")
+				So(psus[0].Status, ShouldEqual, "OK")
+				So(psus[1].Present, ShouldBeFalse)
+				So(psus[1].Plugged, ShouldBeFalse)
+				So(psus[1].MaxPowerCapacity, ShouldEqual, 0)
+			})
+
+			Convey("板载设备", func() {
+				devs := tbl.OnboardDevices()
+				So(len(devs), ShouldEqual, 2)
+				So(devs[0].ReferenceDesignation, ShouldEqual, "Integrated NIC 1")
+				So(devs[0].Type, ShouldEqual, "Ethernet")
+				So(devs[0].Enabled, ShouldBeTrue)
+				So(devs[0].BusAddress, ShouldEqual, "0000:01:00.0")
+				So(devs[1].Type, ShouldEqual, "SAS Controller")
+				So(devs[1].Enabled, ShouldBeFalse)
+			})
+		})
+	}
+
+	Convey("结构表文件不存在", t, func() {
+		_, err := Open("./testdata/nonexistent")
+		So(err, ShouldNotBeNil)
+	})
+}
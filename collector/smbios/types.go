@@ -0,0 +1,644 @@
+package smbios
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 结构类型
+const (
+	TypeBIOS             = 0
+	TypeSystem           = 1
+	TypeBaseboard        = 2
+	TypeChassis          = 3
+	TypeProcessor        = 4
+	TypeCache            = 7
+	TypeSystemSlot       = 9
+	TypeMemoryArray      = 16
+	TypeMemoryDevice     = 17
+	TypePowerSupply      = 39
+	TypeOnboardDeviceExt = 41
+)
+
+// BIOS BIOS信息（类型0）
+type BIOS struct {
+	Vendor          string
+	Version         string
+	ReleaseDate     string
+	ROMSize         int64 // 单位Byte
+	Characteristics []string
+}
+
+var biosCharacteristics = map[uint]string{
+	4:  "ISA is supported",
+	5:  "MCA is supported",
+	6:  "EISA is supported",
+	7:  "PCI is supported",
+	8:  "PC Card (PCMCIA) is supported",
+	9:  "PNP is supported",
+	10: "APM is supported",
+	11: "BIOS is upgradeable",
+	12: "BIOS shadowing is allowed",
+	13: "VLB is supported",
+	14: "ESCD support is available",
+	15: "Boot from CD is supported",
+	16: "Selectable boot is supported",
+	17: "BIOS ROM is socketed",
+	18: "Boot from PC Card (PCMCIA) is supported",
+	19: "EDD is supported",
+	26: "Print screen service is supported (int 5h)",
+	27: "8042 keyboard services are supported (int 9h)",
+	28: "Serial services are supported (int 14h)",
+	29: "Printer services are supported (int 17h)",
+	30: "CGA/mono video services are supported (int 10h)",
+	31: "NEC PC-98",
+}
+
+var biosCharacteristicsExt = [2][8]string{
+	{"ACPI is supported", "USB legacy is supported", "AGP is supported", "I2O boot is supported",
+		"LS-120 boot is supported", "ATAPI Zip drive boot is supported", "IEEE 1394 boot is supported", "Smart battery is supported"},
+	{"BIOS boot specification is supported", "Function key-initiated network boot is supported",
+		"Targeted content distribution is supported", "UEFI is supported", "System is a virtual machine"},
+}
+
+// BIOS 返回BIOS信息，不存在时返回nil。
+func (t *Table) BIOS() *BIOS {
+	items := t.filter(TypeBIOS)
+	if len(items) == 0 {
+		return nil
+	}
+	s := items[0]
+	bios := BIOS{
+		Vendor:      s.str(0x04),
+		Version:     s.str(0x05),
+		ReleaseDate: s.str(0x08),
+		ROMSize:     (int64(s.byte(0x09)) + 1) * 64 * 1024,
+	}
+	if s.byte(0x09) == 0xFF && s.has(0x18, 2) {
+		// 3.1起ROM大于16MB时使用扩展ROM大小
+		ext := s.word(0x18)
+		size := int64(ext & 0x3FFF)
+		switch ext >> 14 {
+		case 0:
+			bios.ROMSize = size << 20
+		case 1:
+			bios.ROMSize = size << 30
+		}
+	}
+	chars := s.qword(0x0A)
+	if chars&(1<<3) == 0 {
+		for bit := uint(4); bit < 32; bit++ {
+			if name, ok := biosCharacteristics[bit]; ok && chars&(1<<bit) != 0 {
+				bios.Characteristics = append(bios.Characteristics, name)
+			}
+		}
+	}
+	for i := range biosCharacteristicsExt {
+		if !s.has(0x12+i, 1) {
+			break
+		}
+		ext := s.byte(0x12 + i)
+		for bit, name := range biosCharacteristicsExt[i] {
+			if name != "" && ext&(1<<uint(bit)) != 0 {
+				bios.Characteristics = append(bios.Characteristics, name)
+			}
+		}
+	}
+	return &bios
+}
+
+// System 系统信息（类型1）
+type System struct {
+	Manufacturer string
+	ProductName  string
+	Version      string
+	SerialNumber string
+	UUID         string
+	SKUNumber    string
+	Family       string
+}
+
+// System 返回系统信息，不存在时返回nil。
+func (t *Table) System() *System {
+	items := t.filter(TypeSystem)
+	if len(items) == 0 {
+		return nil
+	}
+	s := items[0]
+	sys := System{
+		Manufacturer: s.str(0x04),
+		ProductName:  s.str(0x05),
+		Version:      s.str(0x06),
+		SerialNumber: s.str(0x07),
+		SKUNumber:    s.str(0x19),
+		Family:       s.str(0x1A),
+	}
+	if s.has(0x08, 16) {
+		sys.UUID = formatUUID(s.Formatted[0x08:0x18], t.EntryPoint)
+	}
+	return &sys
+}
+
+// formatUUID 格式化UUID。自SMBIOS 2.6起，前三个字段以小端字节序存储。
+func formatUUID(b []byte, ep *EntryPoint) string {
+	if ep != nil && (ep.Major > 2 || ep.Major == 2 && ep.Minor >= 6) {
+		return fmt.Sprintf("%02X%02X%02X%02X-%02X%02X-%02X%02X-%02X%02X-%02X%02X%02X%02X%02X%02X",
+			b[3], b[2], b[1], b[0], b[5], b[4], b[7], b[6], b[8], b[9], b[10], b[11], b[12], b[13], b[14], b[15])
+	}
+	return fmt.Sprintf("%02X%02X%02X%02X-%02X%02X-%02X%02X-%02X%02X-%02X%02X%02X%02X%02X%02X",
+		b[0], b[1], b[2], b[3], b[4], b[5], b[6], b[7], b[8], b[9], b[10], b[11], b[12], b[13], b[14], b[15])
+}
+
+// Baseboard 主板信息（类型2）
+type Baseboard struct {
+	Manufacturer string
+	ProductName  string
+	Version      string
+	SerialNumber string
+	AssetTag     string
+}
+
+// Baseboards 返回主板信息
+func (t *Table) Baseboards() (items []*Baseboard) {
+	for _, s := range t.filter(TypeBaseboard) {
+		items = append(items, &Baseboard{
+			Manufacturer: s.str(0x04),
+			ProductName:  s.str(0x05),
+			Version:      s.str(0x06),
+			SerialNumber: s.str(0x07),
+			AssetTag:     s.str(0x08),
+		})
+	}
+	return items
+}
+
+// 机箱类型
+const (
+	ChassisRackMount = 0x17
+	ChassisBlade     = 0x1C
+)
+
+// Chassis 机箱信息（类型3）
+type Chassis struct {
+	Manufacturer string
+	Type         int // 机箱类型，如0x17表示机架式，0x1C表示刀片。
+	Lock         bool
+	Version      string
+	SerialNumber string
+	AssetTag     string
+	Height       int // 高度（U数），0表示未指定。
+}
+
+// Chassis 返回机箱信息，不存在时返回nil。
+func (t *Table) Chassis() *Chassis {
+	items := t.filter(TypeChassis)
+	if len(items) == 0 {
+		return nil
+	}
+	s := items[0]
+	return &Chassis{
+		Manufacturer: s.str(0x04),
+		Type:         int(s.byte(0x05) & 0x7F),
+		Lock:         s.byte(0x05)&0x80 != 0,
+		Version:      s.str(0x06),
+		SerialNumber: s.str(0x07),
+		AssetTag:     s.str(0x08),
+		Height:       int(s.byte(0x11)),
+	}
+}
+
+// Processor 处理器信息（类型4）
+type Processor struct {
+	SocketDesignation string
+	Type              string
+	Family            string
+	Manufacturer      string
+	ID                uint64
+	Version           string
+	Voltage           string // 如"1.8 V"
+	ExternalClock     int    // 单位MHz
+	MaxSpeed          int    // 单位MHz
+	CurrentSpeed      int    // 单位MHz
+	Populated         bool
+	Enabled           bool
+	L1CacheHandle     uint16
+	L2CacheHandle     uint16
+	L3CacheHandle     uint16
+	SerialNumber      string
+	AssetTag          string
+	PartNumber        string
+	CoreCount         int
+	CoreEnabled       int
+	ThreadCount       int
+}
+
+var processorTypes = map[uint8]string{
+	1: "Other",
+	2: "Unknown",
+	3: "Central Processor",
+	4: "Math Processor",
+	5: "DSP Processor",
+	6: "Video Processor",
+}
+
+// processorFamilies 常见的处理器系列
+var processorFamilies = map[uint16]string{
+	0x01:  "Other",
+	0x02:  "Unknown",
+	0x83:  "Athlon 64",
+	0x84:  "Opteron",
+	0x6B:  "Zen",
+	0xB3:  "Xeon",
+	0xC6:  "Core i7",
+	0xCD:  "Core i5",
+	0xCE:  "Core i3",
+	0x100: "ARMv7",
+	0x101: "ARMv8",
+}
+
+// Processors 返回处理器信息
+func (t *Table) Processors() (items []*Processor) {
+	for _, s := range t.filter(TypeProcessor) {
+		family := uint16(s.byte(0x06))
+		if family == 0xFE && s.has(0x28, 2) {
+			family = s.word(0x28)
+		}
+		p := Processor{
+			SocketDesignation: s.str(0x04),
+			Type:              processorTypes[s.byte(0x05)],
+			Family:            processorFamilies[family],
+			Manufacturer:      s.str(0x07),
+			ID:                s.qword(0x08),
+			Version:           s.str(0x10),
+			Voltage:           processorVoltage(s.byte(0x11)),
+			ExternalClock:     int(s.word(0x12)),
+			MaxSpeed:          int(s.word(0x14)),
+			CurrentSpeed:      int(s.word(0x16)),
+			Populated:         s.byte(0x18)&0x40 != 0,
+			Enabled:           s.byte(0x18)&0x07 == 1,
+			L1CacheHandle:     s.word(0x1A),
+			L2CacheHandle:     s.word(0x1C),
+			L3CacheHandle:     s.word(0x1E),
+			SerialNumber:      s.str(0x20),
+			AssetTag:          s.str(0x21),
+			PartNumber:        s.str(0x22),
+			CoreCount:         int(s.byte(0x23)),
+			CoreEnabled:       int(s.byte(0x24)),
+			ThreadCount:       int(s.byte(0x25)),
+		}
+		// 3.0起核心数、线程数超过255时使用扩展字段
+		if p.CoreCount == 0xFF && s.has(0x2A, 2) {
+			p.CoreCount = int(s.word(0x2A))
+		}
+		if p.CoreEnabled == 0xFF && s.has(0x2C, 2) {
+			p.CoreEnabled = int(s.word(0x2C))
+		}
+		if p.ThreadCount == 0xFF && s.has(0x2E, 2) {
+			p.ThreadCount = int(s.word(0x2E))
+		}
+		items = append(items, &p)
+	}
+	return items
+}
+
+// processorVoltage 返回处理器电压
+func processorVoltage(v uint8) string {
+	if v&0x80 != 0 {
+		return fmt.Sprintf("%.1f V", float64(v&0x7F)/10)
+	}
+	var legacy []string
+	for i, name := range []string{"5.0 V", "3.3 V", "2.9 V"} {
+		if v&(1<<uint(i)) != 0 {
+			legacy = append(legacy, name)
+		}
+	}
+	return strings.Join(legacy, " ")
+}
+
+// Cache 缓存信息（类型7）
+type Cache struct {
+	Handle            uint16
+	SocketDesignation string
+	Level             int
+	Enabled           bool
+	MaximumSize       int64 // 单位Byte
+	InstalledSize     int64 // 单位Byte
+}
+
+// Caches 返回缓存信息
+func (t *Table) Caches() (items []*Cache) {
+	for _, s := range t.filter(TypeCache) {
+		conf := s.word(0x05)
+		c := Cache{
+			Handle:            s.Handle,
+			SocketDesignation: s.str(0x04),
+			Level:             int(conf&0x07) + 1,
+			Enabled:           conf&0x80 != 0,
+			MaximumSize:       cacheSize(uint32(s.word(0x07)), 15),
+			InstalledSize:     cacheSize(uint32(s.word(0x09)), 15),
+		}
+		// 3.1起缓存大于2047MB时使用扩展字段
+		if s.word(0x07) == 0xFFFF && s.has(0x13, 4) {
+			c.MaximumSize = cacheSize(s.dword(0x13), 31)
+		}
+		if s.word(0x09) == 0xFFFF && s.has(0x17, 4) {
+			c.InstalledSize = cacheSize(s.dword(0x17), 31)
+		}
+		items = append(items, &c)
+	}
+	return items
+}
+
+// cacheSize 返回缓存大小。粒度位为1时单位为64KB，否则为1KB。
+func cacheSize(v uint32, granularityBit uint) int64 {
+	size := int64(v & (1<<granularityBit - 1))
+	if v&(1<<granularityBit) != 0 {
+		return size * 64 * 1024
+	}
+	return size * 1024
+}
+
+// SystemSlot 系统插槽信息（类型9）
+type SystemSlot struct {
+	Designation  string
+	Type         string
+	CurrentUsage string
+	Length       string
+	ID           int
+	BusAddress   string // PCI总线地址，如"0000:04:00.0"。
+}
+
+var slotTypes = map[uint8]string{
+	0x06: "PCI",
+	0xA5: "PCI Express",
+	0xA6: "PCI Express x1",
+	0xA7: "PCI Express x2",
+	0xA8: "PCI Express x4",
+	0xA9: "PCI Express x8",
+	0xAA: "PCI Express x16",
+	0xAB: "PCI Express 2",
+	0xAC: "PCI Express 2 x1",
+	0xAD: "PCI Express 2 x2",
+	0xAE: "PCI Express 2 x4",
+	0xAF: "PCI Express 2 x8",
+	0xB0: "PCI Express 2 x16",
+	0xB1: "PCI Express 3",
+	0xB2: "PCI Express 3 x1",
+	0xB3: "PCI Express 3 x2",
+	0xB4: "PCI Express 3 x4",
+	0xB5: "PCI Express 3 x8",
+	0xB6: "PCI Express 3 x16",
+	0xB8: "PCI Express 4",
+	0xB9: "PCI Express 4 x1",
+	0xBA: "PCI Express 4 x2",
+	0xBB: "PCI Express 4 x4",
+	0xBC: "PCI Express 4 x8",
+	0xBD: "PCI Express 4 x16",
+}
+
+var slotWidths = map[uint8]string{
+	0x08: "x1",
+	0x09: "x2",
+	0x0A: "x4",
+	0x0B: "x8",
+	0x0C: "x16",
+	0x0D: "x32",
+}
+
+var slotUsages = map[uint8]string{
+	1: "Other",
+	2: "Unknown",
+	3: "Available",
+	4: "In Use",
+	5: "Unavailable",
+}
+
+var slotLengths = map[uint8]string{
+	1: "Other",
+	2: "Unknown",
+	3: "Short",
+	4: "Long",
+	5: "2.5\" drive form factor",
+	6: "3.5\" drive form factor",
+}
+
+// SystemSlots 返回系统插槽信息
+func (t *Table) SystemSlots() (items []*SystemSlot) {
+	for _, s := range t.filter(TypeSystemSlot) {
+		typ := slotTypes[s.byte(0x05)]
+		if width := slotWidths[s.byte(0x06)]; width != "" && typ != "" {
+			typ = width + " " + typ
+		}
+		slot := SystemSlot{
+			Designation:  s.str(0x04),
+			Type:         typ,
+			CurrentUsage: slotUsages[s.byte(0x07)],
+			Length:       slotLengths[s.byte(0x08)],
+			ID:           int(s.word(0x09)),
+		}
+		if s.has(0x0D, 4) {
+			slot.BusAddress = busAddress(s.word(0x0D), s.byte(0x0F), s.byte(0x10))
+		}
+		items = append(items, &slot)
+	}
+	return items
+}
+
+// busAddress 返回PCI总线地址。各字段均为0xFF时表示不适用，返回空字符串。
+func busAddress(segment uint16, bus, devfn uint8) string {
+	if segment == 0xFFFF && bus == 0xFF && devfn == 0xFF {
+		return ""
+	}
+	return fmt.Sprintf("%04x:%02x:%02x.%x", segment, bus, devfn>>3, devfn&0x07)
+}
+
+// MemoryArray 物理内存阵列信息（类型16）
+type MemoryArray struct {
+	Use             int
+	MaximumCapacity int64 // 单位Byte
+	NumberOfDevices int
+}
+
+// MemoryArrayUseSystem 用途-系统内存
+const MemoryArrayUseSystem = 3
+
+// MemoryArrays 返回物理内存阵列信息
+func (t *Table) MemoryArrays() (items []*MemoryArray) {
+	for _, s := range t.filter(TypeMemoryArray) {
+		a := MemoryArray{
+			Use:             int(s.byte(0x05)),
+			MaximumCapacity: int64(s.dword(0x07)) * 1024,
+			NumberOfDevices: int(s.word(0x0D)),
+		}
+		if s.dword(0x07) == 0x80000000 && s.has(0x0F, 8) {
+			a.MaximumCapacity = int64(s.qword(0x0F))
+		}
+		items = append(items, &a)
+	}
+	return items
+}
+
+// MemoryDevice 内存设备信息（类型17）
+type MemoryDevice struct {
+	Locator           string
+	BankLocator       string
+	Size              int64 // 单位Byte，0表示未安装内存条。
+	Type              string
+	Speed             int // 单位MT/s
+	ConfiguredSpeed   int // 单位MT/s
+	Manufacturer      string
+	SerialNumber      string
+	AssetTag          string
+	PartNumber        string
+	ConfiguredVoltage int // 单位mV
+}
+
+var memoryTypes = map[uint8]string{
+	0x01: "Other",
+	0x02: "Unknown",
+	0x03: "DRAM",
+	0x0F: "SDRAM",
+	0x12: "DDR",
+	0x13: "DDR2",
+	0x18: "DDR3",
+	0x1A: "DDR4",
+	0x1B: "LPDDR",
+	0x1C: "LPDDR2",
+	0x1D: "LPDDR3",
+	0x1E: "LPDDR4",
+	0x22: "DDR5",
+	0x23: "LPDDR5",
+}
+
+// MemoryDevices 返回内存设备信息
+func (t *Table) MemoryDevices() (items []*MemoryDevice) {
+	for _, s := range t.filter(TypeMemoryDevice) {
+		m := MemoryDevice{
+			Locator:           s.str(0x10),
+			BankLocator:       s.str(0x11),
+			Size:              memorySize(s),
+			Type:              memoryTypes[s.byte(0x12)],
+			Speed:             int(s.word(0x15)),
+			Manufacturer:      s.str(0x17),
+			SerialNumber:      s.str(0x18),
+			AssetTag:          s.str(0x19),
+			PartNumber:        s.str(0x1A),
+			ConfiguredSpeed:   int(s.word(0x20)),
+			ConfiguredVoltage: int(s.word(0x26)),
+		}
+		items = append(items, &m)
+	}
+	return items
+}
+
+// memorySize 返回内存条容量。0x7FFF表示使用扩展容量字段（单位MB），最高位为1时单位为KB。
+func memorySize(s *Structure) int64 {
+	size := s.word(0x0C)
+	switch {
+	case size == 0 || size == 0xFFFF:
+		return 0
+	case size == 0x7FFF && s.has(0x1C, 4):
+		return int64(s.dword(0x1C)&0x7FFFFFFF) << 20
+	case size&0x8000 != 0:
+		return int64(size&0x7FFF) << 10
+	}
+	return int64(size) << 20
+}
+
+// PowerSupply 系统电源信息（类型39）
+type PowerSupply struct {
+	Group                      int
+	Location                   string
+	Name                       string
+	Manufacturer               string
+	SerialNumber               string
+	AssetTag                   string
+	ModelPartNumber            string
+	Revision                   string
+	MaxPowerCapacity           int // 单位W，0表示未知。
+	HotReplaceable             bool
+	Present                    bool
+	Plugged                    bool
+	InputVoltageRangeSwitching string
+	Status                     string
+}
+
+var powerSupplySwitching = map[uint16]string{
+	1: "Other",
+	2: "Unknown",
+	3: "Manual",
+	4: "Auto-switch",
+	5: "Wide Range",
+	6: "N/A",
+}
+
+var powerSupplyStatus = map[uint16]string{
+	1: "Other",
+	2: "Unknown",
+	3: "OK",
+	4: "Non-critical",
+	5: "Critical",
+}
+
+// PowerSupplies 返回系统电源信息
+func (t *Table) PowerSupplies() (items []*PowerSupply) {
+	for _, s := range t.filter(TypePowerSupply) {
+		chars := s.word(0x0E)
+		p := PowerSupply{
+			Group:                      int(s.byte(0x04)),
+			Location:                   s.str(0x05),
+			Name:                       s.str(0x06),
+			Manufacturer:               s.str(0x07),
+			SerialNumber:               s.str(0x08),
+			AssetTag:                   s.str(0x09),
+			ModelPartNumber:            s.str(0x0A),
+			Revision:                   s.str(0x0B),
+			HotReplaceable:             chars&0x01 != 0,
+			Present:                    chars&0x02 != 0,
+			Plugged:                    chars&0x04 == 0,
+			InputVoltageRangeSwitching: powerSupplySwitching[chars>>3&0x0F],
+			Status:                     powerSupplyStatus[chars>>7&0x07],
+		}
+		if capacity := s.word(0x0C); capacity != 0x8000 {
+			p.MaxPowerCapacity = int(capacity)
+		}
+		items = append(items, &p)
+	}
+	return items
+}
+
+// OnboardDevice 板载设备信息（类型41）
+type OnboardDevice struct {
+	ReferenceDesignation string
+	Type                 string
+	Enabled              bool
+	Instance             int
+	BusAddress           string
+}
+
+var onboardDeviceTypes = map[uint8]string{
+	0x01: "Other",
+	0x02: "Unknown",
+	0x03: "Video",
+	0x04: "SCSI Controller",
+	0x05: "Ethernet",
+	0x06: "Token Ring",
+	0x07: "Sound",
+	0x08: "PATA Controller",
+	0x09: "SATA Controller",
+	0x0A: "SAS Controller",
+}
+
+// OnboardDevices 返回板载设备信息
+func (t *Table) OnboardDevices() (items []*OnboardDevice) {
+	for _, s := range t.filter(TypeOnboardDeviceExt) {
+		items = append(items, &OnboardDevice{
+			ReferenceDesignation: s.str(0x04),
+			Type:                 onboardDeviceTypes[s.byte(0x05)&0x7F],
+			Enabled:              s.byte(0x05)&0x80 != 0,
+			Instance:             int(s.byte(0x06)),
+			BusAddress:           busAddress(s.word(0x07), s.byte(0x09), s.byte(0x0A)),
+		})
+	}
+	return items
+}
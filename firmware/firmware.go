@@ -0,0 +1,171 @@
+package firmware
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+const (
+	// BIOS 部件类型-BIOS
+	BIOS = "BIOS"
+	// BMC 部件类型-BMC
+	BMC = "BMC"
+	// RAID 部件类型-RAID控制器
+	RAID = "RAID"
+	// NIC 部件类型-网卡
+	NIC = "NIC"
+	// Backplane 部件类型-背板
+	Backplane = "BACKPLANE"
+)
+
+const (
+	// StatusRunning 固件升级任务执行中
+	StatusRunning = "running"
+	// StatusSucceeded 固件升级任务执行成功
+	StatusSucceeded = "succeeded"
+	// StatusFailed 固件升级任务执行失败
+	StatusFailed = "failed"
+)
+
+// Component 部件固件信息
+type Component struct {
+	Type    string `json:"type"`    // 部件类型
+	ID      string `json:"id"`      // 部件标识，如RAID控制器ID、网卡名称。
+	Name    string `json:"name"`    // 部件名称
+	Version string `json:"version"` // 当前固件版本号
+}
+
+// Progress 固件升级任务进度
+type Progress struct {
+	TaskID  string `json:"task_id"` // 任务ID
+	Status  string `json:"status"`  // 任务状态
+	Percent int    `json:"percent"` // 完成百分比
+	Message string `json:"message"` // 进度描述或出错信息
+}
+
+// Setting 固件配置参数
+type Setting struct {
+	Items []*SettingItem `json:"items"`
+}
+
+// SettingItem 单个部件的固件配置参数
+type SettingItem struct {
+	Type    string `json:"type"`    // 部件类型
+	ID      string `json:"id"`      // 部件标识。为空时匹配该类型的所有部件。
+	Version string `json:"version"` // 预期的固件版本号
+	Image   string `json:"image"`   // 固件镜像下载地址
+}
+
+// PostCheck 检查部件实际的固件版本是否与预期的配置相符
+func PostCheck(components []*Component, sett *Setting) (items []*util.CheckingItem) {
+	if sett == nil {
+		return nil
+	}
+	for _, settItem := range sett.Items {
+		if settItem == nil || settItem.Version == "" {
+			continue
+		}
+		var found bool
+		for _, comp := range components {
+			if comp == nil || !settItem.match(comp) {
+				continue
+			}
+			found = true
+			items = append(items, util.NewCheckingHelper(
+				title(comp.Type, comp.ID), settItem.Version, comp.Version,
+			).Matcher(util.EqualIgnoreCaseMatch).Do())
+		}
+		if !found {
+			items = append(items, &util.CheckingItem{
+				Title:    title(settItem.Type, settItem.ID),
+				Expected: settItem.Version,
+				Matched:  util.MatchedUnknown,
+				Error:    "component not found",
+			})
+		}
+	}
+	return items
+}
+
+// match 判断部件是否为该配置项的作用对象
+func (item *SettingItem) match(comp *Component) bool {
+	if !strings.EqualFold(item.Type, comp.Type) {
+		return false
+	}
+	return item.ID == "" || item.ID == comp.ID
+}
+
+func title(typ, id string) string {
+	if id == "" {
+		return fmt.Sprintf("%s Firmware", strings.ToUpper(typ))
+	}
+	return fmt.Sprintf("%s %s Firmware", strings.ToUpper(typ), id)
+}
+
+// CheckMinimum 检查部件实际的固件版本是否不低于provider要求的最低版本，min的key为部件类型。
+func CheckMinimum(components []*Component, min map[string]string) (items []*util.CheckingItem) {
+	for _, comp := range components {
+		if comp == nil {
+			continue
+		}
+		for typ, version := range min {
+			if !strings.EqualFold(typ, comp.Type) {
+				continue
+			}
+			items = append(items, util.NewCheckingHelper(
+				title(comp.Type, comp.ID)+" Minimum", version, comp.Version,
+			).Matcher(MinVersionMatch).Do())
+		}
+	}
+	return items
+}
+
+// MinVersionMatch 实际版本号不低于预期版本号时视为相符
+func MinVersionMatch(expected, actual string) bool {
+	return actual != "" && CompareVersion(actual, expected) >= 0
+}
+
+// CompareVersion 逐段比较以'.'、'-'分隔的版本号，a小于、等于、大于b时分别返回-1、0、1。
+// 均为数字的版本段按数值比较，否则按字符串比较（大小写不敏感）。
+func CompareVersion(a, b string) int {
+	split := func(r rune) bool { return r == '.' || r == '-' }
+	as, bs := strings.FieldsFunc(a, split), strings.FieldsFunc(b, split)
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y string
+		if i < len(as) {
+			x = as[i]
+		}
+		if i < len(bs) {
+			y = bs[i]
+		}
+		if c := compareSegment(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func compareSegment(x, y string) int {
+	nx, errx := strconv.ParseUint(orZero(x), 10, 64)
+	ny, erry := strconv.ParseUint(orZero(y), 10, 64)
+	if errx == nil && erry == nil {
+		switch {
+		case nx < ny:
+			return -1
+		case nx > ny:
+			return 1
+		}
+		return 0
+	}
+	return strings.Compare(strings.ToLower(x), strings.ToLower(y))
+}
+
+func orZero(s string) string {
+	if s == "" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,76 @@
+package firmware
+
+import (
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPostCheck(t *testing.T) {
+	Convey("固件版本后置检查", t, func() {
+		components := []*Component{
+			{Type: BIOS, Name: "BIOS", Version: "2.12.1"},
+			{Type: RAID, ID: "0", Name: "PERC H730P Mini", Version: "25.5.9.0001"},
+			{Type: NIC, ID: "eth0", Version: "21.80.9"},
+			{Type: NIC, ID: "eth1", Version: "21.60.2"},
+		}
+
+		Convey("配置为空", func() {
+			So(PostCheck(components, nil), ShouldBeEmpty)
+		})
+
+		Convey("版本相符", func() {
+			items := PostCheck(components, &Setting{Items: []*SettingItem{
+				{Type: "bios", Version: "2.12.1"},
+				{Type: RAID, ID: "0", Version: "25.5.9.0001"},
+			}})
+			So(len(items), ShouldEqual, 2)
+			So(items[0].Title, ShouldEqual, "BIOS Firmware")
+			So(items[0].Matched, ShouldEqual, util.MatchedYES)
+			So(items[1].Title, ShouldEqual, "RAID 0 Firmware")
+			So(items[1].Matched, ShouldEqual, util.MatchedYES)
+		})
+
+		Convey("未指定部件标识时检查该类型所有部件", func() {
+			items := PostCheck(components, &Setting{Items: []*SettingItem{
+				{Type: NIC, Version: "21.80.9"},
+			}})
+			So(len(items), ShouldEqual, 2)
+			So(items[0].Matched, ShouldEqual, util.MatchedYES)
+			So(items[1].Matched, ShouldEqual, util.MatchedNO)
+			So(items[1].Actual, ShouldEqual, "21.60.2")
+		})
+
+		Convey("部件不存在", func() {
+			items := PostCheck(components, &Setting{Items: []*SettingItem{
+				{Type: Backplane, Version: "4.35"},
+			}})
+			So(len(items), ShouldEqual, 1)
+			So(items[0].Matched, ShouldEqual, util.MatchedUnknown)
+		})
+	})
+}
+
+func TestCheckMinimum(t *testing.T) {
+	Convey("比较版本号", t, func() {
+		So(CompareVersion("2.12.1", "2.9.10"), ShouldEqual, 1)
+		So(CompareVersion("2.81.81.81", "2.81.81.81"), ShouldEqual, 0)
+		So(CompareVersion("2.1", "2.1.1"), ShouldEqual, -1)
+		So(CompareVersion("4.35", "4.35.0"), ShouldEqual, 0)
+		So(CompareVersion("1.0-a", "1.0-B"), ShouldEqual, -1)
+	})
+
+	Convey("检查最低固件版本", t, func() {
+		items := CheckMinimum([]*Component{
+			{Type: BIOS, Version: "2.12.1"},
+			{Type: NIC, ID: "eth0", Version: "21.60.2"},
+			{Type: BMC, Version: ""},
+		}, map[string]string{"bios": "2.9.0", NIC: "21.80.9", BMC: "2.81.81.81"})
+		So(len(items), ShouldEqual, 3)
+		So(items[0].Title, ShouldEqual, "BIOS Firmware Minimum")
+		So(items[0].Matched, ShouldEqual, util.MatchedYES)
+		So(items[1].Matched, ShouldEqual, util.MatchedNO)
+		So(items[2].Matched, ShouldEqual, util.MatchedNO)
+	})
+}
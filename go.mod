@@ -9,11 +9,10 @@ require (
 	github.com/hashicorp/go-hclog v1.0.0 // indirect
 	github.com/hashicorp/go-plugin v1.4.3
 	github.com/json-iterator/go v1.1.12
-	github.com/licairong/oob-dell v1.0.0
-	github.com/licairong/raid-avago v1.0.2
 	github.com/smartystreets/goconvey v1.7.2
 	golang.org/x/net v0.8.0 // indirect
 	google.golang.org/grpc v1.43.0
 	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v2 v2.2.8
 	idcos.io/cloudboot/hardware/v4 v4.8.15
 )
@@ -0,0 +1,241 @@
+package installer
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/provider"
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// Command 安装命令
+type Command struct {
+	Name string   `json:"name"`
+	Args []string `json:"args"`
+}
+
+func (cmd Command) String() string {
+	return strings.TrimSpace(cmd.Name + " " + strings.Join(quoteAll(cmd.Args), " "))
+}
+
+func command(name string, args ...string) Command {
+	return Command{Name: name, Args: args}
+}
+
+// backend 工具安装方式
+type backend interface {
+	// name 返回安装方式名称
+	name() string
+	// installed 返回工具已安装的版本，未安装时返回空字符串。
+	installed(tool *provider.Tool) string
+	// commands 返回安装工具需执行的命令
+	commands(tool *provider.Tool) []Command
+}
+
+// rpmVersion 通过rpm查询已安装的版本
+func rpmVersion(executor util.Executor, name string) string {
+	output, err := executor.Exec(nil, "rpm", "-q", "--qf", quote("%{VERSION}-%{RELEASE}"), quote(name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// dpkgVersion 通过dpkg查询已安装的版本
+func dpkgVersion(executor util.Executor, name string) string {
+	output, err := executor.Exec(nil, "dpkg-query", "-W", "-f", quote("${Version}"), quote(name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// yum yum/dnf包管理器
+type yum struct {
+	executor util.Executor
+	cmd      string
+	repo     string
+}
+
+func (b *yum) name() string {
+	return b.cmd
+}
+
+func (b *yum) installed(tool *provider.Tool) string {
+	return rpmVersion(b.executor, tool.Name)
+}
+
+func (b *yum) commands(tool *provider.Tool) []Command {
+	args := []string{"-y"}
+	if b.repo != "" {
+		args = append(args, "--disablerepo=*", "--enablerepo="+b.repo)
+	}
+	pkg := tool.Name
+	if tool.Version != "" {
+		pkg += "-" + tool.Version
+	}
+	return []Command{command(b.cmd, append(args, "install", pkg)...)}
+}
+
+// apt apt包管理器
+type apt struct {
+	executor   util.Executor
+	sourceList string
+}
+
+func (b *apt) name() string {
+	return "apt"
+}
+
+func (b *apt) installed(tool *provider.Tool) string {
+	return dpkgVersion(b.executor, tool.Name)
+}
+
+func (b *apt) commands(tool *provider.Tool) []Command {
+	pkg := tool.Name
+	if tool.Version != "" {
+		pkg += "=" + tool.Version
+	}
+	if b.sourceList == "" {
+		return []Command{command("apt-get", "-y", "install", pkg)}
+	}
+	// 离线模式下仅使用本地仓库
+	opts := []string{"-o", "Dir::Etc::SourceList=" + b.sourceList, "-o", "Dir::Etc::SourceParts=-"}
+	return []Command{
+		command("apt-get", append(opts, "update")...),
+		command("apt-get", append(opts, "-y", "install", pkg)...),
+	}
+}
+
+// zypper zypper包管理器
+type zypper struct {
+	executor util.Executor
+	repo     string
+}
+
+func (b *zypper) name() string {
+	return "zypper"
+}
+
+func (b *zypper) installed(tool *provider.Tool) string {
+	return rpmVersion(b.executor, tool.Name)
+}
+
+func (b *zypper) commands(tool *provider.Tool) []Command {
+	args := []string{"--non-interactive", "install"}
+	if b.repo != "" {
+		args = append(args, "--from", b.repo)
+	}
+	pkg := tool.Name
+	if tool.Version != "" {
+		pkg += "=" + tool.Version
+	}
+	return []Command{command("zypper", append(args, pkg)...)}
+}
+
+// rpmURL 通过URL安装的RPM包
+type rpmURL struct {
+	executor util.Executor
+}
+
+func (b *rpmURL) name() string {
+	return "rpm"
+}
+
+func (b *rpmURL) installed(tool *provider.Tool) string {
+	return rpmVersion(b.executor, tool.Name)
+}
+
+func (b *rpmURL) commands(tool *provider.Tool) []Command {
+	return []Command{command("rpm", "-Uvh", "--replacepkgs", tool.DownloadAddr)}
+}
+
+// debURL 通过URL安装的DEB包
+type debURL struct {
+	executor util.Executor
+	tmpDir   string
+}
+
+func (b *debURL) name() string {
+	return "deb"
+}
+
+func (b *debURL) installed(tool *provider.Tool) string {
+	return dpkgVersion(b.executor, tool.Name)
+}
+
+func (b *debURL) commands(tool *provider.Tool) []Command {
+	file := downloadPath(b.tmpDir, tool.DownloadAddr)
+	return []Command{
+		command("curl", "-fsSL", "-o", file, tool.DownloadAddr),
+		command("dpkg", "-i", file),
+	}
+}
+
+// archive 通过URL安装的tarball或二进制文件。
+// 由于没有包数据库，安装后在安装目录中写入版本标记文件用于检测已安装的版本。
+type archive struct {
+	executor util.Executor
+	binDir   string
+	tmpDir   string
+}
+
+func (b *archive) name() string {
+	return "archive"
+}
+
+// marker 返回版本标记文件路径
+func (b *archive) marker(tool *provider.Tool) string {
+	return filepath.Join(b.binDir, fmt.Sprintf(".%s.version", tool.Name))
+}
+
+func (b *archive) installed(tool *provider.Tool) string {
+	output, err := b.executor.Exec(nil, "cat", quote(b.marker(tool)))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+func (b *archive) commands(tool *provider.Tool) []Command {
+	file := downloadPath(b.tmpDir, tool.DownloadAddr)
+	cmds := []Command{
+		command("curl", "-fsSL", "-o", file, tool.DownloadAddr),
+		command("mkdir", "-p", b.binDir),
+	}
+	if isTarball(tool.DownloadAddr) {
+		cmds = append(cmds, command("tar", "-xf", file, "-C", b.binDir))
+	} else {
+		cmds = append(cmds, command("install", "-m", "0755", file, filepath.Join(b.binDir, tool.Name)))
+	}
+	version := tool.Version
+	if version == "" {
+		version = "unknown"
+	}
+	return append(cmds, command("sh", "-c", fmt.Sprintf("echo %s > %s", quote(version), quote(b.marker(tool)))))
+}
+
+func isTarball(addr string) bool {
+	base := strings.ToLower(path.Base(addr))
+	for _, ext := range []string{".tar", ".tar.gz", ".tgz", ".tar.bz2", ".tar.xz"} {
+		if strings.HasSuffix(base, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// quote 返回shell单引号转义后的参数
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func quoteAll(args []string) []string {
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		quoted = append(quoted, quote(arg))
+	}
+	return quoted
+}
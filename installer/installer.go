@@ -0,0 +1,156 @@
+package installer
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/provider"
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// ErrNoPackageManager 未找到可用的包管理器
+var ErrNoPackageManager = errors.New("no package manager found")
+
+// Options 安装器可选参数
+type Options struct {
+	// PackageManager 包管理器。可选值：dnf|yum|apt|zypper，为空时自动探测。
+	PackageManager string
+	// LocalRepo 离线安装时使用的本地仓库。yum/dnf/zypper为仓库ID，apt为sources.list文件路径。为空时使用系统默认仓库。
+	LocalRepo string
+	// BinDir tarball及二进制工具的安装目录，默认/usr/local/bin。
+	BinDir string
+	// TmpDir 安装包下载目录，默认/tmp。
+	TmpDir string
+	// DryRun 仅生成安装计划而不执行安装命令
+	DryRun bool
+}
+
+// Step 单个工具的安装计划
+type Step struct {
+	Tool      *provider.Tool `json:"tool"`
+	Backend   string         `json:"backend"`   // 安装方式
+	Installed string         `json:"installed"` // 已安装的版本，未安装时为空。
+	Skip      bool           `json:"skip"`      // 已安装符合要求的版本，无需安装。
+	Commands  []Command      `json:"commands"`  // 安装命令
+}
+
+// Installer 硬件配置工具安装器
+type Installer struct {
+	executor util.Executor
+	opts     Options
+	pm       backend
+}
+
+// New 返回基于命令执行器的安装器
+func New(executor util.Executor, opts Options) *Installer {
+	if opts.BinDir == "" {
+		opts.BinDir = "/usr/local/bin"
+	}
+	if opts.TmpDir == "" {
+		opts.TmpDir = "/tmp"
+	}
+	return &Installer{executor: executor, opts: opts}
+}
+
+// Plan 生成安装计划。已安装符合要求版本的工具将被标记为跳过。
+func (i *Installer) Plan(tools []*provider.Tool) ([]*Step, error) {
+	steps := make([]*Step, 0, len(tools))
+	for _, tool := range tools {
+		if tool == nil || strings.TrimSpace(tool.Name) == "" {
+			return nil, util.NewInvalidOptionError("tool")
+		}
+		b, err := i.backend(tool)
+		if err != nil {
+			return nil, err
+		}
+		step := Step{
+			Tool:      tool,
+			Backend:   b.name(),
+			Installed: b.installed(tool),
+		}
+		if step.Installed != "" && versionMatch(tool.Version, step.Installed) {
+			step.Skip = true
+		} else {
+			step.Commands = b.commands(tool)
+		}
+		steps = append(steps, &step)
+	}
+	return steps, nil
+}
+
+// Install 按安装计划依次安装工具，并返回安装计划。DryRun模式下不执行任何安装命令。
+func (i *Installer) Install(tools []*provider.Tool) ([]*Step, error) {
+	steps, err := i.Plan(tools)
+	if err != nil || i.opts.DryRun {
+		return steps, err
+	}
+	for _, step := range steps {
+		for _, cmd := range step.Commands {
+			if _, err = i.executor.Exec(nil, cmd.Name, quoteAll(cmd.Args)...); err != nil {
+				return steps, fmt.Errorf("install %s: %w", step.Tool.Name, err)
+			}
+		}
+	}
+	return steps, nil
+}
+
+// backend 根据工具的下载地址选择安装方式
+func (i *Installer) backend(tool *provider.Tool) (backend, error) {
+	addr := strings.ToLower(tool.DownloadAddr)
+	switch {
+	case addr == "":
+		return i.packageManager()
+	case strings.HasSuffix(addr, ".rpm"):
+		return &rpmURL{executor: i.executor}, nil
+	case strings.HasSuffix(addr, ".deb"):
+		return &debURL{executor: i.executor, tmpDir: i.opts.TmpDir}, nil
+	default:
+		return &archive{executor: i.executor, binDir: i.opts.BinDir, tmpDir: i.opts.TmpDir}, nil
+	}
+}
+
+// packageManager 返回指定或探测到的包管理器
+func (i *Installer) packageManager() (backend, error) {
+	if i.pm != nil {
+		return i.pm, nil
+	}
+	name := i.opts.PackageManager
+	if name == "" {
+		for _, candidate := range []string{"dnf", "yum", "apt-get", "zypper"} {
+			if _, err := i.executor.Exec(nil, "command", "-v", candidate); err == nil {
+				name = candidate
+				break
+			}
+		}
+	}
+	switch name {
+	case "dnf", "yum":
+		i.pm = &yum{executor: i.executor, cmd: name, repo: i.opts.LocalRepo}
+	case "apt", "apt-get":
+		i.pm = &apt{executor: i.executor, sourceList: i.opts.LocalRepo}
+	case "zypper":
+		i.pm = &zypper{executor: i.executor, repo: i.opts.LocalRepo}
+	case "":
+		return nil, ErrNoPackageManager
+	default:
+		return nil, util.NewInvalidOptionError("package_manager", name)
+	}
+	return i.pm, nil
+}
+
+// versionMatch 返回已安装的版本是否满足要求。未指定版本时任意版本均满足要求，
+// 指定版本可省略发行号等后缀，如1.0.27匹配1.0.27-1。
+func versionMatch(expected, installed string) bool {
+	if expected == "" || expected == installed {
+		return true
+	}
+	return strings.HasPrefix(installed, expected+"-") || strings.HasPrefix(installed, expected+".")
+}
+
+// downloadPath 返回安装包的本地下载路径
+func downloadPath(tmpDir, addr string) string {
+	return filepath.Join(tmpDir, path.Base(addr))
+}
@@ -0,0 +1,131 @@
+package installer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/provider"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeExecutor 记录执行的命令，并按命令前缀返回预设的输出。
+type fakeExecutor struct {
+	util.Executor
+	outputs  map[string]string // 命令前缀与输出
+	failures map[string]bool   // 执行失败的命令前缀
+	cmds     []string
+}
+
+func (e *fakeExecutor) Exec(opts *util.ExecutionOptions, cmd string, args ...string) ([]byte, error) {
+	line := strings.TrimSpace(cmd + " " + strings.Join(args, " "))
+	e.cmds = append(e.cmds, line)
+	for prefix := range e.failures {
+		if strings.HasPrefix(line, prefix) {
+			return nil, errors.New("exec error")
+		}
+	}
+	for prefix, output := range e.outputs {
+		if strings.HasPrefix(line, prefix) {
+			return []byte(output), nil
+		}
+	}
+	return nil, errors.New("exec error")
+}
+
+func TestInstaller(t *testing.T) {
+	Convey("安装硬件配置工具", t, func() {
+		e := &fakeExecutor{outputs: map[string]string{
+			"command -v yum": "/usr/bin/yum",
+			"rpm -q --qf '%{VERSION}-%{RELEASE}' 'ipmitool'": "1.8.18-10.el7",
+			"yum":      "",
+			"rpm -Uvh": "",
+			"curl":     "",
+			"mkdir":    "",
+			"tar":      "",
+			"sh -c":    "",
+		}}
+
+		Convey("探测包管理器并跳过已安装的工具", func() {
+			steps, err := New(e, Options{}).Install([]*provider.Tool{
+				{Name: "ipmitool", Version: "1.8.18"},
+				{Name: "storcli", Version: "1.0.27"},
+			})
+			So(err, ShouldBeNil)
+			So(steps[0].Backend, ShouldEqual, "yum")
+			So(steps[0].Skip, ShouldBeTrue)
+			So(steps[0].Installed, ShouldEqual, "1.8.18-10.el7")
+			So(steps[1].Skip, ShouldBeFalse)
+			So(e.cmds[len(e.cmds)-1], ShouldEqual, "yum '-y' 'install' 'storcli-1.0.27'")
+		})
+
+		Convey("已安装的版本不符", func() {
+			steps, err := New(e, Options{DryRun: true}).Plan([]*provider.Tool{{Name: "ipmitool", Version: "1.8.19"}})
+			So(err, ShouldBeNil)
+			So(steps[0].Skip, ShouldBeFalse)
+		})
+
+		Convey("离线模式", func() {
+			steps, err := New(e, Options{PackageManager: "dnf", LocalRepo: "local"}).Plan([]*provider.Tool{{Name: "storcli"}})
+			So(err, ShouldBeNil)
+			So(steps[0].Commands[0].String(), ShouldEqual, "dnf '-y' '--disablerepo=*' '--enablerepo=local' 'install' 'storcli'")
+
+			steps, err = New(e, Options{PackageManager: "apt", LocalRepo: "/etc/apt/local.list"}).Plan([]*provider.Tool{{Name: "storcli", Version: "1.0.27"}})
+			So(err, ShouldBeNil)
+			So(len(steps[0].Commands), ShouldEqual, 2)
+			So(steps[0].Commands[1].Args, ShouldResemble, []string{"-o", "Dir::Etc::SourceList=/etc/apt/local.list", "-o", "Dir::Etc::SourceParts=-", "-y", "install", "storcli=1.0.27"})
+
+			steps, err = New(e, Options{PackageManager: "zypper", LocalRepo: "local"}).Plan([]*provider.Tool{{Name: "storcli"}})
+			So(err, ShouldBeNil)
+			So(steps[0].Commands[0].Args, ShouldResemble, []string{"--non-interactive", "install", "--from", "local", "storcli"})
+		})
+
+		Convey("通过URL安装", func() {
+			steps, err := New(e, Options{}).Plan([]*provider.Tool{
+				{Name: "storcli", DownloadAddr: "http://repo/storcli.rpm"},
+				{Name: "perccli", DownloadAddr: "http://repo/perccli.deb"},
+				{Name: "racadm", Version: "9.4", DownloadAddr: "http://repo/racadm.tar.gz"},
+				{Name: "sum", DownloadAddr: "http://repo/sum"},
+			})
+			So(err, ShouldBeNil)
+			So(steps[0].Backend, ShouldEqual, "rpm")
+			So(steps[1].Backend, ShouldEqual, "deb")
+			So(steps[1].Commands[1].Args, ShouldResemble, []string{"-i", "/tmp/perccli.deb"})
+			So(steps[2].Commands[2].Args, ShouldResemble, []string{"-xf", "/tmp/racadm.tar.gz", "-C", "/usr/local/bin"})
+			So(steps[2].Commands[3].Args, ShouldResemble, []string{"-c", "echo '9.4' > '/usr/local/bin/.racadm.version'"})
+			So(steps[3].Commands[2].Args, ShouldResemble, []string{"-m", "0755", "/tmp/sum", "/usr/local/bin/sum"})
+		})
+
+		Convey("二进制工具已安装", func() {
+			e.outputs["cat '/usr/local/bin/.racadm.version'"] = "9.4\n"
+			steps, err := New(e, Options{}).Plan([]*provider.Tool{{Name: "racadm", Version: "9.4", DownloadAddr: "http://repo/racadm.tgz"}})
+			So(err, ShouldBeNil)
+			So(steps[0].Skip, ShouldBeTrue)
+		})
+
+		Convey("DryRun模式不执行安装命令", func() {
+			steps, err := New(e, Options{PackageManager: "yum", DryRun: true}).Install([]*provider.Tool{{Name: "storcli"}})
+			So(err, ShouldBeNil)
+			So(len(steps[0].Commands), ShouldEqual, 1)
+			for _, cmd := range e.cmds {
+				So(cmd, ShouldNotStartWith, "yum")
+			}
+		})
+
+		Convey("安装失败", func() {
+			e.failures = map[string]bool{"yum": true}
+			_, err := New(e, Options{}).Install([]*provider.Tool{{Name: "storcli"}})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldStartWith, "install storcli")
+		})
+
+		Convey("无效的工具", func() {
+			_, err := New(e, Options{}).Plan([]*provider.Tool{{Name: " "}})
+			So(util.IsInvalidOptionError(err), ShouldBeTrue)
+
+			_, err = New(&fakeExecutor{}, Options{}).Plan([]*provider.Tool{{Name: "storcli"}})
+			So(err, ShouldEqual, ErrNoPackageManager)
+		})
+	})
+}
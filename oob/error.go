@@ -12,6 +12,8 @@ var (
 	ErrUnknownHardware = errors.New("unknown OOB hardware")
 	// ErrOOBIPAndSNUnmatched 带外IP和设备序列号不匹配
 	ErrOOBIPAndSNUnmatched = errors.New("oob ip and sn do not match")
+	// ErrNotSupported 处理器或设备暂不支持该操作
+	ErrNotSupported = errors.New("not supported yet")
 )
 
 // UserNotFoundError 用户不存在错误
@@ -58,6 +60,16 @@ func (e *IPUnreachableError) Error() string {
 	return fmt.Sprintf("OOB IP %q is unreachable: %s", e.ip, e.err.Error())
 }
 
+// IP 返回不可达的带外IP
+func (e *IPUnreachableError) IP() string {
+	return e.ip
+}
+
+// Unwrap 返回原始错误
+func (e *IPUnreachableError) Unwrap() error {
+	return e.err
+}
+
 // IsIPUnreachableError 判断是否是IP不可达错误，若是返回true，反之为false。
 func IsIPUnreachableError(err error) bool {
 	if err == nil {
@@ -86,6 +98,16 @@ func (e *FRUDeviceNotPresentError) Error() string {
 	return fmt.Sprintf("ipmitool fru list %s error: %s", e.id, e.err)
 }
 
+// ID 返回FRU设备ID
+func (e *FRUDeviceNotPresentError) ID() string {
+	return e.id
+}
+
+// Unwrap 返回原始错误
+func (e *FRUDeviceNotPresentError) Unwrap() error {
+	return e.err
+}
+
 // IsFRUDeviceNotPresentError 判断是否是FRU设备不存在错误
 func IsFRUDeviceNotPresentError(err error) bool {
 	if err == nil {
@@ -111,6 +133,11 @@ func (e *UsernamePasswordError) Error() string {
 	return fmt.Sprintf("username and password do not match: %s", e.err.Error())
 }
 
+// Unwrap 返回原始错误
+func (e *UsernamePasswordError) Unwrap() error {
+	return e.err
+}
+
 // IsUsernamePasswordError 判断是否是用户名、密码不匹配错误，若是返回true，反之为false。
 func IsUsernamePasswordError(err error) bool {
 	if err == nil {
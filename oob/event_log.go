@@ -0,0 +1,21 @@
+package oob
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// ExportAndClearEventLogs 将全部事件日志以JSON数组的形式写入out，写入成功后再清空SEL，避免清空时丢失日志。
+func ExportAndClearEventLogs(w Worker, out io.Writer) error {
+	items, err := w.EventLogs(0)
+	if err != nil {
+		return err
+	}
+	if items == nil {
+		items = []*EventLog{}
+	}
+	if err = json.NewEncoder(out).Encode(items); err != nil {
+		return err
+	}
+	return w.SelClear()
+}
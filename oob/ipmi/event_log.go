@@ -0,0 +1,38 @@
+package ipmi
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	ipmiutil "github.com/licairong/cloudboot-provider-framework/util/ipmi"
+)
+
+// severities IPMI事件严重性级别与日志严重性级别的对应关系
+var severities = map[ipmiutil.Severity]string{
+	ipmiutil.SeverityInformation: oob.InformationSeverity,
+	ipmiutil.SeverityWarning:     oob.WarningSeverity,
+	ipmiutil.SeverityCritical:    oob.CriticalSeverity,
+}
+
+// selEventLogs 将SEL记录转换为序号大于since的事件日志，记录ID作为日志序号，并按序号升序排列。
+func selEventLogs(records []*ipmiutil.SELRecord, since int) []*oob.EventLog {
+	items := make([]*oob.EventLog, 0, len(records))
+	for _, r := range records {
+		if r == nil || int(r.ID) <= since {
+			continue
+		}
+		severity, ok := severities[r.Severity()]
+		if !ok {
+			severity = oob.UnknownSeverity
+		}
+		items = append(items, &oob.EventLog{
+			SeqNumber: strconv.Itoa(int(r.ID)),
+			Timestamp: r.Timestamp,
+			Severity:  severity,
+			Message:   r.Message(),
+		})
+	}
+	sort.Sort(oob.BySeqASC(items))
+	return items
+}
@@ -5,13 +5,16 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/oob/ipmi/oem"
 	"github.com/licairong/cloudboot-provider-framework/util"
 	"io"
+	"os"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	ipmiutil "github.com/licairong/cloudboot-provider-framework/util/ipmi"
 	strutil "github.com/licairong/cloudboot-provider-framework/util/strings"
 )
 
@@ -221,25 +224,46 @@ func parseUsers(output []byte) (items []*oob.User, err error) {
 	return items, nil
 }
 
-// isDELL 返回当前设备的厂商是否是DELL的布尔值
-func (w *worker) isDELL() bool {
+// extension 返回适用于当前设备的厂商OEM扩展，无适用扩展时返回nil。
+// 优先按FRU中的厂商查找，model为空时以FRU中的产品名作为型号；
+// FRU读取失败或按FRU未找到适用扩展时，按调用方提供的厂商manufacturer查找。
+func (w *worker) extension(manufacturer, model string) (*oem.Extension, error) {
 	fd, err := w.FRUDevice()
-	if err != nil || fd == nil {
-		return true
+	if err == nil {
+		fruModel := model
+		if fruModel == "" {
+			fruModel = fd.ProductName
+		}
+		if ext := oem.Lookup(fd.ProductManufacturer, fruModel); ext != nil {
+			return ext, nil
+		}
+	}
+	if manufacturer != "" {
+		return oem.Lookup(manufacturer, model), nil
 	}
-	return util.ManufacturerName(fd.ProductManufacturer) == util.Dell
+	return nil, err
 }
 
-// racadmTool DELL iDRAC配置工具
-const racadmTool = "/opt/dell/srvadmin/sbin/racadm"
+// oemRunner 返回供厂商OEM扩展执行命令的Runner
+func (w *worker) oemRunner() oem.Runner {
+	return &runner{w: w}
+}
 
-func (w *worker) enableUserAccessIDRAC(userID int) (err error) {
-	if !w.isDELL() {
-		return nil
-	}
-	_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, racadmTool, "config", "-g", "cfgUserAdmin", "-i", strconv.Itoa(userID), "-o", "cfgUserAdminPrivilege", "0x000001ff")
-	return err
+// runner 通过ipmitool及厂商配置工具执行厂商OEM扩展的命令
+type runner struct {
+	w *worker
 }
+
+// Raw 执行'ipmitool raw'
+func (r *runner) Raw(args ...string) ([]byte, error) {
+	return r.w.executor.Exec(&util.ExecutionOptions{Shadows: r.w.shadows}, tool, append([]string{r.w.remoteArgs(), "raw"}, args...)...)
+}
+
+// Exec 执行厂商配置工具
+func (r *runner) Exec(cmd string, args ...string) ([]byte, error) {
+	return r.w.executor.Exec(&util.ExecutionOptions{Shadows: r.w.shadows}, cmd, args...)
+}
+
 func (w *worker) parseSensorlist(output []byte) ([]*oob.SensorDevice, error) {
 	items := make([]*oob.SensorDevice, 0)
 	scanner := bufio.NewScanner(bytes.NewReader(output))
@@ -272,3 +296,71 @@ func (w *worker) parseSensorlist(output []byte) ([]*oob.SensorDevice, error) {
 	}
 	return items, nil
 }
+
+// sdrCacheTTL SDR缓存免校验的有效期。超过有效期后通过'ipmitool sdr info'校验缓存的记录数，一致则续期。
+var sdrCacheTTL = time.Hour
+
+// sdrCache 返回可用的SDR缓存文件路径，文件名包含带外主机名。
+// 缓存文件在有效期内直接使用；超过有效期且其记录数与BMC的SDR仓库不一致、缓存文件不存在或refresh为true时，
+// 通过'ipmitool sdr dump'重新生成，生成失败则返回空字符串。
+func (w *worker) sdrCache(refresh bool) string {
+	if w.opts == nil || w.opts.SDRCache == "" {
+		return ""
+	}
+	path := w.opts.SDRCachePath()
+	if fi, err := os.Stat(path); err == nil && !refresh {
+		if time.Since(fi.ModTime()) < sdrCacheTTL {
+			return path
+		}
+		if records, err := ipmiutil.ReadSDRCache(path); err == nil && len(records) > 0 {
+			count, err := w.sdrRecordCount()
+			if err != nil || count == len(records) { // 无法获取仓库信息时信任缓存
+				now := time.Now()
+				_ = os.Chtimes(path, now, now)
+				return path
+			}
+		}
+	}
+	_ = os.Remove(path)
+	if _, err := w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "sdr", "dump", path); err != nil {
+		return ""
+	}
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+// sdrRecordCount 通过'ipmitool sdr info'返回BMC的SDR仓库中的记录数
+func (w *worker) sdrRecordCount() (int, error) {
+	output, err := w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "sdr", "info")
+	if err != nil {
+		return 0, err
+	}
+	return parseSDRRecordCount(output)
+}
+
+// parseSDRRecordCount 解析'ipmitool sdr info'输出中的记录数
+func parseSDRRecordCount(output []byte) (int, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		kv := strings.SplitN(scanner.Text(), ":", 2)
+		if len(kv) == 2 && strings.TrimSpace(kv[0]) == "Record Count" {
+			return strconv.Atoi(strings.TrimSpace(kv[1]))
+		}
+	}
+	return 0, fmt.Errorf("record count not found in sdr info")
+}
+
+// parseSEL 解码'ipmitool sel writeraw'导出的原始SEL记录，返回序号大于since的事件日志。
+func (w *worker) parseSEL(data []byte, since int) ([]*oob.EventLog, error) {
+	records := make([]*ipmiutil.SELRecord, 0, len(data)/ipmiutil.SELRecordSize)
+	for off := 0; off+ipmiutil.SELRecordSize <= len(data); off += ipmiutil.SELRecordSize {
+		r, err := ipmiutil.ParseSELRecord(data[off : off+ipmiutil.SELRecordSize])
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	return selEventLogs(records, since), nil
+}
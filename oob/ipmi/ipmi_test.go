@@ -191,3 +191,42 @@ func Test_parseFRUDevice(t *testing.T) {
 		})
 	})
 }
+
+func Test_parseSEL(t *testing.T) {
+	Convey("解码ipmitool导出的原始SEL记录", t, func() {
+		data, err := ioutil.ReadFile("./testdata/ipmitool_sel_writeraw.bin")
+		So(err, ShouldBeNil)
+
+		items, err := new(worker).parseSEL(data, 0)
+		So(err, ShouldBeNil)
+		So(len(items), ShouldEqual, 3)
+
+		So(items[0].SeqNumber, ShouldEqual, "1")
+		So(items[0].Timestamp.Format("2006-01-02 15:04:05"), ShouldEqual, "2021-05-20 10:22:33")
+		So(items[0].Severity, ShouldEqual, "critical")
+		So(items[0].Message, ShouldEqual, "Temperature #0x30 | Upper Critical going high | Asserted")
+
+		So(items[1].Timestamp.IsZero(), ShouldBeTrue)
+		So(items[1].Severity, ShouldEqual, "information")
+		So(items[1].Message, ShouldEqual, "Memory #0x08 | Uncorrectable ECC | Deasserted")
+
+		So(items[2].Severity, ShouldEqual, "unknown")
+		So(items[2].Message, ShouldEqual, "OEM record e0 | 101112131415161718191a1b1c")
+
+		items, err = new(worker).parseSEL(data, 2)
+		So(err, ShouldBeNil)
+		So(len(items), ShouldEqual, 1)
+		So(items[0].SeqNumber, ShouldEqual, "3")
+	})
+}
+
+func Test_parseSDRRecordCount(t *testing.T) {
+	Convey("解析SDR仓库记录数", t, func() {
+		count, err := parseSDRRecordCount([]byte("SDR Version                         : 0x51\nRecord Count                        : 65\nFree Space                          : unspecified\n"))
+		So(err, ShouldBeNil)
+		So(count, ShouldEqual, 65)
+
+		_, err = parseSDRRecordCount([]byte("SDR Version                         : 0x51\n"))
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,598 @@
+// Package native 基于纯Go IPMI客户端的OOB处理器。
+// 通过RMCP+与BMC直接通信，不依赖ipmitool，会话在多次操作间复用；未指定BMC地址时经由本机OpenIPMI设备进行带内操作。
+package native
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"github.com/licairong/cloudboot-provider-framework/util/ipmi"
+)
+
+var _ oob.Worker = (*worker)(nil)
+
+const (
+	// name 处理器名称
+	name = oob.NativeWorker
+	// defaultUserID 新建用户的最小ID，1号用户为匿名用户。
+	defaultUserID = 2
+	// maxChannel 查找LAN通道时的最大通道号
+	maxChannel = 11
+)
+
+func init() {
+	oob.Register(name, NewWorker())
+	oob.RegisterFactory(name, NewWorker)
+}
+
+type worker struct {
+	opts   *oob.Options
+	log    util.Logger
+	client *ipmi.Client
+	delay  time.Duration // 连续的电源操作之间的等待时间
+}
+
+// NewWorker 返回处理器实例。
+// 通过oob.WithRemote指定BMC地址及用户名、密码时使用lanplus（Interface参数被忽略），否则使用本机IPMI设备（/dev/ipmi0）。
+func NewWorker(setters ...func(*oob.Options)) oob.Worker {
+	var opts oob.Options
+	opts.ChannelID = -1
+
+	for i := range setters {
+		setters[i](&opts)
+	}
+
+	client := ipmi.NewLocalClient(ipmi.NewDevice(""))
+	if opts.Hostname != "" {
+		client = ipmi.NewClient(opts.Hostname, opts.Username, opts.Password)
+	}
+	client.SDRCache = opts.SDRCachePath()
+	return &worker{
+		opts:   &opts,
+		log:    opts.Log,
+		client: client,
+		delay:  3 * time.Second,
+	}
+}
+
+// Name 返回处理器实现的名称
+func (w *worker) Name() string {
+	return name
+}
+
+// Close 关闭与BMC之间的会话或本机IPMI设备
+func (w *worker) Close() error {
+	return w.client.Close()
+}
+
+// wrap 将会话建立失败转换为UsernamePasswordError，将远程BMC的网络错误及超时转换为IPUnreachableError。
+func (w *worker) wrap(err error) error {
+	if err == nil || w.opts.Hostname == "" {
+		return err
+	}
+	if ipmi.IsAuthError(err) {
+		return oob.NewUsernamePasswordError(err)
+	}
+	var netErr net.Error
+	if err == ipmi.ErrTimeout || errors.As(err, &netErr) {
+		return oob.NewIPUnreachableError(w.opts.Hostname, err)
+	}
+	return err
+}
+
+func (w *worker) sleep() {
+	time.Sleep(w.delay)
+}
+
+// FRUDevice 返回物理机基本信息
+func (w *worker) FRUDevice() (*oob.FRUDevice, error) {
+	fru, err := w.client.FRU(0)
+	if err != nil {
+		if ipmi.IsCompletion(err, ipmi.CompletionNotPresent) {
+			return nil, oob.NewFRUDeviceNotPresentError("0", err)
+		}
+		return nil, w.wrap(err)
+	}
+	fd := oob.FRUDevice{
+		ProductManufacturer: fru.ProductManufacturer,
+		ProductName:         fru.ProductName,
+		ProductSerial:       fru.ProductSerial,
+	}
+	if fd.ProductSerial == "" {
+		fd.ProductSerial = fru.ChassisSerial
+	}
+	return &fd, nil
+}
+
+// ValidateSN 校验预期的SN与实际的SN是否匹配
+func (w *worker) ValidateSN(sn string) error {
+	fd, err := w.FRUDevice()
+	if err != nil {
+		return err
+	}
+	if fd.ProductSerial != sn {
+		return oob.ErrOOBIPAndSNUnmatched
+	}
+	return nil
+}
+
+// PowerStatus 返回电源状态
+func (w *worker) PowerStatus() (status string, err error) {
+	on, err := w.client.PowerOn()
+	if err != nil {
+		return "", w.wrap(err)
+	}
+	if on {
+		return oob.PowerOn, nil
+	}
+	return oob.PowerOff, nil
+}
+
+// PowerOn 设备上电开机
+func (w *worker) PowerOn() error {
+	if status, _ := w.PowerStatus(); status == oob.PowerOn {
+		return nil
+	}
+	err := w.client.ChassisControl(ipmi.ControlPowerUp)
+	w.sleep()
+	return w.wrap(err)
+}
+
+// PowerOff 设备下电关机
+func (w *worker) PowerOff() error {
+	if status, _ := w.PowerStatus(); status == oob.PowerOff {
+		return nil
+	}
+	err := w.client.ChassisControl(ipmi.ControlPowerDown)
+	w.sleep()
+	return w.wrap(err)
+}
+
+// PowerReset 设备重启
+func (w *worker) PowerReset() error {
+	if status, _ := w.PowerStatus(); status == oob.PowerOff {
+		w.sleep()
+		return w.PowerOn() // 关机状态下无法直接重启
+	}
+	return w.wrap(w.client.ChassisControl(ipmi.ControlHardReset))
+}
+
+// PXEBoot 设备重启并指定其（仅下次）从网络引导
+func (w *worker) PXEBoot(uefi bool, manufacturer string) error {
+	_ = w.PowerOff()
+	if err := w.client.SetBootDevice(ipmi.BootDevicePXE, uefi, false); err != nil {
+		return w.wrap(err)
+	}
+	w.sleep()
+	return w.wrap(w.client.ChassisControl(ipmi.ControlPowerUp))
+}
+
+// Raw 发送原始的ipmi请求并返回响应内容。参数及输出格式与'ipmitool raw'一致，如'0x06 0x01'。
+func (w *worker) Raw(args string) (response []byte, err error) {
+	fields := strings.Fields(args)
+	if len(fields) < 2 {
+		return nil, errors.New("invalid commandline args")
+	}
+	req := make([]byte, 0, len(fields))
+	for _, f := range fields {
+		v, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(f), "0x"), 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commandline args: %s", f)
+		}
+		req = append(req, uint8(v))
+	}
+	data, err := w.client.Send(ipmi.NetFn(req[0]), req[1], req[2:])
+	if err != nil {
+		return nil, w.wrap(err)
+	}
+	var sb strings.Builder
+	for i, b := range data {
+		if i > 0 && i%16 == 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, " %02x", b)
+	}
+	sb.WriteString("\n")
+	return []byte(sb.String()), nil
+}
+
+// getBuffedChannel 返回已指定或已探测到的LAN通道
+func (w *worker) getBuffedChannel() (int, error) {
+	if w.opts.ChannelID >= 0 {
+		return w.opts.ChannelID, nil
+	}
+	return w.Channel()
+}
+
+// Channel 返回LAN通道。优先返回已配置IP的通道。
+func (w *worker) Channel() (int, error) {
+	channel := -1
+	for i := 1; i <= maxChannel; i++ {
+		info, err := w.client.GetChannelInfo(uint8(i))
+		if err != nil {
+			if ipmi.IsCompletion(err, ipmi.CompletionInvalidDataField) || ipmi.IsCompletion(err, ipmi.CompletionParameterOutOfRange) {
+				continue
+			}
+			return 0, w.wrap(err)
+		}
+		if info.Medium != ipmi.MediumLAN {
+			continue
+		}
+		if ip, err := w.client.GetLANConfig(uint8(i), ipmi.LANParamIPAddress); err == nil && len(ip) >= 4 && !net.IP(ip[:4]).Equal(net.IPv4zero) {
+			channel = i
+			break
+		}
+		if channel < 0 {
+			channel = i
+		}
+	}
+	if channel < 0 {
+		return 0, oob.ErrChannelNotFound
+	}
+	w.opts.ChannelID = channel
+	return channel, nil
+}
+
+// Network 返回OOB网络信息
+func (w *worker) Network() (*oob.Network, error) {
+	channel, err := w.getBuffedChannel()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := w.client.LANConfig(uint8(channel))
+	if err != nil {
+		return nil, w.wrap(err)
+	}
+	return &oob.Network{
+		IPSrc:   cfg.IPSourceName(),
+		MAC:     cfg.MAC.String(),
+		IP:      cfg.IP.String(),
+		Netmask: cfg.Netmask.String(),
+		Gateway: cfg.Gateway.String(),
+	}, nil
+}
+
+// SetDHCP 设置IP来源是DHCP
+func (w *worker) SetDHCP() error {
+	channel, err := w.getBuffedChannel()
+	if err != nil {
+		return err
+	}
+	return w.wrap(w.client.SetLANConfig(uint8(channel), ipmi.LANParamIPSource, []byte{ipmi.IPSourceDHCP}))
+}
+
+// SetStaticIP 设置IP来源是静态IP
+func (w *worker) SetStaticIP(ip, netmask, gateway string) error {
+	channel, err := w.getBuffedChannel()
+	if err != nil {
+		return err
+	}
+	params := []struct {
+		param uint8
+		value string
+	}{
+		{ipmi.LANParamIPAddress, ip},
+		{ipmi.LANParamSubnetMask, netmask},
+		{ipmi.LANParamDefaultGateway, gateway},
+	}
+	if err = w.client.SetLANConfig(uint8(channel), ipmi.LANParamIPSource, []byte{ipmi.IPSourceStatic}); err != nil {
+		return w.wrap(err)
+	}
+	for _, p := range params {
+		addr := net.ParseIP(p.value).To4()
+		if addr == nil {
+			return fmt.Errorf("invalid IPv4 address: %q", p.value)
+		}
+		if err = w.client.SetLANConfig(uint8(channel), p.param, addr); err != nil {
+			return w.wrap(err)
+		}
+	}
+	return nil
+}
+
+// Users 返回OOB用户列表
+func (w *worker) Users() ([]*oob.User, error) {
+	channel, err := w.getBuffedChannel()
+	if err != nil {
+		return nil, err
+	}
+	first, err := w.client.GetUserAccess(uint8(channel), 1)
+	if err != nil {
+		return nil, w.wrap(err)
+	}
+
+	var users []*oob.User
+	for id := 1; id <= int(first.MaxUsers); id++ {
+		name, err := w.client.GetUserName(uint8(id))
+		if err != nil {
+			return nil, w.wrap(err)
+		}
+		if name == "" {
+			continue
+		}
+		access, err := w.client.GetUserAccess(uint8(channel), uint8(id))
+		if err != nil {
+			return nil, w.wrap(err)
+		}
+		users = append(users, &oob.User{
+			Channel: channel,
+			ID:      id,
+			Name:    name,
+			Access:  userAccess(id, name, access),
+		})
+	}
+	return users, nil
+}
+
+// userAccess 转换为oob用户Access，取值与'ipmitool channel getaccess'的输出一致。
+func userAccess(id int, name string, access *ipmi.UserAccess) *oob.UserAccess {
+	onOff := func(b bool) string {
+		if b {
+			return "enabled"
+		}
+		return "disabled"
+	}
+	ua := oob.UserAccess{
+		UserID:             id,
+		UserName:           name,
+		FixedName:          "No",
+		AccessAvailable:    "callback",
+		LinkAuthentication: onOff(access.LinkAuth),
+		IPMIMessaging:      onOff(access.IPMIMessaging),
+		PrivilegeLevel:     int(access.Privilege),
+	}
+	if id <= int(access.FixedNames) {
+		ua.FixedName = "Yes"
+	}
+	if access.Callin {
+		ua.AccessAvailable = "call-in / callback"
+	}
+	if access.Privilege == ipmi.PrivilegeNoAccess {
+		ua.PrivilegeLevel = oob.NoAccessLevel
+	}
+	return &ua
+}
+
+// findUserByName 根据带外用户名查找带外用户。
+// 若指定名称的用户不存在，则返回oob.UserNotFoundError实例。
+func (w *worker) findUserByName(name string) (*oob.User, error) {
+	users, err := w.Users()
+	if err != nil {
+		return nil, err
+	}
+	for i := range users {
+		if users[i].Name == name {
+			return users[i], nil
+		}
+	}
+	return nil, oob.NewUserNotFoundError(name)
+}
+
+// findUserIndexByName 返回指定名称的用户在列表中的索引，不存在则返回-1。
+func (w *worker) findUserIndexByName(users []*oob.User, name string) (index int) {
+	for i := range users {
+		if users[i] == nil {
+			continue
+		}
+		if users[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// newUserID 返回首个未设置用户名的用户ID
+func (w *worker) newUserID(channel int) (int, error) {
+	first, err := w.client.GetUserAccess(uint8(channel), 1)
+	if err != nil {
+		return 0, w.wrap(err)
+	}
+	for id := defaultUserID; id <= int(first.MaxUsers); id++ {
+		name, err := w.client.GetUserName(uint8(id))
+		if err != nil {
+			return 0, w.wrap(err)
+		}
+		if name == "" {
+			return id, nil
+		}
+	}
+	return 0, errors.New("no free oob user slot")
+}
+
+// GenerateUser 生成用户带外帐号
+func (w *worker) GenerateUser(sett *oob.UserSettingItem) error {
+	channel, err := w.getBuffedChannel()
+	if err != nil {
+		return err
+	}
+	var userID int
+	user, err := w.findUserByName(sett.Username)
+	if err != nil && !oob.IsUserNotFoundError(err) {
+		return err
+	}
+	if user == nil { // 目标用户不存在
+		if userID, err = w.newUserID(channel); err != nil {
+			return err
+		}
+		if err = w.client.SetUserName(uint8(userID), sett.Username); err != nil {
+			return w.wrap(err)
+		}
+	} else {
+		userID = user.ID
+	}
+
+	if err = w.client.SetUserPassword(uint8(userID), sett.Password); err != nil {
+		return w.wrap(err)
+	}
+	if err = w.client.EnableUser(uint8(userID)); err != nil {
+		return w.wrap(err)
+	}
+	if err = w.client.SetUserAccess(uint8(channel), uint8(userID), &ipmi.UserAccess{
+		Callin:        true,
+		LinkAuth:      true,
+		IPMIMessaging: true,
+		Privilege:     uint8(sett.PrivilegeLevel),
+	}); err != nil {
+		return w.wrap(err)
+	}
+
+	switch sett.Status {
+	case oob.DisabledUser:
+		return w.wrap(w.client.DisableUser(uint8(userID)))
+	}
+	return nil
+}
+
+// ChangeUserPassword 修改目标带外用户密码
+func (w *worker) ChangeUserPassword(username, password string) error {
+	user, err := w.findUserByName(username)
+	if err != nil {
+		return err
+	}
+	return w.wrap(w.client.SetUserPassword(uint8(user.ID), password))
+}
+
+// EnableUser 启用带外用户帐号
+func (w *worker) EnableUser(username string) error {
+	user, err := w.findUserByName(username)
+	if err != nil {
+		return err
+	}
+	return w.wrap(w.client.EnableUser(uint8(user.ID)))
+}
+
+// DisableUser 禁用带外用户帐号
+func (w *worker) DisableUser(username string) error {
+	user, err := w.findUserByName(username)
+	if err != nil {
+		return err
+	}
+	return w.wrap(w.client.DisableUser(uint8(user.ID)))
+}
+
+// BMC 返回OOB的BMC信息
+func (w *worker) BMC() (*oob.BMC, error) {
+	id, err := w.client.GetDeviceID()
+	if err != nil {
+		return nil, w.wrap(err)
+	}
+	return &oob.BMC{
+		FirmwareReversion: id.FirmwareRevision(),
+		IPMIVersion:       id.Version(),
+		ManufacturerID:    strconv.Itoa(int(id.ManufacturerID)),
+		ManufacturerName:  ipmi.ManufacturerName(id.ManufacturerID),
+	}, nil
+}
+
+// BMCColdReset (冷)重启BMC
+func (w *worker) BMCColdReset() error {
+	return w.wrap(w.client.ColdReset())
+}
+
+// SelClear 日志清空
+func (w *worker) SelClear() error {
+	return w.wrap(w.client.ClearSEL())
+}
+
+// EventLogs 返回序号大于since的事件日志
+func (w *worker) EventLogs(since int) ([]*oob.EventLog, error) {
+	if since < 0 {
+		since = 0
+	}
+	if since >= math.MaxUint16 {
+		return []*oob.EventLog{}, nil
+	}
+	records, err := w.client.SELRecords(uint16(since))
+	if err != nil {
+		return nil, w.wrap(err)
+	}
+	return selEventLogs(records, since), nil
+}
+
+// SensorList 返回基于阈值的传感器信息，各字段取值与'ipmitool sensor list'一致。
+func (w *worker) SensorList() ([]*oob.SensorDevice, error) {
+	records, err := w.client.SensorRecords()
+	if err != nil {
+		return nil, w.wrap(err)
+	}
+	items := make([]*oob.SensorDevice, 0, len(records))
+	for _, r := range records {
+		if !r.Analog() {
+			continue
+		}
+		reading, err := w.client.GetSensorReading(r.Number)
+		if err != nil {
+			if ipmi.IsCompletion(err, ipmi.CompletionNotPresent) {
+				continue
+			}
+			return nil, w.wrap(err)
+		}
+		threshold := func(t ipmi.Threshold) string {
+			if v, ok := r.Threshold(t); ok {
+				return format(v)
+			}
+			return ""
+		}
+		item := oob.SensorDevice{
+			Name:     r.Name,
+			Units:    r.Units(),
+			State:    state(reading),
+			Lonorec:  threshold(ipmi.LowerNonRecoverable),
+			Locrit:   threshold(ipmi.LowerCritical),
+			Lonocrit: threshold(ipmi.LowerNonCritical),
+			Upcrit:   threshold(ipmi.UpperCritical),
+			Upnocrit: threshold(ipmi.UpperNonCritical),
+			Upnorec:  threshold(ipmi.UpperNonRecoverable),
+		}
+		if reading.Available {
+			item.Value = format(r.Convert(reading.Raw))
+		}
+		items = append(items, &item)
+	}
+	return items, nil
+}
+
+// state 返回传感器状态：ns（不可用）、nr（不可恢复）、cr（严重）、nc（非严重）或ok。
+func state(r *ipmi.SensorReading) string {
+	switch {
+	case !r.Available:
+		return "ns"
+	case r.Crossed(ipmi.LowerNonRecoverable) || r.Crossed(ipmi.UpperNonRecoverable):
+		return "nr"
+	case r.Crossed(ipmi.LowerCritical) || r.Crossed(ipmi.UpperCritical):
+		return "cr"
+	case r.Crossed(ipmi.LowerNonCritical) || r.Crossed(ipmi.UpperNonCritical):
+		return "nc"
+	}
+	return "ok"
+}
+
+func format(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// SetSnmpTrap 设置snmptrap依赖厂商OEM命令，暂不支持。
+func (w *worker) SetSnmpTrap(*oob.SnmpSet) error {
+	return oob.ErrNotSupported
+}
+
+// PostCheck OOB配置实施后置检查
+func (w *worker) PostCheck(sett *oob.Setting) (items []*util.CheckingItem) {
+	if sett == nil {
+		return nil
+	}
+	if sett.Network != nil {
+		items = append(items, w.checkNetwork(sett.Network)...)
+	}
+	if sett.User != nil {
+		items = append(items, w.checkUser(sett.User)...)
+	}
+	return items
+}
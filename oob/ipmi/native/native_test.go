@@ -0,0 +1,280 @@
+package native
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"github.com/licairong/cloudboot-provider-framework/util/ipmi"
+	"github.com/licairong/cloudboot-provider-framework/util/ipmi/ipmitest"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newWorker(addr, username, password string) *worker {
+	w := NewWorker(oob.WithRemote(oob.LANPlusInterface, addr, username, password)).(*worker)
+	w.delay = 0
+	w.client.Timeout = 200 * time.Millisecond
+	w.client.Retries = 0
+	return w
+}
+
+func TestWorker(t *testing.T) {
+	Convey("基于纯Go IPMI客户端的OOB处理器", t, func() {
+		srv := ipmitest.NewServer(nil)
+		defer srv.Close()
+		w := newWorker(srv.Addr(), "root", "calvin")
+		defer w.Close()
+
+		Convey("已注册", func() {
+			So(oob.Registered(), ShouldContain, oob.NativeWorker)
+		})
+
+		Convey("FRU及SN校验", func() {
+			fd, err := w.FRUDevice()
+			So(err, ShouldBeNil)
+			So(fd, ShouldResemble, &oob.FRUDevice{
+				ProductManufacturer: "DELL",
+				ProductName:         "PowerEdge R730",
+				ProductSerial:       "FK2F47K",
+			})
+			So(w.ValidateSN("FK2F47K"), ShouldBeNil)
+			So(w.ValidateSN("XXXXXXX"), ShouldEqual, oob.ErrOOBIPAndSNUnmatched)
+		})
+
+		Convey("电源控制及PXE引导", func() {
+			status, err := w.PowerStatus()
+			So(err, ShouldBeNil)
+			So(status, ShouldEqual, oob.PowerOff)
+
+			So(w.PowerReset(), ShouldBeNil)
+			So(srv.IsPoweredOn(), ShouldBeTrue)
+			So(w.PowerOff(), ShouldBeNil)
+			So(srv.IsPoweredOn(), ShouldBeFalse)
+
+			So(w.PXEBoot(true, "Dell Inc."), ShouldBeNil)
+			So(srv.IsPoweredOn(), ShouldBeTrue)
+			So(srv.CurrentBootFlags(), ShouldResemble, []byte{0xA0, 0x04, 0, 0, 0})
+		})
+
+		Convey("原始命令", func() {
+			out, err := w.Raw("0x00 0x01")
+			So(err, ShouldBeNil)
+			So(string(out), ShouldEqual, " 00 00 00 00\n")
+
+			_, err = w.Raw("0x06")
+			So(err, ShouldNotBeNil)
+			_, err = w.Raw("0x06 zz")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("通道及网络", func() {
+			channel, err := w.Channel()
+			So(err, ShouldBeNil)
+			So(channel, ShouldEqual, 1)
+
+			network, err := w.Network()
+			So(err, ShouldBeNil)
+			So(network, ShouldResemble, &oob.Network{
+				IPSrc:   "DHCP Address",
+				MAC:     "18:66:da:5f:2e:10",
+				IP:      "192.168.0.120",
+				Netmask: "255.255.255.0",
+				Gateway: "192.168.0.1",
+			})
+
+			So(w.SetStaticIP("10.0.0.10", "255.255.0.0", "10.0.0.1"), ShouldBeNil)
+			network, _ = w.Network()
+			So(network.IPSrc, ShouldEqual, "Static Address")
+			So(network.IP, ShouldEqual, "10.0.0.10")
+			So(network.Gateway, ShouldEqual, "10.0.0.1")
+			So(w.SetStaticIP("10.0.0.10", "bad", "10.0.0.1"), ShouldNotBeNil)
+
+			So(w.SetDHCP(), ShouldBeNil)
+			network, _ = w.Network()
+			So(network.IPSrc, ShouldEqual, "DHCP Address")
+
+			items := w.PostCheck(&oob.Setting{Network: &oob.NetworkSetting{IPSrc: oob.DHCP}})
+			So(len(items), ShouldEqual, 1)
+			So(items[0].Matched, ShouldEqual, util.MatchedYES)
+		})
+
+		Convey("用户管理", func() {
+			users, err := w.Users()
+			So(err, ShouldBeNil)
+			So(len(users), ShouldEqual, 1)
+			So(users[0].ID, ShouldEqual, 2)
+			So(users[0].Access, ShouldResemble, &oob.UserAccess{
+				UserID:             2,
+				UserName:           "root",
+				FixedName:          "No",
+				AccessAvailable:    "call-in / callback",
+				LinkAuthentication: "enabled",
+				IPMIMessaging:      "enabled",
+				PrivilegeLevel:     oob.AdministratorLevel,
+			})
+
+			So(w.GenerateUser(&oob.UserSettingItem{
+				Username:       "admin",
+				Password:       "admin-pwd",
+				PrivilegeLevel: oob.OperatorLevel,
+				Status:         oob.EnabledUser,
+			}), ShouldBeNil)
+			admin := srv.FindUser("admin")
+			So(admin, ShouldNotBeNil)
+			So(admin.Password, ShouldEqual, "admin-pwd")
+			So(admin.Enabled, ShouldBeTrue)
+			So(admin.Privilege, ShouldEqual, ipmi.PrivilegeOperator)
+			So(srv.UserByID(3), ShouldResemble, admin)
+
+			So(w.ChangeUserPassword("admin", "new-pwd"), ShouldBeNil)
+			So(srv.UserByID(3).Password, ShouldEqual, "new-pwd")
+			So(w.DisableUser("admin"), ShouldBeNil)
+			So(srv.UserByID(3).Enabled, ShouldBeFalse)
+			So(w.EnableUser("admin"), ShouldBeNil)
+			So(srv.UserByID(3).Enabled, ShouldBeTrue)
+			So(oob.IsUserNotFoundError(w.EnableUser("nobody")), ShouldBeTrue)
+
+			items := w.PostCheck(&oob.Setting{User: &oob.UserSetting{
+				{Username: "admin", PrivilegeLevel: oob.OperatorLevel},
+				{Username: "nobody", PrivilegeLevel: oob.UserLevel},
+			}})
+			So(len(items), ShouldEqual, 2)
+			So(items[0].Matched, ShouldEqual, util.MatchedYES)
+			So(items[1].Actual, ShouldEqual, "Missing")
+		})
+
+		Convey("BMC信息及冷重启", func() {
+			bmc, err := w.BMC()
+			So(err, ShouldBeNil)
+			So(bmc, ShouldResemble, &oob.BMC{
+				FirmwareReversion: "2.81",
+				IPMIVersion:       "2.0",
+				ManufacturerID:    "674",
+				ManufacturerName:  "DELL Inc",
+			})
+			So(w.BMCColdReset(), ShouldBeNil)
+			So(srv.ColdResetCount(), ShouldEqual, 1)
+		})
+
+		Convey("SEL及传感器", func() {
+			srv.SetSEL([][]byte{make([]byte, 16)})
+			So(w.SelClear(), ShouldBeNil)
+			So(srv.SELLen(), ShouldEqual, 0)
+
+			srv.Lock()
+			srv.Sensors[0].Reading = 45
+			srv.Unlock()
+			sensors, err := w.SensorList()
+			So(err, ShouldBeNil)
+			So(sensors, ShouldResemble, []*oob.SensorDevice{
+				{Name: "Inlet Temp", Value: "45", Units: "degrees C", State: "nc", Locrit: "3", Lonocrit: "8", Upnocrit: "42", Upcrit: "47"},
+				{Name: "Fan1 RPM", Value: "5880", Units: "RPM", State: "ok", Locrit: "600"},
+			})
+		})
+
+		Convey("结构化传感器及SDR缓存", func() {
+			cache := filepath.Join(t.TempDir(), "sdr.cache")
+			w := NewWorker(oob.WithRemote(oob.LANPlusInterface, srv.Addr(), "root", "calvin"), oob.WithSDRCache(cache)).(*worker)
+			w.client.Timeout = 200 * time.Millisecond
+			w.client.Retries = 0
+			defer w.Close()
+
+			srv.Lock()
+			srv.Sensors[0].Reading = 45
+			srv.Unlock()
+			sensors, err := oob.ReadSensors(w)
+			So(err, ShouldBeNil)
+			So(len(sensors), ShouldEqual, 2)
+			So(sensors.Health(), ShouldEqual, oob.HealthWarning)
+			So(sensors.Filter(oob.FanSensor)[0].Health(), ShouldEqual, oob.HealthOK)
+			_, err = os.Stat(cache + "." + strings.Replace(srv.Addr(), ":", "_", -1))
+			So(err, ShouldBeNil)
+
+			srv.Lock()
+			srv.Sensors[0].Reading = 48
+			srv.Unlock()
+			sensors, err = oob.ReadSensors(w)
+			So(err, ShouldBeNil)
+			So(sensors.Filter(oob.TemperatureSensor)[0].Health(), ShouldEqual, oob.HealthCritical)
+		})
+
+		Convey("事件日志", func() {
+			srv.SetSEL([][]byte{
+				{0x01, 0x00, 0x02, 0x69, 0x38, 0xa6, 0x60, 0x20, 0x00, 0x04, 0x07, 0x60, 0x6f, 0x00, 0xff, 0xff},
+				{0x02, 0x00, 0x02, 0x6a, 0x38, 0xa6, 0x60, 0x20, 0x00, 0x04, 0x08, 0x61, 0x6f, 0x02, 0xff, 0xff},
+			})
+			items, err := w.EventLogs(0)
+			So(err, ShouldBeNil)
+			So(items, ShouldResemble, []*oob.EventLog{
+				{SeqNumber: "1", Timestamp: time.Unix(0x60a63869, 0).UTC(), Severity: oob.CriticalSeverity, Message: "Processor #0x60 | IERR | Asserted"},
+				{SeqNumber: "2", Timestamp: time.Unix(0x60a6386a, 0).UTC(), Severity: oob.WarningSeverity, Message: "Power Supply #0x61 | Predictive failure | Asserted"},
+			})
+
+			items, err = w.EventLogs(1)
+			So(err, ShouldBeNil)
+			So(len(items), ShouldEqual, 1)
+			So(items[0].SeqNumber, ShouldEqual, "2")
+
+			var buf bytes.Buffer
+			So(oob.ExportAndClearEventLogs(w, &buf), ShouldBeNil)
+			So(buf.String(), ShouldContainSubstring, `"message":"Processor #0x60 | IERR | Asserted"`)
+			So(srv.SELLen(), ShouldEqual, 0)
+
+			items, err = w.EventLogs(0)
+			So(err, ShouldBeNil)
+			So(items, ShouldBeEmpty)
+		})
+
+		Convey("不支持的操作", func() {
+			So(w.SetSnmpTrap(&oob.SnmpSet{}), ShouldEqual, oob.ErrNotSupported)
+		})
+
+		Convey("本机IPMI设备", func() {
+			local := NewWorker().(*worker)
+			So(local.client.Transport, ShouldHaveSameTypeAs, &ipmi.Device{})
+
+			dev := ipmitest.NewDevice(nil)
+			local.client = ipmi.NewLocalClient(dev)
+			local.delay = 0
+			channel, err := local.Channel()
+			So(err, ShouldBeNil)
+			So(channel, ShouldEqual, 1)
+
+			network, err := local.Network()
+			So(err, ShouldBeNil)
+			So(network.IP, ShouldEqual, "192.168.0.120")
+
+			fd, err := local.FRUDevice()
+			So(err, ShouldBeNil)
+			So(fd.ProductSerial, ShouldEqual, "FK2F47K")
+
+			So(local.GenerateUser(&oob.UserSettingItem{
+				Username:       "admin",
+				Password:       "admin-pwd",
+				PrivilegeLevel: oob.AdministratorLevel,
+			}), ShouldBeNil)
+			users, err := local.Users()
+			So(err, ShouldBeNil)
+			So(len(users), ShouldEqual, 2)
+			So(dev.FindUser("admin").Password, ShouldEqual, "admin-pwd")
+
+			So(local.Close(), ShouldBeNil)
+			So(dev.Closes(), ShouldEqual, 1)
+		})
+
+		Convey("错误转换", func() {
+			_, err := newWorker(srv.Addr(), "root", "wrong").PowerStatus()
+			So(oob.IsUsernamePasswordError(err), ShouldBeTrue)
+
+			addr := srv.Addr()
+			srv.Close()
+			_, err = newWorker(addr, "root", "calvin").PowerStatus()
+			So(oob.IsIPUnreachableError(err), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,24 @@
+package oem
+
+import (
+	"strconv"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// racadmTool DELL iDRAC配置工具
+const racadmTool = "/opt/dell/srvadmin/sbin/racadm"
+
+func init() {
+	Register(&Extension{
+		Name:             "Dell-iDRAC",
+		Manufacturer:     util.Dell,
+		EnableUserAccess: enableUserAccessIDRAC,
+	})
+}
+
+// enableUserAccessIDRAC 授予用户iDRAC的全部权限
+func enableUserAccessIDRAC(r Runner, userID int) error {
+	_, err := r.Exec(racadmTool, "config", "-g", "cfgUserAdmin", "-i", strconv.Itoa(userID), "-o", "cfgUserAdminPrivilege", "0x000001ff")
+	return err
+}
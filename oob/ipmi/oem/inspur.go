@@ -0,0 +1,197 @@
+package oem
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+func init() {
+	Register(&Extension{
+		Name:         "Inspur",
+		Manufacturer: util.Inspur,
+		SetSnmpTrap:  inspurSnmpTrap("0x3c 0x19 0x0e"),
+	})
+	Register(&Extension{
+		Name:         "Inspur-M5-4S",
+		Manufacturer: util.Inspur,
+		Models:       regexp.MustCompile(`NF8260M5|NF8460M5|NF8480M5`),
+		SetSnmpTrap:  inspurSnmpTrap("0x3a 0x08"), // 四路M5机型设置告警端口号的命令不同
+	})
+}
+
+// inspurSnmpTrap 返回浪潮BMC设置SNMP告警的实现，portCmd为设置告警端口号的原始命令。
+func inspurSnmpTrap(portCmd string) func(r Runner, snmpset *oob.SnmpSet) error {
+	return func(r Runner, snmpset *oob.SnmpSet) (err error) {
+		var version, alarmseverity, authProtocol, privProtocol string
+		switch snmpset.SnmpTrapVersion {
+		case "1":
+			version = "0x01"
+		case "2c":
+			version = "0x02"
+		case "3":
+			version = "0x03"
+		}
+		if version == "" {
+			return errors.New("版本不合法 合法值为1/2c/3")
+		}
+		//设置告警版本
+		if _, err = r.Raw("0x3c 0x19 0x00", version); err != nil {
+			return err
+		}
+		switch snmpset.SnmpTrapAlarmseverity {
+		case "critical":
+			alarmseverity = "0x02"
+		case "warning":
+			alarmseverity = "0x01"
+		case "all":
+			alarmseverity = "0x00"
+		}
+		if alarmseverity == "" {
+			return errors.New("告警Event等级不合法 合法值为critical/warning/all")
+		}
+		//设置告警Event等级
+		if _, err = r.Raw("0x3c 0x19 0x0d", alarmseverity); err != nil {
+			return err
+		}
+		if snmpset.SnmpTrapVersion == "1" || snmpset.SnmpTrapVersion == "2c" {
+			if snmpset.CommunityName == "" {
+				return errors.New("团体名不合法 不能为空")
+			}
+			//设置团体名
+			if _, err = r.Raw("0x3c 0x19 0x01", fmt.Sprintf(`string2HexData "设置团体名" "%s" 161`, snmpset.CommunityName)); err != nil {
+				return err
+			}
+			//清空用户名
+			_, err = r.Raw("0x3C 0x19 0x02", "0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00")
+		} else {
+			if snmpset.SnmpV3User == "" || snmpset.SnmpV3AuthPassword == "" || snmpset.SnmpV3PrivPassword == "" {
+				return errors.New("用户名 必需参数用户名或认证密码或加密密码为空")
+			}
+			switch snmpset.SnmpV3AuthProtocol {
+			case "SHA":
+				authProtocol = "0x01"
+			case "MD5":
+				authProtocol = "0x02"
+			}
+			if authProtocol == "" {
+				return errors.New("告警认证协议不合法 可选SHA或MD5")
+			}
+			switch snmpset.SnmpV3PrivProtocol {
+			case "DES":
+				privProtocol = "0x01"
+			case "AES":
+				privProtocol = "0x02"
+			}
+			if privProtocol == "" {
+				return errors.New("告警加密协议不合法 可选DES或AES")
+			}
+			//设置告警用户名
+			if _, err = r.Raw("0x3C 0x19 0x02", fmt.Sprintf(`string2HexData "设置告警用户名%s" "%s" 32`, snmpset.SnmpV3User, snmpset.SnmpV3User)); err != nil {
+				return err
+			}
+			//设置告警认证协议
+			if _, err = r.Raw("0x3c 0x19 0x03", authProtocol); err != nil {
+				return err
+			}
+			//设置告警认证密码
+			if _, err = r.Raw("0x3c 0x19 0x05", fmt.Sprintf(`string2HexData "设置告警认证密码" "%s" 161`, snmpset.SnmpV3AuthPassword)); err != nil {
+				return err
+			}
+			//设置告警加密协议
+			if _, err = r.Raw("0x3c 0x19 0x04", privProtocol); err != nil {
+				return err
+			}
+			//设置告警加密密码
+			if _, err = r.Raw("0x3c 0x19 0x06", fmt.Sprintf(`string2HexData "转换加密密码" "%s" 161`, snmpset.SnmpV3PrivPassword)); err != nil {
+				return err
+			}
+			if snmpset.SnmpTrapEngineId > 0 {
+				//设置告警引擎号EngineID
+				if _, err = r.Raw("0x3c 0x19 0x07", fmt.Sprintf(`string2HexData "设置告警引擎ID%d" "%d" 48`, snmpset.SnmpTrapEngineId, snmpset.SnmpTrapEngineId)); err != nil {
+					return err
+				}
+			}
+		}
+		if snmpset.SnmpTrapSystemName != "" {
+			//设置告警系统名
+			if _, err = r.Raw("0x3c 0x19 0x0b", fmt.Sprintf(`string2HexData "设置告警系统名%s" "%s" 32`, snmpset.SnmpTrapSystemName, snmpset.SnmpTrapSystemName)); err != nil {
+				return err
+			}
+		}
+		if snmpset.SnmpTrapSystemId > 0 {
+			//设置告警系统ID
+			if _, err = r.Raw("0x3c 0x19 0x0c", fmt.Sprintf(`string2HexData "设置告警系统ID%d" "%d" 16`, snmpset.SnmpTrapSystemId, snmpset.SnmpTrapSystemId)); err != nil {
+				return err
+			}
+		}
+		if snmpset.SnmpTrapLocation != "" {
+			//设置告警主机位置
+			if _, err = r.Raw("0x3c 0x19 0x09", fmt.Sprintf(`string2HexData "设置告警主机位置%s" "%s" 48`, snmpset.SnmpTrapLocation, snmpset.SnmpTrapLocation)); err != nil {
+				return err
+			}
+		}
+		if snmpset.SnmpTrapContact != "" {
+			//设置告警联系人
+			if _, err = r.Raw("0x3c 0x19 0x08", fmt.Sprintf(`string2HexData "设置告警联系人%s" "%s" 16`, snmpset.SnmpTrapContact, snmpset.SnmpTrapContact)); err != nil {
+				return err
+			}
+		}
+		if snmpset.SnmpTrapHostOs != "" {
+			//设置告警系统主机名
+			if _, err = r.Raw("0x3c 0x19 0x0a", fmt.Sprintf(`string2HexData "设置告警系统主机名%s" "%s" 16`, snmpset.SnmpTrapHostOs, snmpset.SnmpTrapHostOs)); err != nil {
+				return err
+			}
+		}
+		if snmpset.SnmpTrapPortNo > 0 {
+			//设置告警端口号
+			if _, err = r.Raw(portCmd, fmt.Sprintf("%d", snmpset.SnmpTrapPortNo)); err != nil {
+				return err
+			}
+		}
+		for _, v := range snmpset.SnmpTrapServer {
+			if v.SnmpTrapPolicy == "disable" {
+				//关闭告警策略
+				if _, err = r.Raw("0x04 0x12 0x09", fmt.Sprintf("0x0%d", v.TrapID), fmt.Sprintf("0x%d0", v.TrapID), fmt.Sprintf("0x1%d", v.TrapID), "0x00"); err != nil {
+					return err
+				}
+			}
+			if v.SnmpTrapPolicy == "enable" {
+				//开启告警策略
+				if _, err = r.Raw("0x04 0x12 0x09", fmt.Sprintf("0x0%d", v.TrapID), fmt.Sprintf("0x%d8", v.TrapID), fmt.Sprintf("0x%d%d", v.SnmpTrapChannel, v.TrapID), "0x00"); err != nil {
+					return err
+				}
+			}
+			trapType := ""
+			switch v.SnmpTrapType {
+			case "email":
+				trapType = ""
+			case "snmp":
+				trapType = "00"
+			}
+			if trapType != "" {
+				//设置告警类型
+				if _, err = r.Raw("0x0c 0x01", fmt.Sprintf("0x0%d 0x12 ", v.SnmpTrapChannel), fmt.Sprintf("0x0%d", v.TrapID), fmt.Sprintf(" 0x%s 0x03 0x03", trapType)); err != nil {
+					return err
+				}
+			}
+			if v.SnmpTrapDestination != "" {
+				if net.ParseIP(v.SnmpTrapDestination).To4() != nil {
+					if _, err = r.Raw("0x0c 0x01", fmt.Sprintf("0x0%d 0xC1 ", v.SnmpTrapChannel), fmt.Sprintf(" 0x0%d 0x01 0x00", v.TrapID), fmt.Sprintf("%s 0x00 0x00 0x00 0x00 0x00 0x00", v.SnmpTrapDestination)); err != nil {
+						return err
+					}
+				}
+				if net.ParseIP(v.SnmpTrapDestination).To16() != nil {
+					if _, err = r.Raw("0x0c 0x01", fmt.Sprintf("0x0%d 0x13 ", v.SnmpTrapChannel), fmt.Sprintf(" 0x0%d 0x00 0x00", v.TrapID), fmt.Sprintf("%s 0x00 0x00 0x00 0x00 0x00 0x00", v.SnmpTrapDestination)); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return err
+	}
+}
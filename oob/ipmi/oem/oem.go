@@ -0,0 +1,90 @@
+// Package oem 提供BMC厂商OEM扩展的注册及查找。
+// 各厂商的SNMP告警、用户访问权限等依赖OEM原始命令的实现以扩展形式注册，
+// 由IPMI处理器按设备的厂商及型号选用，新增厂商时无需修改处理器。
+package oem
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// Runner 扩展执行命令的方式，由IPMI处理器实现。
+type Runner interface {
+	// Raw 发送原始IPMI命令，参数与'ipmitool raw'一致。
+	Raw(args ...string) ([]byte, error)
+	// Exec 执行厂商配置工具（如racadm）
+	Exec(cmd string, args ...string) ([]byte, error)
+}
+
+// Extension 厂商OEM扩展。未实现的操作为nil，由处理器采用标准IPMI命令或返回不支持。
+type Extension struct {
+	Name         string         // 扩展名称
+	Manufacturer string         // 厂商名，取值同util.ManufacturerName的返回值。
+	Models       *regexp.Regexp // 适用的型号，为nil时适用于该厂商的全部型号。
+
+	// SetSnmpTrap 设置SNMP告警
+	SetSnmpTrap func(r Runner, sett *oob.SnmpSet) error
+	// EnableUserAccess 开启用户访问厂商管理界面（如iDRAC）的权限
+	EnableUserAccess func(r Runner, userID int) error
+}
+
+// Match 返回扩展是否适用于指定厂商及型号的布尔值
+func (ext *Extension) Match(manufacturer, model string) bool {
+	if util.ManufacturerName(manufacturer) != ext.Manufacturer {
+		return false
+	}
+	return ext.Models == nil || ext.Models.MatchString(model)
+}
+
+var (
+	extensions []*Extension
+	mux        sync.Mutex
+)
+
+// Register 注册厂商OEM扩展
+func Register(ext *Extension) {
+	mux.Lock()
+	defer mux.Unlock()
+	if ext == nil || ext.Name == "" || ext.Manufacturer == "" {
+		panic("oem: Register extension is invalid")
+	}
+	for i := range extensions {
+		if strings.EqualFold(extensions[i].Name, ext.Name) {
+			panic("oem: Register called twice for extension " + ext.Name)
+		}
+	}
+	extensions = append(extensions, ext)
+}
+
+// Registered 返回已注册的扩展名称
+func Registered() (items []string) {
+	mux.Lock()
+	defer mux.Unlock()
+	for i := range extensions {
+		items = append(items, extensions[i].Name)
+	}
+	return items
+}
+
+// Lookup 返回适用于指定厂商及型号的扩展。指定了型号的扩展优先于适用于全部型号的扩展，无适用扩展时返回nil。
+func Lookup(manufacturer, model string) *Extension {
+	mux.Lock()
+	defer mux.Unlock()
+	var fallback *Extension
+	for _, ext := range extensions {
+		if !ext.Match(manufacturer, model) {
+			continue
+		}
+		if ext.Models != nil {
+			return ext
+		}
+		if fallback == nil {
+			fallback = ext
+		}
+	}
+	return fallback
+}
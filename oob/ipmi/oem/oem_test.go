@@ -0,0 +1,123 @@
+package oem
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeRunner 记录扩展执行的命令
+type fakeRunner struct {
+	cmds []string
+	err  error
+}
+
+func (r *fakeRunner) Raw(args ...string) ([]byte, error) {
+	r.cmds = append(r.cmds, "raw "+strings.Join(args, " "))
+	return nil, r.err
+}
+
+func (r *fakeRunner) Exec(cmd string, args ...string) ([]byte, error) {
+	r.cmds = append(r.cmds, cmd+" "+strings.Join(args, " "))
+	return nil, r.err
+}
+
+func TestLookup(t *testing.T) {
+	Convey("按厂商及型号查找OEM扩展", t, func() {
+		So(Registered(), ShouldContain, "Inspur")
+		So(Registered(), ShouldContain, "Dell-iDRAC")
+
+		ext := Lookup("Inspur", "NF5280M5")
+		So(ext, ShouldNotBeNil)
+		So(ext.Name, ShouldEqual, "Inspur")
+
+		ext = Lookup("INSPUR", "NF8260M5")
+		So(ext, ShouldNotBeNil)
+		So(ext.Name, ShouldEqual, "Inspur-M5-4S")
+
+		ext = Lookup("DELL Inc.", "PowerEdge R730")
+		So(ext, ShouldNotBeNil)
+		So(ext.EnableUserAccess, ShouldNotBeNil)
+		So(ext.SetSnmpTrap, ShouldBeNil)
+
+		So(Lookup("Supermicro", "SYS-1029P"), ShouldBeNil)
+		So(Lookup("", ""), ShouldBeNil)
+
+		Convey("重复注册", func() {
+			So(func() { Register(&Extension{Name: "inspur", Manufacturer: util.Inspur}) }, ShouldPanic)
+			So(func() { Register(&Extension{Name: "no-manufacturer"}) }, ShouldPanic)
+		})
+
+		Convey("新增厂商扩展", func() {
+			Register(&Extension{
+				Name:         "Supermicro-X11",
+				Manufacturer: util.Supermicro,
+				Models:       regexp.MustCompile(`^SYS-\d+P`),
+				EnableUserAccess: func(r Runner, userID int) error {
+					_, err := r.Raw("0x30 0x70 0x0c", strconv.Itoa(userID))
+					return err
+				},
+			})
+			ext := Lookup("Supermicro", "SYS-1029P")
+			So(ext, ShouldNotBeNil)
+			var r fakeRunner
+			So(ext.EnableUserAccess(&r, 3), ShouldBeNil)
+			So(r.cmds, ShouldResemble, []string{"raw 0x30 0x70 0x0c 3"})
+			So(Lookup("Supermicro", "X10DRi"), ShouldBeNil)
+		})
+	})
+}
+
+func TestInspurSnmpTrap(t *testing.T) {
+	Convey("浪潮设置SNMP告警", t, func() {
+		sett := oob.SnmpSet{
+			SnmpTrapVersion:       "2c",
+			SnmpTrapAlarmseverity: "critical",
+			CommunityName:         "public",
+			SnmpTrapPortNo:        162,
+		}
+
+		Convey("通用机型", func() {
+			var r fakeRunner
+			So(Lookup(util.Inspur, "NF5280M5").SetSnmpTrap(&r, &sett), ShouldBeNil)
+			So(len(r.cmds), ShouldEqual, 5)
+			So(r.cmds[0], ShouldEqual, "raw 0x3c 0x19 0x00 0x02")
+			So(r.cmds[1], ShouldEqual, "raw 0x3c 0x19 0x0d 0x02")
+			So(r.cmds[2], ShouldEqual, `raw 0x3c 0x19 0x01 string2HexData "设置团体名" "public" 161`)
+			So(r.cmds[4], ShouldEqual, "raw 0x3c 0x19 0x0e 162")
+		})
+
+		Convey("四路M5机型", func() {
+			var r fakeRunner
+			So(Lookup(util.Inspur, "NF8480M5").SetSnmpTrap(&r, &sett), ShouldBeNil)
+			So(r.cmds[len(r.cmds)-1], ShouldEqual, "raw 0x3a 0x08 162")
+		})
+
+		Convey("参数不合法", func() {
+			var r fakeRunner
+			sett.SnmpTrapVersion = "4"
+			So(Lookup(util.Inspur, "").SetSnmpTrap(&r, &sett), ShouldNotBeNil)
+			So(r.cmds, ShouldBeEmpty)
+		})
+
+		Convey("命令执行失败", func() {
+			r := fakeRunner{err: errors.New("exec error")}
+			So(Lookup(util.Inspur, "").SetSnmpTrap(&r, &sett), ShouldEqual, r.err)
+			So(len(r.cmds), ShouldEqual, 1)
+		})
+	})
+}
+
+func TestDellUserAccess(t *testing.T) {
+	Convey("开启iDRAC访问权限", t, func() {
+		var r fakeRunner
+		So(Lookup(util.Dell, "").EnableUserAccess(&r, 3), ShouldBeNil)
+		So(r.cmds, ShouldResemble, []string{racadmTool + " config -g cfgUserAdmin -i 3 -o cfgUserAdminPrivilege 0x000001ff"})
+	})
+}
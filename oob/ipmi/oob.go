@@ -3,11 +3,13 @@ package ipmi
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"github.com/licairong/cloudboot-provider-framework/oob"
 	"github.com/licairong/cloudboot-provider-framework/util"
-	"net"
+	"io/ioutil"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +18,7 @@ import (
 )
 
 var _ oob.Worker = (*worker)(nil)
+var _ oob.ContextWorker = (*worker)(nil)
 
 const (
 	// name 处理器名称
@@ -24,6 +27,11 @@ const (
 	tool = "ipmitool"
 )
 
+func init() {
+	oob.Register(name, NewWorker())
+	oob.RegisterFactory(name, NewWorker)
+}
+
 type worker struct {
 	opts     *oob.Options
 	log      util.Logger
@@ -63,6 +71,13 @@ func (w *worker) Name() string {
 	return name
 }
 
+// WithContext 返回绑定了context的处理器副本
+func (w *worker) WithContext(ctx context.Context) oob.Worker {
+	cp := *w
+	cp.executor = util.WithContext(w.executor, ctx)
+	return &cp
+}
+
 const (
 	lanSendCmdFailed    = "IPMI LAN send command failed"
 	unableEstablish     = "Unable to establish"
@@ -304,8 +319,10 @@ func (w *worker) GenerateUser(sett *oob.UserSettingItem) error {
 		return err
 	}
 
-	// 尝试开启iDRAC访问权限
-	_ = w.enableUserAccessIDRAC(userID)
+	// 尝试开启厂商管理界面（如iDRAC）的访问权限
+	if ext, _ := w.extension("", ""); ext != nil && ext.EnableUserAccess != nil {
+		_ = ext.EnableUserAccess(w.oemRunner(), userID)
+	}
 
 	switch sett.Status {
 	case oob.EnabledUser:
@@ -455,9 +472,17 @@ func (w *worker) SelClear() error {
 	return err
 }
 
-// SensorList 返回物理机传感器信息。
-func (w *worker) SensorList() (sensorlist []*oob.SensorDevice, err error) {
-	output, err := w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "sensor", "list")
+// EventLogs 返回序号大于since的事件日志。
+// 通过'ipmitool sel writeraw'将原始SEL记录导出至临时文件后解码。
+func (w *worker) EventLogs(since int) (items []*oob.EventLog, err error) {
+	f, err := ioutil.TempFile("", "sel-*.raw")
+	if err != nil {
+		return nil, err
+	}
+	_ = f.Close()
+	defer os.Remove(f.Name())
+
+	output, err := w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "sel", "writeraw", f.Name())
 	if err != nil {
 		msg := string(output)
 		if strings.Contains(msg, lanSendCmdFailed) && strings.Contains(msg, unableEstablish) {
@@ -472,202 +497,58 @@ func (w *worker) SensorList() (sensorlist []*oob.SensorDevice, err error) {
 		}
 		return nil, err
 	}
-	return w.parseSensorlist(output)
-}
-
-// SensorList 返回物理机传感器信息。
-func (w *worker) SetSnmpTrap(snmpset *oob.SnmpSet) (err error) {
-	var version, alarmseverity, authProtocol, privProtocol string
-	switch snmpset.SnmpTrapVersion {
-	case "1":
-		version = "0x01"
-	case "2c":
-		version = "0x02"
-	case "3":
-		version = "0x03"
-	}
-	if version == "" {
-		return errors.New("版本不合法 合法值为1/2c/3")
-	}
-	//设置告警版本
-	_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x00", version)
+	data, err := ioutil.ReadFile(f.Name())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	switch snmpset.SnmpTrapAlarmseverity {
-	case "critical":
-		alarmseverity = "0x02"
-	case "warning":
-		alarmseverity = "0x01"
-	case "all":
-		alarmseverity = "0x00"
+	return w.parseSEL(data, since)
+}
+
+// SensorList 返回物理机传感器信息。若指定了SDR缓存文件，则通过'ipmitool -S'复用缓存的SDR记录，
+// 使用缓存读取失败或未读取到传感器时重新生成缓存后重试。
+func (w *worker) SensorList() (sensorlist []*oob.SensorDevice, err error) {
+	cache := w.sdrCache(false)
+	sensorlist, err = w.sensorList(cache)
+	if cache != "" && (err == nil && len(sensorlist) == 0 ||
+		err != nil && !oob.IsIPUnreachableError(err) && !oob.IsUsernamePasswordError(err)) {
+		sensorlist, err = w.sensorList(w.sdrCache(true))
 	}
-	if alarmseverity == "" {
-		return errors.New("告警Event等级不合法 合法值为critical/warning/all")
+	return sensorlist, err
+}
+
+// sensorList 通过'ipmitool sensor list'返回传感器信息，cache不为空时复用缓存的SDR记录。
+func (w *worker) sensorList(cache string) ([]*oob.SensorDevice, error) {
+	args := []string{w.remoteArgs()}
+	if cache != "" {
+		args = append(args, "-S", cache)
 	}
-	//设置告警Event等级
-	_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x0d", alarmseverity)
+	output, err := w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, append(args, "sensor", "list")...)
 	if err != nil {
-		return err
-	}
-	//设置团体名
-	if snmpset.SnmpTrapVersion == "1" || snmpset.SnmpTrapVersion == "2c" {
-		if snmpset.CommunityName == "" {
-			return errors.New("团体名不合法 不能为空")
-		}
-		//设置团体名
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x01", fmt.Sprintf(`string2HexData "设置团体名" "%s" 161`, snmpset.CommunityName))
-		if err != nil {
-			return err
-		}
-		//清空用户名
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3C 0x19 0x02", "0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00 0x00")
-	} else {
-		if snmpset.SnmpV3User == "" || snmpset.SnmpV3AuthPassword == "" || snmpset.SnmpV3PrivPassword == "" {
-			return errors.New("用户名 必需参数用户名或认证密码或加密密码为空")
-		}
-		switch snmpset.SnmpV3AuthProtocol {
-		case "SHA":
-			authProtocol = "0x01"
-		case "MD5":
-			authProtocol = "0x02"
-		}
-		if authProtocol == "" {
-			return errors.New("告警认证协议不合法 可选SHA或MD5")
-		}
-		switch snmpset.SnmpV3PrivProtocol {
-		case "DES":
-			privProtocol = "0x01"
-		case "AES":
-			privProtocol = "0x02"
-		}
-		if privProtocol == "" {
-			return errors.New("告警加密协议不合法 可选DES或AES")
-		}
-		//设置告警用户名
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3C 0x19 0x02", fmt.Sprintf(`string2HexData "设置告警用户名%s" "%s" 32`, snmpset.SnmpV3User, snmpset.SnmpV3User))
-		if err != nil {
-			return err
-		}
-		//设置告警认证协议
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x03", authProtocol)
-		if err != nil {
-			return err
-		}
-		//设置告警认证密码
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x05", fmt.Sprintf(`string2HexData "设置告警认证密码" "%s" 161`, snmpset.SnmpV3AuthPassword))
-		if err != nil {
-			return err
-		}
-		//设置告警加密协议
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x04", privProtocol)
-		if err != nil {
-			return err
-		}
-		//设置告警加密密码
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x06", fmt.Sprintf(`string2HexData "转换加密密码" "%s" 161`, snmpset.SnmpV3PrivPassword))
-		if err != nil {
-			return err
-		}
-		if snmpset.SnmpTrapEngineId > 0 {
-			//设置告警引擎号EngineID
-			_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x07", fmt.Sprintf(`string2HexData "设置告警引擎ID%d" "%d" 48`, snmpset.SnmpTrapEngineId, snmpset.SnmpTrapEngineId))
-			if err != nil {
-				return err
+		msg := string(output)
+		if strings.Contains(msg, lanSendCmdFailed) && strings.Contains(msg, unableEstablish) {
+			var oobip string
+			if w != nil && w.opts != nil {
+				oobip = w.opts.Hostname
 			}
+			return nil, oob.NewIPUnreachableError(oobip, err)
 		}
-	}
-	if snmpset.SnmpTrapSystemName != "" {
-		//设置告警系统名
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x0b", fmt.Sprintf(`string2HexData "设置告警系统名%s" "%s" 32`, snmpset.SnmpTrapSystemName, snmpset.SnmpTrapSystemName))
-		if err != nil {
-			return err
-		}
-	}
-	if snmpset.SnmpTrapSystemId > 0 {
-		//设置告警系统ID
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x0c", fmt.Sprintf(`string2HexData "设置告警系统ID%d" "%d" 16`, snmpset.SnmpTrapSystemId, snmpset.SnmpTrapSystemId))
-		if err != nil {
-			return err
-		}
-	}
-	if snmpset.SnmpTrapLocation != "" {
-		//设置告警主机位置
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x09", fmt.Sprintf(`string2HexData "设置告警主机位置%s" "%s" 48`, snmpset.SnmpTrapLocation, snmpset.SnmpTrapLocation))
-		if err != nil {
-			return err
-		}
-	}
-	if snmpset.SnmpTrapContact != "" {
-		//设置告警联系人
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x08", fmt.Sprintf(`string2HexData "设置告警联系人%s" "%s" 16`, snmpset.SnmpTrapContact, snmpset.SnmpTrapContact))
-		if err != nil {
-			return err
-		}
-	}
-	if snmpset.SnmpTrapHostOs != "" {
-		//设置告警系统主机名
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x3c 0x19 0x0a", fmt.Sprintf(`string2HexData "设置告警系统主机名%s" "%s" 16`, snmpset.SnmpTrapHostOs, snmpset.SnmpTrapHostOs))
-		if err != nil {
-			return err
+		if strings.Contains(msg, unableEstablish) {
+			return nil, oob.NewUsernamePasswordError(err)
 		}
+		return nil, err
 	}
-	if snmpset.SnmpTrapPortNo > 0 {
-		raw := "raw 0x3c 0x19 0x0e"
-		if strings.Contains(snmpset.Devicemodel, "NF8260M5") || strings.Contains(snmpset.Devicemodel, "NF8460M5") || strings.Contains(snmpset.Devicemodel, "NF8480M5") {
-			raw = "raw 0x3a 0x08"
-		}
-		//设置告警端口号
-		_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), raw, fmt.Sprintf("%d", snmpset.SnmpTrapPortNo))
-		if err != nil {
-			return err
-		}
+	return w.parseSensorlist(output)
+}
+
+// SetSnmpTrap 设置snmptrap。依赖厂商OEM命令，由适用于当前设备厂商及型号的OEM扩展（目前仅浪潮）实现。
+// 按FRU确定设备厂商，FRU读取失败或不适用时采用snmpset.Manufacturer；仍无适用扩展时返回oob.ErrNotSupported。
+func (w *worker) SetSnmpTrap(snmpset *oob.SnmpSet) (err error) {
+	ext, err := w.extension(snmpset.Manufacturer, snmpset.Devicemodel)
+	if err != nil {
+		return err
 	}
-	for _, v := range snmpset.SnmpTrapServer {
-		if v.SnmpTrapPolicy == "disable" {
-			//关闭告警策略
-			_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x04 0x12 0x09", fmt.Sprintf("0x0%s", v.TrapID), fmt.Sprintf("0x%s0", v.TrapID), fmt.Sprintf("0x1%s", v.TrapID), "0x00")
-			if err != nil {
-				return err
-			}
-		}
-		if v.SnmpTrapPolicy == "enable" {
-			//开启告警策略
-			_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x04 0x12 0x09", fmt.Sprintf("0x0%s", v.TrapID), fmt.Sprintf("0x%s8", v.TrapID), fmt.Sprintf("0x%s%s", v.SnmpTrapChannel, v.TrapID), "0x00")
-			if err != nil {
-				return err
-			}
-		}
-		trapType := ""
-		switch v.SnmpTrapType {
-		case "email":
-			trapType = ""
-		case "snmp":
-			trapType = "00"
-		}
-		if trapType != "" {
-			//设置告警类型
-			_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x0c 0x01", fmt.Sprintf("0x0%s 0x12 ", v.SnmpTrapChannel), fmt.Sprintf("0x0%s", v.TrapID), fmt.Sprintf(" 0x%s 0x03 0x03", trapType))
-			if err != nil {
-				return err
-			}
-		}
-		if v.SnmpTrapDestination != "" {
-			b_ip4 := net.ParseIP(v.SnmpTrapDestination).To4()
-			if b_ip4 != nil {
-				_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x0c 0x01", fmt.Sprintf("0x0%s 0xC1 ", v.SnmpTrapChannel), fmt.Sprintf(" 0x0%s 0x01 0x00", v.TrapID), fmt.Sprintf("%s 0x00 0x00 0x00 0x00 0x00 0x00", v.SnmpTrapDestination))
-				if err != nil {
-					return err
-				}
-			}
-			b_ip6 := net.ParseIP(v.SnmpTrapDestination).To16()
-			if b_ip6 != nil {
-				_, err = w.executor.Exec(&util.ExecutionOptions{Shadows: w.shadows}, tool, w.remoteArgs(), "raw 0x0c 0x01", fmt.Sprintf("0x0%s 0x13 ", v.SnmpTrapChannel), fmt.Sprintf(" 0x0%s 0x00 0x00", v.TrapID), fmt.Sprintf("%s 0x00 0x00 0x00 0x00 0x00 0x00", v.SnmpTrapDestination))
-				if err != nil {
-					return err
-				}
-			}
-		}
+	if ext == nil || ext.SetSnmpTrap == nil {
+		return oob.ErrNotSupported
 	}
-	return err
+	return ext.SetSnmpTrap(w.oemRunner(), snmpset)
 }
@@ -321,3 +321,32 @@ func TestSetDHCP(t *testing.T) {
 		})
 	})
 }
+
+func TestSetSnmpTrap(t *testing.T) {
+	Convey("设置snmptrap", t, func() {
+		var bash *hardware.Bash
+		Convey("FRU读取失败时采用调用方提供的厂商", func() {
+			monkey.PatchInstanceMethod(reflect.TypeOf(bash), "Exec", func(b *hardware.Bash, opts *hardware.ExecutionOptions, cmd string, args ...string) ([]byte, error) {
+				return nil, errors.New("fru read failed")
+			})
+			defer monkey.UnpatchAll()
+
+			err := NewWorker().SetSnmpTrap(&oob.SnmpSet{Manufacturer: "Inspur", SnmpTrapVersion: "x"})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "版本不合法")
+
+			err = NewWorker().SetSnmpTrap(&oob.SnmpSet{SnmpTrapVersion: "2c"})
+			So(err, ShouldNotBeNil)
+			So(err, ShouldNotEqual, oob.ErrNotSupported)
+		})
+
+		Convey("无适用的OEM扩展", func() {
+			monkey.PatchInstanceMethod(reflect.TypeOf(bash), "Exec", func(b *hardware.Bash, opts *hardware.ExecutionOptions, cmd string, args ...string) ([]byte, error) {
+				return ioutil.ReadFile("./testdata/ipmitool_fru_list_0_dell.txt")
+			})
+			defer monkey.UnpatchAll()
+
+			So(NewWorker().SetSnmpTrap(&oob.SnmpSet{SnmpTrapVersion: "2c"}), ShouldEqual, oob.ErrNotSupported)
+		})
+	})
+}
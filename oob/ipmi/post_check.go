@@ -8,7 +8,7 @@ import (
 )
 
 // checkNetwork 检查实际的OOB网络是否与预期的配置相符
-func (w *worker) checkNetwork(sett *oob.NetworkSetting) (items []*hardware.CheckingItem) {
+func (w *worker) checkNetwork(sett *oob.NetworkSetting) (items []*util.CheckingItem) {
 	if sett == nil || sett.IPSrc == "" {
 		return nil
 	}
@@ -1,6 +1,7 @@
 package oob
 
 import (
+	"context"
 	"github.com/licairong/cloudboot-provider-framework/util"
 	"strconv"
 	"time"
@@ -9,6 +10,10 @@ import (
 const (
 	// DefaultWorker 默认处理器名称
 	DefaultWorker = "IPMI"
+	// RedfishWorker 基于Redfish的处理器名称
+	RedfishWorker = "REDFISH"
+	// NativeWorker 基于纯Go IPMI客户端的处理器名称
+	NativeWorker = "IPMI-NATIVE"
 )
 
 const (
@@ -56,12 +61,20 @@ type Worker interface {
 	Raw(args string) (response []byte, err error)
 	// SelClear 日志清理
 	SelClear() error
+	// EventLogs 返回序号大于since的事件日志（按序号升序），since为0时返回全部事件日志。
+	EventLogs(since int) ([]*EventLog, error)
 	// SensorList 返回物理机传感器信息。
 	SensorList() ([]*SensorDevice, error)
 	// SetSnmpTrap 设置snmptrap
 	SetSnmpTrap(*SnmpSet) (err error)
 }
 
+// ContextWorker 可绑定context的OOB处理器。
+// 通过WithContext返回的处理器执行操作时，若context被取消或超时，则终止正在执行的底层命令。
+type ContextWorker interface {
+	WithContext(ctx context.Context) Worker
+}
+
 // FRUDevice FRU设备基本信息
 type FRUDevice struct {
 	ProductManufacturer string // 物理机厂商名
@@ -147,9 +160,27 @@ type BMCWorker interface {
 	BMCColdReset() error
 }
 
-// Whoami 返回当前的BIOS固件对应的处理器名
-func Whoami() (worker string, err error) {
-	return DefaultWorker, nil // 暂时只有一个实现
+// Whoami 探测BMC支持的管理接口并返回相应的处理器名。
+// 指定了带外主机时依次调用各处理器注册的探测函数（如Redfish），返回首个探测成功的处理器名，均不成功时返回DefaultWorker。
+func Whoami(setters ...func(*Options)) (worker string, err error) {
+	var opts Options
+	for i := range setters {
+		setters[i](&opts)
+	}
+	if opts.Hostname == "" {
+		return DefaultWorker, nil
+	}
+	names, items := registeredProbes()
+	for i := range items {
+		if err := items[i](&opts); err != nil {
+			if opts.Log != nil {
+				opts.Log.Debugf("%s is unavailable on %s: %s", names[i], opts.Hostname, err)
+			}
+			continue
+		}
+		return names[i], nil
+	}
+	return DefaultWorker, nil
 }
 
 const (
@@ -196,6 +227,7 @@ type SensorDevice struct {
 	Upnorec  string
 }
 type SnmpSet struct {
+	Manufacturer          string // 设备厂商，无法从FRU确定适用的OEM扩展时使用。
 	Devicemodel           string
 	SnmpTrapVersion       string
 	SnmpV3User            string
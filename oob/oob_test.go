@@ -1,6 +1,7 @@
 package oob
 
 import (
+	"errors"
 	"sort"
 	"testing"
 
@@ -23,3 +24,135 @@ func TestBySeqASC(t *testing.T) {
 		So(items[2].SeqNumber, ShouldEqual, "3")
 	})
 }
+
+func TestWhoami(t *testing.T) {
+	Convey("探测BMC支持的管理接口", t, func() {
+		var available bool
+		RegisterProbe("fake", func(opts *Options) error {
+			if !available {
+				return errors.New("not found")
+			}
+			return nil
+		})
+		defer func() {
+			mux.Lock()
+			delete(probes, "FAKE")
+			mux.Unlock()
+		}()
+
+		Convey("未指定带外主机", func() {
+			available = true
+			worker, err := Whoami()
+			So(err, ShouldBeNil)
+			So(worker, ShouldEqual, DefaultWorker)
+		})
+
+		Convey("探测成功", func() {
+			available = true
+			worker, err := Whoami(WithRemote("", "10.0.0.1", "root", "calvin"))
+			So(err, ShouldBeNil)
+			So(worker, ShouldEqual, "FAKE")
+		})
+
+		Convey("探测失败", func() {
+			worker, err := Whoami(WithRemote("", "10.0.0.1", "root", "calvin"))
+			So(err, ShouldBeNil)
+			So(worker, ShouldEqual, DefaultWorker)
+		})
+	})
+}
+
+func TestSensor(t *testing.T) {
+	Convey("结构化传感器信息", t, func() {
+		sensors := NewSensors([]*SensorDevice{
+			{Name: "Inlet Temp", Value: "23.000", Units: "degrees C", State: "ok", Locrit: "3.000", Lonocrit: "8.000", Upnocrit: "42.000", Upcrit: "47.000"},
+			{Name: "Fan1 RPM", Value: "5880.000", Units: "RPM", State: "ok", Locrit: "600.000"},
+			{Name: "PS1 Voltage", Value: "230.000", Units: "Volts", State: "ok"},
+			{Name: "Pwr Consumption", Value: "168.000", Units: "Watts", State: "ok", Upnocrit: "896.000", Upcrit: "980.000"},
+			{Name: "Current 1", Value: "0.600", Units: "Amps", State: "ok"},
+			{Name: "Drive 0", Value: "0x0", Units: "discrete", State: "0x0100"},
+			{Name: "Usage", Value: "", Units: "percent", State: "ns"},
+		})
+
+		So(len(sensors), ShouldEqual, 7)
+		So(sensors[0].Type, ShouldEqual, TemperatureSensor)
+		So(*sensors[0].Value, ShouldEqual, 23)
+		So(*sensors[0].Thresholds.UpperCritical, ShouldEqual, 47)
+		So(sensors[0].Thresholds.UpperNonRecoverable, ShouldBeNil)
+		So(sensors[1].Type, ShouldEqual, FanSensor)
+		So(sensors[2].Type, ShouldEqual, PSUSensor)
+		So(sensors[3].Type, ShouldEqual, PowerSensor)
+		So(sensors[4].Type, ShouldEqual, CurrentSensor)
+		So(sensors[5].Type, ShouldEqual, DriveSensor)
+		So(sensors[5].Value, ShouldBeNil)
+		So(sensors[6].Type, ShouldEqual, OtherSensor)
+		So(sensors[6].Unit, ShouldEqual, UnitPercent)
+
+		So(sensors[0].Health(), ShouldEqual, HealthOK)
+		So(sensors[5].Health(), ShouldEqual, HealthOK)
+		So(sensors[6].Health(), ShouldEqual, HealthUnknown)
+		So(sensors.Health(), ShouldEqual, HealthOK)
+		So(len(sensors.Filter(TemperatureSensor)), ShouldEqual, 1)
+		So(sensors.Filter(CurrentSensor)[0].Name, ShouldEqual, "Current 1")
+
+		Convey("按阈值评估健康状态", func() {
+			temp := sensors[0]
+			temp.State = ""
+			v := 42.0
+			temp.Value = &v
+			So(temp.Health(), ShouldEqual, HealthWarning)
+			v = 47
+			So(temp.Health(), ShouldEqual, HealthCritical)
+			v = 2
+			So(temp.Health(), ShouldEqual, HealthCritical)
+			So(sensors.Health(), ShouldEqual, HealthCritical)
+		})
+
+		Convey("优先采用BMC给出的状态", func() {
+			sensors[1].State = "nc"
+			So(sensors[1].Health(), ShouldEqual, HealthWarning)
+			sensors[1].State = "nr"
+			So(sensors[1].Health(), ShouldEqual, HealthCritical)
+		})
+
+		Convey("按离散状态评估健康状态", func() {
+			drive := sensors[5]
+			drive.State = "0x0300" // Drive Presence, Drive Fault
+			So(drive.Health(), ShouldEqual, HealthCritical)
+			drive.State = "0x0181" // Drive Presence, Rebuild/Remap Aborted
+			So(drive.Health(), ShouldEqual, HealthCritical)
+			drive.State = "0x0580" // Drive Presence, Predictive Failure
+			So(drive.Health(), ShouldEqual, HealthWarning)
+			drive.State = "0x0000"
+			So(drive.Health(), ShouldEqual, HealthUnknown)
+
+			psu := NewSensor(&SensorDevice{Name: "PS2 Status", Units: "discrete", State: "0x0B80"})
+			So(psu.Type, ShouldEqual, PSUSensor)
+			So(psu.Health(), ShouldEqual, HealthCritical) // Presence detected, Failure detected, AC lost
+			So(NewSensor(&SensorDevice{Name: "Fan Redundancy", Units: "discrete", State: "0x0180"}).Health(), ShouldEqual, HealthUnknown)
+
+			So(NewSensor(&SensorDevice{Name: "PS1 Status", State: "Presence detected, Failure detected"}).Health(), ShouldEqual, HealthCritical)
+			So(NewSensor(&SensorDevice{Name: "PS1 Status", State: "Presence detected, Predictive failure"}).Health(), ShouldEqual, HealthWarning)
+			So(NewSensor(&SensorDevice{Name: "PS2 Status", State: "Device Absent"}).Health(), ShouldEqual, HealthWarning)
+			So(NewSensor(&SensorDevice{Name: "Fan Redundancy", State: "Fully Redundant"}).Health(), ShouldEqual, HealthOK)
+			So(NewSensors([]*SensorDevice{
+				{Name: "Inlet Temp", Value: "23", Units: "degrees C", State: "ok"},
+				{Name: "Drive 1", Units: "discrete", State: "0x0300"},
+			}).Health(), ShouldEqual, HealthCritical)
+		})
+
+		Convey("无可评估的传感器", func() {
+			So(Sensors{sensors[6]}.Health(), ShouldEqual, HealthUnknown)
+			So(Sensors(nil).Health(), ShouldEqual, HealthUnknown)
+		})
+	})
+}
+
+func TestSDRCachePath(t *testing.T) {
+	Convey("SDR缓存文件路径", t, func() {
+		So((&Options{}).SDRCachePath(), ShouldBeEmpty)
+		So((&Options{SDRCache: "/var/cache/sdr"}).SDRCachePath(), ShouldEqual, "/var/cache/sdr")
+		So((&Options{SDRCache: "/var/cache/sdr", Hostname: "fe80::1%eth0"}).SDRCachePath(), ShouldEqual, "/var/cache/sdr.fe80__1_eth0")
+		So((&Options{SDRCache: "/var/cache/sdr", Hostname: "10.0.0.1"}).SDRCachePath(), ShouldEqual, "/var/cache/sdr.10.0.0.1")
+	})
+}
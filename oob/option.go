@@ -1,6 +1,9 @@
 package oob
 
 import (
+	"net/http"
+	"regexp"
+
 	"github.com/licairong/cloudboot-provider-framework/util"
 )
 
@@ -13,14 +16,16 @@ const (
 
 // Options 选项
 type Options struct {
-	Interface string            // 接口(协议)
-	Hostname  string            // 带外主机名/IP
-	Username  string            // 带外用户名
-	Password  string            // 带外密码
-	ChannelID int               // 通道ID
-	Debug     bool              // 若开启debug，会将关键日志信息写入console。
-	Log       util.Logger   // 日志实例
-	Executor  util.Executor // 执行器实例
+	Interface  string        // 接口(协议)
+	Hostname   string        // 带外主机名/IP
+	Username   string        // 带外用户名
+	Password   string        // 带外密码
+	ChannelID  int           // 通道ID
+	HTTPClient *http.Client  // Redfish等基于HTTP的处理器使用的HTTP客户端
+	SDRCache   string        // IPMI SDR缓存文件路径，为空时不缓存。
+	Debug      bool          // 若开启debug，会将关键日志信息写入console。
+	Log        util.Logger   // 日志实例
+	Executor   util.Executor // 执行器实例
 }
 
 // WithRemote 设置IPMI远程操作参数
@@ -54,9 +59,33 @@ func WithDebug(debug bool) func(*Options) {
 	}
 }
 
+// WithHTTPClient 设置基于HTTP的处理器使用的HTTP客户端
+func WithHTTPClient(client *http.Client) func(*Options) {
+	return func(opts *Options) {
+		opts.HTTPClient = client
+	}
+}
+
 // WithChannelID 设置channel id
 func WithChannelID(id int) func(*Options) {
 	return func(opts *Options) {
 		opts.ChannelID = id
 	}
 }
+
+// WithSDRCache 设置IPMI SDR缓存文件路径。读取传感器时复用缓存的SDR记录，避免每次都从BMC读取全部SDR。
+func WithSDRCache(path string) func(*Options) {
+	return func(opts *Options) {
+		opts.SDRCache = path
+	}
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// SDRCachePath 返回SDR缓存文件路径。指定了带外主机时文件名包含主机名，避免多个BMC共用同一缓存文件。
+func (opts *Options) SDRCachePath() string {
+	if opts.SDRCache == "" || opts.Hostname == "" {
+		return opts.SDRCache
+	}
+	return opts.SDRCache + "." + unsafeChars.ReplaceAllString(opts.Hostname, "_")
+}
@@ -0,0 +1,83 @@
+package redfish
+
+import (
+	"errors"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+)
+
+// ethernetInterface BMC网络接口
+type ethernetInterface struct {
+	path          string
+	MACAddress    string `json:"MACAddress"`
+	IPv4Addresses []struct {
+		Address       string `json:"Address"`
+		SubnetMask    string `json:"SubnetMask"`
+		Gateway       string `json:"Gateway"`
+		AddressOrigin string `json:"AddressOrigin"`
+	} `json:"IPv4Addresses"`
+}
+
+// ethernetInterface 返回BMC的首个网络接口
+func (w *worker) ethernetInterface() (*ethernetInterface, error) {
+	mgr, err := w.manager()
+	if err != nil {
+		return nil, err
+	}
+	if mgr.EthernetInterfaces.ID == "" {
+		return nil, errors.New("redfish: manager has no ethernet interfaces")
+	}
+	path, err := w.first(mgr.EthernetInterfaces.ID)
+	if err != nil {
+		return nil, err
+	}
+	eth := ethernetInterface{path: path}
+	if err = w.get(path, &eth); err != nil {
+		return nil, err
+	}
+	return &eth, nil
+}
+
+// Network 返回OOB网络信息
+func (w *worker) Network() (*oob.Network, error) {
+	eth, err := w.ethernetInterface()
+	if err != nil {
+		return nil, err
+	}
+	network := oob.Network{MAC: eth.MACAddress}
+	if len(eth.IPv4Addresses) > 0 {
+		addr := eth.IPv4Addresses[0]
+		network.IP = addr.Address
+		network.Netmask = addr.SubnetMask
+		network.Gateway = addr.Gateway
+		if addr.AddressOrigin != "" {
+			network.IPSrc = addr.AddressOrigin + " Address" // 与ipmitool的输出保持一致，如'DHCP Address'。
+		}
+	}
+	return &network, nil
+}
+
+// SetDHCP 设置IP来源是DHCP
+func (w *worker) SetDHCP() error {
+	eth, err := w.ethernetInterface()
+	if err != nil {
+		return err
+	}
+	return w.patch(eth.path, map[string]interface{}{
+		"DHCPv4": map[string]bool{"DHCPEnabled": true},
+	})
+}
+
+// SetStaticIP 设置IP来源是静态IP
+func (w *worker) SetStaticIP(ip, netmask, gateway string) error {
+	eth, err := w.ethernetInterface()
+	if err != nil {
+		return err
+	}
+	return w.patch(eth.path, map[string]interface{}{
+		"DHCPv4": map[string]bool{"DHCPEnabled": false},
+		"IPv4StaticAddresses": []map[string]string{
+			{"Address": ip, "SubnetMask": netmask, "Gateway": gateway},
+		},
+	})
+}
@@ -0,0 +1,529 @@
+// Package redfish 基于BMC Redfish接口的OOB处理器
+package redfish
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	rf "github.com/licairong/cloudboot-provider-framework/util/redfish"
+)
+
+var _ oob.Worker = (*worker)(nil)
+
+const (
+	// name 处理器名称
+	name = oob.RedfishWorker
+)
+
+func init() {
+	oob.Register(name, NewWorker())
+	oob.RegisterFactory(name, NewWorker)
+	oob.RegisterProbe(name, probe)
+}
+
+// probe 探测带外主机的Redfish服务是否可用
+func probe(opts *oob.Options) error {
+	version, err := rf.Probe(opts.Hostname, opts.HTTPClient)
+	if err == nil && opts.Log != nil {
+		opts.Log.Debugf("redfish %s is available on %s", version, opts.Hostname)
+	}
+	return err
+}
+
+type worker struct {
+	opts   *oob.Options
+	log    util.Logger
+	client *rf.Client
+}
+
+// NewWorker 返回处理器实例。须通过oob.WithRemote指定BMC地址及用户名、密码。
+func NewWorker(setters ...func(*oob.Options)) oob.Worker {
+	var opts oob.Options
+	for i := range setters {
+		setters[i](&opts)
+	}
+	client := rf.NewClient(opts.Hostname, opts.Username, opts.Password)
+	client.HTTPClient = opts.HTTPClient
+	return &worker{
+		opts:   &opts,
+		log:    opts.Log,
+		client: client,
+	}
+}
+
+// Name 返回处理器实现的名称
+func (w *worker) Name() string {
+	return name
+}
+
+// wrap 将传输层错误转换为IPUnreachableError，将认证失败转换为UsernamePasswordError。
+func (w *worker) wrap(err error) error {
+	if err == nil {
+		return nil
+	}
+	if rf.IsStatus(err, http.StatusUnauthorized) || rf.IsStatus(err, http.StatusForbidden) {
+		return oob.NewUsernamePasswordError(err)
+	}
+	var netErr net.Error
+	var urlErr *url.Error
+	if errors.As(err, &netErr) || errors.As(err, &urlErr) {
+		return oob.NewIPUnreachableError(w.opts.Hostname, err)
+	}
+	return err
+}
+
+func (w *worker) get(path string, v interface{}) error {
+	return w.wrap(w.client.Get(path, v))
+}
+
+func (w *worker) post(path string, body interface{}) error {
+	return w.wrap(w.client.Post(path, body, nil))
+}
+
+func (w *worker) patch(path string, body interface{}) error {
+	return w.wrap(w.client.Patch(path, body))
+}
+
+// first 返回资源集合中的首个成员路径
+func (w *worker) first(collection string) (string, error) {
+	members, err := w.client.Members(collection)
+	if err != nil {
+		return "", w.wrap(err)
+	}
+	if len(members) == 0 {
+		return "", errors.New("redfish: no members in " + collection)
+	}
+	return members[0], nil
+}
+
+// action 资源操作
+type action struct {
+	Target string `json:"target"`
+}
+
+// system 计算机系统
+type system struct {
+	path         string
+	Manufacturer string            `json:"Manufacturer"`
+	Model        string            `json:"Model"`
+	SerialNumber string            `json:"SerialNumber"`
+	PowerState   string            `json:"PowerState"`
+	LogServices  rf.ODataID        `json:"LogServices"`
+	Actions      map[string]action `json:"Actions"`
+}
+
+// system 返回首个计算机系统
+func (w *worker) system() (*system, error) {
+	path, err := w.first(rf.ServiceRoot + "/Systems")
+	if err != nil {
+		return nil, err
+	}
+	sys := system{path: path}
+	if err = w.get(path, &sys); err != nil {
+		return nil, err
+	}
+	return &sys, nil
+}
+
+// manager 管理控制器（BMC）
+type manager struct {
+	path               string
+	Manufacturer       string            `json:"Manufacturer"`
+	FirmwareVersion    string            `json:"FirmwareVersion"`
+	EthernetInterfaces rf.ODataID        `json:"EthernetInterfaces"`
+	LogServices        rf.ODataID        `json:"LogServices"`
+	Actions            map[string]action `json:"Actions"`
+}
+
+// manager 返回首个管理控制器
+func (w *worker) manager() (*manager, error) {
+	path, err := w.first(rf.ServiceRoot + "/Managers")
+	if err != nil {
+		return nil, err
+	}
+	mgr := manager{path: path}
+	if err = w.get(path, &mgr); err != nil {
+		return nil, err
+	}
+	return &mgr, nil
+}
+
+// target 返回操作的目标路径，资源未声明该操作时按规范拼接。
+func target(path string, actions map[string]action, name string) string {
+	if a, ok := actions["#"+name]; ok && a.Target != "" {
+		return a.Target
+	}
+	return path + "/Actions/" + name
+}
+
+// FRUDevice 返回物理机基本信息
+func (w *worker) FRUDevice() (*oob.FRUDevice, error) {
+	sys, err := w.system()
+	if err != nil {
+		return nil, err
+	}
+	return &oob.FRUDevice{
+		ProductManufacturer: sys.Manufacturer,
+		ProductName:         sys.Model,
+		ProductSerial:       sys.SerialNumber,
+	}, nil
+}
+
+// ValidateSN 校验预期的SN与实际的SN是否匹配
+func (w *worker) ValidateSN(sn string) error {
+	fd, err := w.FRUDevice()
+	if err != nil {
+		return err
+	}
+	if fd.ProductSerial != sn {
+		return oob.ErrOOBIPAndSNUnmatched
+	}
+	return nil
+}
+
+// PowerStatus 返回电源状态
+func (w *worker) PowerStatus() (status string, err error) {
+	sys, err := w.system()
+	if err != nil {
+		return "", err
+	}
+	if sys.PowerState == "On" {
+		return oob.PowerOn, nil
+	}
+	return oob.PowerOff, nil
+}
+
+// reset 以指定的ResetType执行ComputerSystem.Reset操作
+func (w *worker) reset(resetType string) error {
+	sys, err := w.system()
+	if err != nil {
+		return err
+	}
+	return w.post(target(sys.path, sys.Actions, "ComputerSystem.Reset"), map[string]string{"ResetType": resetType})
+}
+
+// PowerOn 设备上电开机
+func (w *worker) PowerOn() error {
+	return w.reset("On")
+}
+
+// PowerOff 设备下电关机
+func (w *worker) PowerOff() error {
+	return w.reset("ForceOff")
+}
+
+// PowerReset 设备重启
+func (w *worker) PowerReset() error {
+	return w.reset("ForceRestart")
+}
+
+// SetBootOverride 设置引导覆盖。target为引导设备，如'Pxe'、'Hdd'；persistent为false时仅下次引导生效。
+func (w *worker) SetBootOverride(target string, uefi, persistent bool) error {
+	sys, err := w.system()
+	if err != nil {
+		return err
+	}
+	boot := map[string]string{
+		"BootSourceOverrideTarget":  target,
+		"BootSourceOverrideEnabled": "Once",
+		"BootSourceOverrideMode":    "Legacy",
+	}
+	if persistent {
+		boot["BootSourceOverrideEnabled"] = "Continuous"
+	}
+	if uefi {
+		boot["BootSourceOverrideMode"] = "UEFI"
+	}
+	return w.patch(sys.path, map[string]interface{}{"Boot": boot})
+}
+
+// PXEBoot 设备重启并指定其（仅下次）从网络引导
+func (w *worker) PXEBoot(uefi bool, manufacturer string) error {
+	if err := w.SetBootOverride("Pxe", uefi, false); err != nil {
+		return err
+	}
+	status, err := w.PowerStatus()
+	if err != nil {
+		return err
+	}
+	if status == oob.PowerOn {
+		return w.PowerReset()
+	}
+	return w.PowerOn()
+}
+
+// Channel Redfish不区分IPMI channel
+func (w *worker) Channel() (int, error) {
+	return 0, oob.ErrChannelNotFound
+}
+
+// Raw Redfish不支持发送原始的ipmi请求
+func (w *worker) Raw(args string) (response []byte, err error) {
+	return nil, oob.ErrNotSupported
+}
+
+// logService 日志服务
+type logService struct {
+	path    string
+	ID      string            `json:"Id"`
+	Entries rf.ODataID        `json:"Entries"`
+	Actions map[string]action `json:"Actions"`
+}
+
+// selService 返回SEL日志服务。依次查找BMC及计算机系统下Id包含'sel'的日志服务。
+func (w *worker) selService() (*logService, error) {
+	var collections []string
+	if mgr, err := w.manager(); err == nil && mgr.LogServices.ID != "" {
+		collections = append(collections, mgr.LogServices.ID)
+	}
+	if sys, err := w.system(); err == nil && sys.LogServices.ID != "" {
+		collections = append(collections, sys.LogServices.ID)
+	}
+	for _, coll := range collections {
+		members, err := w.client.Members(coll)
+		if err != nil {
+			continue
+		}
+		for _, path := range members {
+			svc := logService{path: path}
+			if err = w.get(path, &svc); err == nil && strings.Contains(strings.ToLower(svc.ID), "sel") {
+				return &svc, nil
+			}
+		}
+	}
+	return nil, oob.ErrNotSupported
+}
+
+// SelClear 清除SEL日志
+func (w *worker) SelClear() error {
+	svc, err := w.selService()
+	if err != nil {
+		return err
+	}
+	return w.post(target(svc.path, svc.Actions, "LogService.ClearLog"), map[string]string{})
+}
+
+// logEntry 日志条目
+type logEntry struct {
+	OData    string `json:"@odata.id"`
+	ID       string `json:"Id"`
+	Created  string `json:"Created"`
+	Severity string `json:"Severity"`
+	Message  string `json:"Message"`
+}
+
+// logEntries 日志条目集合
+type logEntries struct {
+	Members  []*logEntry `json:"Members"`
+	NextLink string      `json:"Members@odata.nextLink"`
+}
+
+// EventLogs 返回SEL日志服务中序号大于since的事件日志
+func (w *worker) EventLogs(since int) ([]*oob.EventLog, error) {
+	svc, err := w.selService()
+	if err != nil {
+		return nil, err
+	}
+	path := svc.Entries.ID
+	if path == "" {
+		path = svc.path + "/Entries"
+	}
+
+	items := make([]*oob.EventLog, 0)
+	for path != "" {
+		var coll logEntries
+		if err = w.get(path, &coll); err != nil {
+			return nil, err
+		}
+		for _, entry := range coll.Members {
+			if entry.Created == "" && entry.Message == "" && entry.OData != "" {
+				// 未展开的成员
+				if err = w.get(entry.OData, entry); err != nil {
+					return nil, err
+				}
+			}
+			if seq, err := strconv.Atoi(entry.ID); err != nil || seq <= since {
+				continue
+			}
+			items = append(items, entry.toEventLog())
+		}
+		path = coll.NextLink
+	}
+	sort.Sort(oob.BySeqASC(items))
+	return items, nil
+}
+
+// toEventLog 转换为oob事件日志
+func (e *logEntry) toEventLog() *oob.EventLog {
+	severity := oob.UnknownSeverity
+	switch e.Severity {
+	case "OK":
+		severity = oob.InformationSeverity
+	case "Warning":
+		severity = oob.WarningSeverity
+	case "Critical":
+		severity = oob.CriticalSeverity
+	}
+	created, _ := time.Parse(time.RFC3339, e.Created)
+	return &oob.EventLog{
+		SeqNumber: e.ID,
+		Timestamp: created,
+		Severity:  severity,
+		Message:   e.Message,
+	}
+}
+
+// reading 传感器读数
+type reading struct {
+	Name                      string   `json:"Name"`
+	FanName                   string   `json:"FanName"`
+	ReadingCelsius            *float64 `json:"ReadingCelsius"`
+	ReadingVolts              *float64 `json:"ReadingVolts"`
+	Reading                   *float64 `json:"Reading"`
+	ReadingUnits              string   `json:"ReadingUnits"`
+	LowerThresholdNonCritical *float64 `json:"LowerThresholdNonCritical"`
+	LowerThresholdCritical    *float64 `json:"LowerThresholdCritical"`
+	LowerThresholdFatal       *float64 `json:"LowerThresholdFatal"`
+	UpperThresholdNonCritical *float64 `json:"UpperThresholdNonCritical"`
+	UpperThresholdCritical    *float64 `json:"UpperThresholdCritical"`
+	UpperThresholdFatal       *float64 `json:"UpperThresholdFatal"`
+	Status                    struct {
+		State  string `json:"State"`
+		Health string `json:"Health"`
+	} `json:"Status"`
+}
+
+// toSensor 转换为oob传感器信息，缺失的数值置空，与ipmi处理器一致。
+func (r *reading) toSensor(value *float64, units string) *oob.SensorDevice {
+	name := r.Name
+	if name == "" {
+		name = r.FanName
+	}
+	state := "ns"
+	if r.Status.State == "Enabled" {
+		state = "ok"
+		switch r.Status.Health {
+		case "Warning":
+			state = "nc"
+		case "Critical":
+			state = "cr"
+		}
+	}
+	return &oob.SensorDevice{
+		Name:     name,
+		Value:    format(value),
+		Units:    units,
+		State:    state,
+		Lonorec:  format(r.LowerThresholdFatal),
+		Locrit:   format(r.LowerThresholdCritical),
+		Lonocrit: format(r.LowerThresholdNonCritical),
+		Upcrit:   format(r.UpperThresholdCritical),
+		Upnocrit: format(r.UpperThresholdNonCritical),
+		Upnorec:  format(r.UpperThresholdFatal),
+	}
+}
+
+func format(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
+
+// SensorList 返回机箱温度、风扇及电压传感器信息。
+func (w *worker) SensorList() ([]*oob.SensorDevice, error) {
+	path, err := w.first(rf.ServiceRoot + "/Chassis")
+	if err != nil {
+		return nil, err
+	}
+	var ch struct {
+		Thermal rf.ODataID `json:"Thermal"`
+		Power   rf.ODataID `json:"Power"`
+	}
+	if err = w.get(path, &ch); err != nil {
+		return nil, err
+	}
+
+	var items []*oob.SensorDevice
+	if ch.Thermal.ID != "" {
+		var th struct {
+			Temperatures []reading `json:"Temperatures"`
+			Fans         []reading `json:"Fans"`
+		}
+		if err = w.get(ch.Thermal.ID, &th); err != nil {
+			return nil, err
+		}
+		for i := range th.Temperatures {
+			items = append(items, th.Temperatures[i].toSensor(th.Temperatures[i].ReadingCelsius, "degrees C"))
+		}
+		for i := range th.Fans {
+			units := th.Fans[i].ReadingUnits
+			if units == "" || units == "RPM" {
+				units = "RPM"
+			} else if units == "Percent" {
+				units = "percent"
+			}
+			items = append(items, th.Fans[i].toSensor(th.Fans[i].Reading, units))
+		}
+	}
+	if ch.Power.ID != "" {
+		var pw struct {
+			Voltages []reading `json:"Voltages"`
+		}
+		if err = w.get(ch.Power.ID, &pw); err != nil {
+			return nil, err
+		}
+		for i := range pw.Voltages {
+			items = append(items, pw.Voltages[i].toSensor(pw.Voltages[i].ReadingVolts, "Volts"))
+		}
+	}
+	return items, nil
+}
+
+// SetSnmpTrap Redfish事件订阅与SNMP Trap模型不同，暂不支持。
+func (w *worker) SetSnmpTrap(*oob.SnmpSet) error {
+	return oob.ErrNotSupported
+}
+
+// BMC 返回OOB的BMC信息
+func (w *worker) BMC() (*oob.BMC, error) {
+	mgr, err := w.manager()
+	if err != nil {
+		return nil, err
+	}
+	return &oob.BMC{
+		FirmwareReversion: mgr.FirmwareVersion,
+		ManufacturerName:  mgr.Manufacturer,
+	}, nil
+}
+
+// BMCColdReset 重启BMC
+func (w *worker) BMCColdReset() error {
+	mgr, err := w.manager()
+	if err != nil {
+		return err
+	}
+	return w.post(target(mgr.path, mgr.Actions, "Manager.Reset"), map[string]string{"ResetType": "ForceRestart"})
+}
+
+// PostCheck OOB配置实施后置检查
+func (w *worker) PostCheck(sett *oob.Setting) (items []*util.CheckingItem) {
+	if sett == nil {
+		return nil
+	}
+	if sett.Network != nil {
+		items = append(items, w.checkNetwork(sett.Network)...)
+	}
+	if sett.User != nil {
+		items = append(items, w.checkUser(sett.User)...)
+	}
+	return items
+}
@@ -0,0 +1,409 @@
+package redfish
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const sessionsPath = "/redfish/v1/SessionService/Sessions"
+
+// resources 模拟BMC的初始资源
+const resources = `{
+  "/redfish/v1": {"RedfishVersion": "1.6.0", "Systems": {"@odata.id": "/redfish/v1/Systems"}},
+  "/redfish/v1/Systems": {"Members": [{"@odata.id": "/redfish/v1/Systems/1"}]},
+  "/redfish/v1/Systems/1": {
+    "Manufacturer": "Contoso", "Model": "3500", "SerialNumber": "437XR1138R2", "PowerState": "Off",
+    "LogServices": {"@odata.id": "/redfish/v1/Systems/1/LogServices"},
+    "Actions": {"#ComputerSystem.Reset": {"target": "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset"}}
+  },
+  "/redfish/v1/Systems/1/LogServices": {"Members": [{"@odata.id": "/redfish/v1/Systems/1/LogServices/Log1"}]},
+  "/redfish/v1/Systems/1/LogServices/Log1": {"Id": "Log1"},
+  "/redfish/v1/Managers": {"Members": [{"@odata.id": "/redfish/v1/Managers/BMC"}]},
+  "/redfish/v1/Managers/BMC": {
+    "Manufacturer": "Contoso", "FirmwareVersion": "1.45.455b66-rev4",
+    "EthernetInterfaces": {"@odata.id": "/redfish/v1/Managers/BMC/EthernetInterfaces"},
+    "LogServices": {"@odata.id": "/redfish/v1/Managers/BMC/LogServices"}
+  },
+  "/redfish/v1/Managers/BMC/EthernetInterfaces": {"Members": [{"@odata.id": "/redfish/v1/Managers/BMC/EthernetInterfaces/1"}]},
+  "/redfish/v1/Managers/BMC/EthernetInterfaces/1": {
+    "MACAddress": "12:44:6A:3B:04:11",
+    "IPv4Addresses": [{"Address": "192.168.0.10", "SubnetMask": "255.255.252.0", "Gateway": "192.168.0.1", "AddressOrigin": "DHCP"}]
+  },
+  "/redfish/v1/Managers/BMC/LogServices": {"Members": [{"@odata.id": "/redfish/v1/Managers/BMC/LogServices/SEL"}]},
+  "/redfish/v1/Managers/BMC/LogServices/SEL": {
+    "Id": "SEL",
+    "Entries": {"@odata.id": "/redfish/v1/Managers/BMC/LogServices/SEL/Entries"},
+    "Actions": {"#LogService.ClearLog": {"target": "/redfish/v1/Managers/BMC/LogServices/SEL/Actions/LogService.ClearLog"}}
+  },
+  "/redfish/v1/Managers/BMC/LogServices/SEL/Entries": {
+    "Members": [
+      {"@odata.id": "/redfish/v1/Managers/BMC/LogServices/SEL/Entries/2", "Id": "2", "Created": "2021-05-20T10:22:34+08:00", "Severity": "Critical", "Message": "CPU 1 has an internal error (IERR)."},
+      {"@odata.id": "/redfish/v1/Managers/BMC/LogServices/SEL/Entries/1", "Id": "1", "Created": "2021-05-20T10:22:33+08:00", "Severity": "OK", "Message": "The chassis is closed."}
+    ],
+    "Members@odata.nextLink": "/redfish/v1/Managers/BMC/LogServices/SEL/Entries?$skip=2"
+  },
+  "/redfish/v1/Managers/BMC/LogServices/SEL/Entries?$skip=2": {
+    "Members": [{"@odata.id": "/redfish/v1/Managers/BMC/LogServices/SEL/Entries/3"}]
+  },
+  "/redfish/v1/Managers/BMC/LogServices/SEL/Entries/3": {"Id": "3", "Created": "2021-05-20T10:25:00+08:00", "Severity": "Warning", "Message": "The system inlet temperature is greater than the upper warning threshold."},
+  "/redfish/v1/Chassis": {"Members": [{"@odata.id": "/redfish/v1/Chassis/1U"}]},
+  "/redfish/v1/Chassis/1U": {
+    "Thermal": {"@odata.id": "/redfish/v1/Chassis/1U/Thermal"},
+    "Power": {"@odata.id": "/redfish/v1/Chassis/1U/Power"}
+  },
+  "/redfish/v1/Chassis/1U/Thermal": {
+    "Temperatures": [{"Name": "CPU1 Temp", "ReadingCelsius": 41, "UpperThresholdNonCritical": 85, "UpperThresholdCritical": 90, "Status": {"State": "Enabled", "Health": "OK"}}],
+    "Fans": [{"FanName": "BaseBoard System Fan", "Reading": 2100, "ReadingUnits": "RPM", "LowerThresholdCritical": 600, "Status": {"State": "Enabled", "Health": "Warning"}}]
+  },
+  "/redfish/v1/Chassis/1U/Power": {
+    "Voltages": [{"Name": "VRM1 Voltage", "ReadingVolts": 12.1, "Status": {"State": "Absent"}}]
+  },
+  "/redfish/v1/AccountService": {"Accounts": {"@odata.id": "/redfish/v1/AccountService/Accounts"}},
+  "/redfish/v1/AccountService/Accounts": {"Members": [
+    {"@odata.id": "/redfish/v1/AccountService/Accounts/1"},
+    {"@odata.id": "/redfish/v1/AccountService/Accounts/2"},
+    {"@odata.id": "/redfish/v1/AccountService/Accounts/3"}
+  ]},
+  "/redfish/v1/AccountService/Accounts/1": {"Id": "1", "UserName": "", "RoleId": "ReadOnly", "Enabled": false},
+  "/redfish/v1/AccountService/Accounts/2": {"Id": "2", "UserName": "root", "RoleId": "Administrator", "Enabled": true},
+  "/redfish/v1/AccountService/Accounts/3": {"Id": "3", "UserName": "", "RoleId": "ReadOnly", "Enabled": false}
+}`
+
+// request 模拟BMC收到的资源操作请求
+type request struct {
+	Path string
+	Body map[string]interface{}
+}
+
+// mockBMC 在内存中维护资源并支持GET、PATCH及POST请求的模拟BMC
+type mockBMC struct {
+	*httptest.Server
+	mux       sync.Mutex
+	seq       int
+	tokens    map[string]bool
+	resources map[string]map[string]interface{}
+	actions   []request
+}
+
+func newMockBMC() *mockBMC {
+	bmc := &mockBMC{tokens: make(map[string]bool)}
+	if err := json.Unmarshal([]byte(resources), &bmc.resources); err != nil {
+		panic(err)
+	}
+	bmc.Server = httptest.NewTLSServer(http.HandlerFunc(bmc.serve))
+	return bmc
+}
+
+func (bmc *mockBMC) serve(w http.ResponseWriter, r *http.Request) {
+	bmc.mux.Lock()
+	defer bmc.mux.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	if r.URL.RawQuery != "" {
+		path += "?" + r.URL.RawQuery
+	}
+	var body map[string]interface{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+
+	switch {
+	case r.Method == http.MethodPost && path == sessionsPath:
+		if body["UserName"] != "root" || body["Password"] != "calvin" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		bmc.seq++
+		token := fmt.Sprintf("token-%d", bmc.seq)
+		bmc.tokens[token] = true
+		w.Header().Set("X-Auth-Token", token)
+		w.Header().Set("Location", fmt.Sprintf("%s/%d", sessionsPath, bmc.seq))
+		w.WriteHeader(http.StatusCreated)
+		return
+	case path != "/redfish/v1" && !bmc.tokens[r.Header.Get("X-Auth-Token")]:
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case r.Method == http.MethodPost && strings.Contains(path, "/Actions/"):
+		bmc.actions = append(bmc.actions, request{Path: path, Body: body})
+		if strings.HasSuffix(path, "ComputerSystem.Reset") {
+			sys := bmc.resources[strings.Split(path, "/Actions/")[0]]
+			sys["PowerState"] = "On"
+			if body["ResetType"] == "ForceOff" {
+				sys["PowerState"] = "Off"
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	res, ok := bmc.resources[path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodPatch:
+		for k, v := range body {
+			res[k] = v
+		}
+	case http.MethodPost:
+		member := fmt.Sprintf("%s/%d", path, len(res["Members"].([]interface{}))+1)
+		res["Members"] = append(res["Members"].([]interface{}), map[string]interface{}{"@odata.id": member})
+		bmc.resources[member] = body
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// resource 返回资源的当前属性
+func (bmc *mockBMC) resource(path string) map[string]interface{} {
+	bmc.mux.Lock()
+	defer bmc.mux.Unlock()
+	return bmc.resources[path]
+}
+
+// lastAction 返回最近一次资源操作请求
+func (bmc *mockBMC) lastAction() request {
+	bmc.mux.Lock()
+	defer bmc.mux.Unlock()
+	if len(bmc.actions) == 0 {
+		return request{}
+	}
+	return bmc.actions[len(bmc.actions)-1]
+}
+
+func TestWorker(t *testing.T) {
+	Convey("Redfish OOB处理器", t, func() {
+		bmc := newMockBMC()
+		defer bmc.Close()
+
+		w := NewWorker(oob.WithRemote("", bmc.URL, "root", "calvin"), oob.WithHTTPClient(bmc.Client()))
+		So(w.Name(), ShouldEqual, oob.RedfishWorker)
+
+		Convey("FRU及SN校验", func() {
+			fd, err := w.FRUDevice()
+			So(err, ShouldBeNil)
+			So(fd, ShouldResemble, &oob.FRUDevice{
+				ProductManufacturer: "Contoso",
+				ProductName:         "3500",
+				ProductSerial:       "437XR1138R2",
+			})
+			So(w.ValidateSN("437XR1138R2"), ShouldBeNil)
+			So(w.ValidateSN("XXX"), ShouldEqual, oob.ErrOOBIPAndSNUnmatched)
+		})
+
+		Convey("用户名密码错误", func() {
+			w := NewWorker(oob.WithRemote("", bmc.URL, "root", "wrong"), oob.WithHTTPClient(bmc.Client()))
+			_, err := w.FRUDevice()
+			So(oob.IsUsernamePasswordError(err), ShouldBeTrue)
+		})
+
+		Convey("带外IP不可达", func() {
+			bmc := newMockBMC()
+			bmc.Close()
+			w := NewWorker(oob.WithRemote("", bmc.URL, "root", "calvin"), oob.WithHTTPClient(bmc.Client()))
+			_, err := w.FRUDevice()
+			So(oob.IsIPUnreachableError(err), ShouldBeTrue)
+		})
+
+		Convey("电源", func() {
+			status, err := w.PowerStatus()
+			So(err, ShouldBeNil)
+			So(status, ShouldEqual, oob.PowerOff)
+
+			So(w.PowerOn(), ShouldBeNil)
+			So(bmc.lastAction().Body["ResetType"], ShouldEqual, "On")
+			status, _ = w.PowerStatus()
+			So(status, ShouldEqual, oob.PowerOn)
+
+			So(w.PowerReset(), ShouldBeNil)
+			So(bmc.lastAction().Body["ResetType"], ShouldEqual, "ForceRestart")
+
+			So(w.PowerOff(), ShouldBeNil)
+			So(bmc.lastAction(), ShouldResemble, request{
+				Path: "/redfish/v1/Systems/1/Actions/ComputerSystem.Reset",
+				Body: map[string]interface{}{"ResetType": "ForceOff"},
+			})
+		})
+
+		Convey("PXE引导", func() {
+			So(w.PXEBoot(true, ""), ShouldBeNil)
+			So(bmc.resource("/redfish/v1/Systems/1")["Boot"], ShouldResemble, map[string]interface{}{
+				"BootSourceOverrideTarget":  "Pxe",
+				"BootSourceOverrideEnabled": "Once",
+				"BootSourceOverrideMode":    "UEFI",
+			})
+			So(bmc.lastAction().Body["ResetType"], ShouldEqual, "On")
+
+			So(w.PXEBoot(false, ""), ShouldBeNil)
+			So(bmc.resource("/redfish/v1/Systems/1")["Boot"].(map[string]interface{})["BootSourceOverrideMode"], ShouldEqual, "Legacy")
+			So(bmc.lastAction().Body["ResetType"], ShouldEqual, "ForceRestart")
+		})
+
+		Convey("网络", func() {
+			network, err := w.Network()
+			So(err, ShouldBeNil)
+			So(network, ShouldResemble, &oob.Network{
+				IPSrc:   "DHCP Address",
+				MAC:     "12:44:6A:3B:04:11",
+				IP:      "192.168.0.10",
+				Netmask: "255.255.252.0",
+				Gateway: "192.168.0.1",
+			})
+
+			So(w.SetStaticIP("10.0.0.10", "255.255.255.0", "10.0.0.1"), ShouldBeNil)
+			eth := bmc.resource("/redfish/v1/Managers/BMC/EthernetInterfaces/1")
+			So(eth["DHCPv4"], ShouldResemble, map[string]interface{}{"DHCPEnabled": false})
+			So(eth["IPv4StaticAddresses"], ShouldResemble, []interface{}{
+				map[string]interface{}{"Address": "10.0.0.10", "SubnetMask": "255.255.255.0", "Gateway": "10.0.0.1"},
+			})
+
+			So(w.SetDHCP(), ShouldBeNil)
+			So(bmc.resource("/redfish/v1/Managers/BMC/EthernetInterfaces/1")["DHCPv4"], ShouldResemble, map[string]interface{}{"DHCPEnabled": true})
+		})
+
+		Convey("用户", func() {
+			users, err := w.Users()
+			So(err, ShouldBeNil)
+			So(len(users), ShouldEqual, 1)
+			So(users[0].Name, ShouldEqual, "root")
+			So(users[0].Access.PrivilegeLevel, ShouldEqual, oob.AdministratorLevel)
+
+			So(w.GenerateUser(&oob.UserSettingItem{
+				Username:       "admin",
+				Password:       "admin123",
+				PrivilegeLevel: oob.OperatorLevel,
+			}), ShouldBeNil)
+			So(bmc.resource("/redfish/v1/AccountService/Accounts/3"), ShouldResemble, map[string]interface{}{
+				"Id":       "3",
+				"UserName": "admin",
+				"Password": "admin123",
+				"RoleId":   "Operator",
+				"Enabled":  true,
+			})
+
+			So(w.GenerateUser(&oob.UserSettingItem{Username: "guest", Password: "guest123", PrivilegeLevel: oob.UserLevel}), ShouldBeNil)
+			So(bmc.resource("/redfish/v1/AccountService/Accounts/4")["RoleId"], ShouldEqual, "ReadOnly")
+
+			So(w.DisableUser("admin"), ShouldBeNil)
+			So(bmc.resource("/redfish/v1/AccountService/Accounts/3")["Enabled"], ShouldBeFalse)
+			So(w.EnableUser("admin"), ShouldBeNil)
+			So(bmc.resource("/redfish/v1/AccountService/Accounts/3")["Enabled"], ShouldBeTrue)
+			So(w.ChangeUserPassword("admin", "secret"), ShouldBeNil)
+			So(bmc.resource("/redfish/v1/AccountService/Accounts/3")["Password"], ShouldEqual, "secret")
+			So(oob.IsUserNotFoundError(w.EnableUser("nobody")), ShouldBeTrue)
+
+			items := w.PostCheck(&oob.Setting{User: &oob.UserSetting{
+				{Username: "admin", PrivilegeLevel: oob.OperatorLevel},
+				{Username: "nobody", PrivilegeLevel: oob.AdministratorLevel},
+			}})
+			So(len(items), ShouldEqual, 2)
+			So(items[0].Actual, ShouldEqual, "admin@OperatorLevel")
+			So(items[1].Actual, ShouldEqual, "Missing")
+		})
+
+		Convey("BMC", func() {
+			info, err := w.BMC()
+			So(err, ShouldBeNil)
+			So(info.FirmwareReversion, ShouldEqual, "1.45.455b66-rev4")
+			So(info.ManufacturerName, ShouldEqual, "Contoso")
+
+			So(w.BMCColdReset(), ShouldBeNil)
+			So(bmc.lastAction(), ShouldResemble, request{
+				Path: "/redfish/v1/Managers/BMC/Actions/Manager.Reset",
+				Body: map[string]interface{}{"ResetType": "ForceRestart"},
+			})
+		})
+
+		Convey("SEL日志清理", func() {
+			So(w.SelClear(), ShouldBeNil)
+			So(bmc.lastAction().Path, ShouldEqual, "/redfish/v1/Managers/BMC/LogServices/SEL/Actions/LogService.ClearLog")
+		})
+
+		Convey("事件日志", func() {
+			items, err := w.EventLogs(0)
+			So(err, ShouldBeNil)
+			So(len(items), ShouldEqual, 3)
+			So(items[0], ShouldResemble, &oob.EventLog{
+				SeqNumber: "1",
+				Timestamp: items[0].Timestamp,
+				Severity:  oob.InformationSeverity,
+				Message:   "The chassis is closed.",
+			})
+			So(items[0].Timestamp.Unix(), ShouldEqual, 1621477353)
+			So(items[1].Severity, ShouldEqual, oob.CriticalSeverity)
+			So(items[2].Severity, ShouldEqual, oob.WarningSeverity)
+
+			items, err = w.EventLogs(2)
+			So(err, ShouldBeNil)
+			So(len(items), ShouldEqual, 1)
+			So(items[0].SeqNumber, ShouldEqual, "3")
+		})
+
+		Convey("传感器", func() {
+			items, err := w.SensorList()
+			So(err, ShouldBeNil)
+			So(len(items), ShouldEqual, 3)
+			So(items[0], ShouldResemble, &oob.SensorDevice{
+				Name:     "CPU1 Temp",
+				Value:    "41",
+				Units:    "degrees C",
+				State:    "ok",
+				Upcrit:   "90",
+				Upnocrit: "85",
+			})
+			So(items[1].Name, ShouldEqual, "BaseBoard System Fan")
+			So(items[1].State, ShouldEqual, "nc")
+			So(items[1].Locrit, ShouldEqual, "600")
+			So(items[2].Value, ShouldEqual, "12.1")
+			So(items[2].State, ShouldEqual, "ns")
+		})
+
+		Convey("不支持的操作", func() {
+			_, err := w.Raw("0x06 0x01")
+			So(err, ShouldNotBeNil)
+			So(w.SetSnmpTrap(&oob.SnmpSet{}), ShouldNotBeNil)
+			_, err = w.Channel()
+			So(err, ShouldEqual, oob.ErrChannelNotFound)
+		})
+	})
+}
+
+func TestWhoami(t *testing.T) {
+	Convey("探测BMC的Redfish服务", t, func() {
+		Convey("Redfish服务可用", func() {
+			srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"RedfishVersion": "1.6.0"}`))
+			}))
+			defer srv.Close()
+
+			selected, err := oob.Whoami(oob.WithRemote("", srv.URL, "root", "calvin"), oob.WithHTTPClient(srv.Client()))
+			So(err, ShouldBeNil)
+			So(selected, ShouldEqual, oob.RedfishWorker)
+
+			w := oob.SelectWorker(selected, oob.WithRemote("", srv.URL, "root", "calvin"), oob.WithHTTPClient(srv.Client()))
+			So(w, ShouldNotBeNil)
+			So(w.(*worker).opts.Hostname, ShouldEqual, srv.URL)
+		})
+
+		Convey("Redfish服务不可用", func() {
+			srv := httptest.NewTLSServer(http.NotFoundHandler())
+			defer srv.Close()
+
+			selected, err := oob.Whoami(oob.WithRemote("", srv.URL, "root", "calvin"), oob.WithHTTPClient(srv.Client()))
+			So(err, ShouldBeNil)
+			So(selected, ShouldEqual, oob.DefaultWorker)
+		})
+	})
+}
@@ -0,0 +1,176 @@
+package redfish
+
+import (
+	"strconv"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	rf "github.com/licairong/cloudboot-provider-framework/util/redfish"
+)
+
+// 带外用户角色
+const (
+	roleAdministrator = "Administrator"
+	roleOperator      = "Operator"
+	roleReadOnly      = "ReadOnly"
+)
+
+// account 带外用户帐号
+type account struct {
+	path     string
+	ID       string `json:"Id"`
+	UserName string `json:"UserName"`
+	RoleID   string `json:"RoleId"`
+	Enabled  bool   `json:"Enabled"`
+}
+
+// accountsPath 返回用户帐号集合的路径
+func (w *worker) accountsPath() (string, error) {
+	var svc struct {
+		Accounts rf.ODataID `json:"Accounts"`
+	}
+	if err := w.get(rf.ServiceRoot+"/AccountService", &svc); err != nil {
+		return "", err
+	}
+	if svc.Accounts.ID == "" {
+		return rf.ServiceRoot + "/AccountService/Accounts", nil
+	}
+	return svc.Accounts.ID, nil
+}
+
+// accounts 返回全部用户帐号（含用户名为空的空闲帐号）
+func (w *worker) accounts() (coll string, items []*account, err error) {
+	if coll, err = w.accountsPath(); err != nil {
+		return "", nil, err
+	}
+	members, err := w.client.Members(coll)
+	if err != nil {
+		return "", nil, w.wrap(err)
+	}
+	for _, path := range members {
+		acct := account{path: path}
+		if err = w.get(path, &acct); err != nil {
+			return "", nil, err
+		}
+		items = append(items, &acct)
+	}
+	return coll, items, nil
+}
+
+// findAccount 根据用户名查找用户帐号
+func (w *worker) findAccount(username string) (*account, error) {
+	_, items, err := w.accounts()
+	if err != nil {
+		return nil, err
+	}
+	for _, acct := range items {
+		if acct.UserName != "" && acct.UserName == username {
+			return acct, nil
+		}
+	}
+	return nil, oob.NewUserNotFoundError(username)
+}
+
+// privilegeLevel 返回角色对应的权限级别
+func privilegeLevel(role string) int {
+	switch role {
+	case roleAdministrator:
+		return oob.AdministratorLevel
+	case roleOperator:
+		return oob.OperatorLevel
+	case roleReadOnly:
+		return oob.UserLevel
+	}
+	return oob.NoAccessLevel
+}
+
+// roleID 返回权限级别对应的角色
+func roleID(level int) string {
+	switch {
+	case level >= oob.AdministratorLevel:
+		return roleAdministrator
+	case level == oob.OperatorLevel:
+		return roleOperator
+	}
+	return roleReadOnly
+}
+
+// Users 返回OOB用户列表
+func (w *worker) Users() ([]*oob.User, error) {
+	_, items, err := w.accounts()
+	if err != nil {
+		return nil, err
+	}
+	users := make([]*oob.User, 0, len(items))
+	for _, acct := range items {
+		if acct.UserName == "" {
+			continue
+		}
+		id, _ := strconv.Atoi(acct.ID)
+		users = append(users, &oob.User{
+			ID:   id,
+			Name: acct.UserName,
+			Access: &oob.UserAccess{
+				UserID:         id,
+				UserName:       acct.UserName,
+				PrivilegeLevel: privilegeLevel(acct.RoleID),
+			},
+		})
+	}
+	return users, nil
+}
+
+// GenerateUser 生成用户帐号。
+// 若用户已经存在则修改其密码、角色及状态；否则优先占用空闲帐号，无空闲帐号时新增帐号。
+func (w *worker) GenerateUser(sett *oob.UserSettingItem) error {
+	coll, items, err := w.accounts()
+	if err != nil {
+		return err
+	}
+	body := map[string]interface{}{
+		"UserName": sett.Username,
+		"Password": sett.Password,
+		"RoleId":   roleID(sett.PrivilegeLevel),
+		"Enabled":  sett.Status != oob.DisabledUser,
+	}
+
+	var free *account
+	for _, acct := range items {
+		if acct.UserName == sett.Username {
+			return w.patch(acct.path, body)
+		}
+		if acct.UserName == "" && free == nil && acct.ID != "1" { // 1号帐号通常为保留帐号
+			free = acct
+		}
+	}
+	if free != nil {
+		return w.patch(free.path, body)
+	}
+	return w.post(coll, body)
+}
+
+// ChangeUserPassword 修改目标带外用户密码
+func (w *worker) ChangeUserPassword(username, password string) error {
+	acct, err := w.findAccount(username)
+	if err != nil {
+		return err
+	}
+	return w.patch(acct.path, map[string]string{"Password": password})
+}
+
+// EnableUser 启用带外用户帐号
+func (w *worker) EnableUser(username string) error {
+	return w.setEnabled(username, true)
+}
+
+// DisableUser 禁用带外用户帐号
+func (w *worker) DisableUser(username string) error {
+	return w.setEnabled(username, false)
+}
+
+func (w *worker) setEnabled(username string, enabled bool) error {
+	acct, err := w.findAccount(username)
+	if err != nil {
+		return err
+	}
+	return w.patch(acct.path, map[string]bool{"Enabled": enabled})
+}
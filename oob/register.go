@@ -1,6 +1,7 @@
 package oob
 
 import (
+	"sort"
 	"strings"
 	"sync"
 )
@@ -9,6 +10,18 @@ import (
 var workerPool = make(map[string]Worker)
 var mux sync.Mutex
 
+// Factory 按选项创建处理器实例
+type Factory func(setters ...func(*Options)) Worker
+
+// factories 处理器注册的构造函数
+var factories = make(map[string]Factory)
+
+// Probe 探测带外主机是否支持处理器的管理接口，支持时返回nil。
+type Probe func(opts *Options) error
+
+// probes 处理器注册的管理接口探测函数
+var probes = make(map[string]Probe)
+
 // Register 注册oob及其处理worker实例
 func Register(name string, worker Worker) {
 	mux.Lock()
@@ -34,12 +47,30 @@ func Registered() (items []string) {
 	return items
 }
 
-// SelectWorker 根据oob名称获取相应的Worker
-func SelectWorker(name string) Worker {
+// RegisterFactory 注册处理器的构造函数，供SelectWorker按选项创建处理器实例。
+func RegisterFactory(name string, factory Factory) {
+	mux.Lock()
+	defer mux.Unlock()
+	if factory == nil {
+		panic("oob: RegisterFactory factory is nil")
+	}
+	name = strings.ToUpper(name)
+	if _, dup := factories[name]; dup {
+		panic("oob: RegisterFactory called twice for worker " + name)
+	}
+	factories[name] = factory
+}
+
+// SelectWorker 根据oob名称获取相应的Worker。
+// 指定了选项且处理器注册了构造函数时，返回按选项创建的新实例。
+func SelectWorker(name string, setters ...func(*Options)) Worker {
 	mux.Lock()
 	defer mux.Unlock()
 
 	name = strings.ToUpper(name)
+	if factory, ok := factories[name]; ok && len(setters) > 0 {
+		return factory(setters...)
+	}
 	for key := range workerPool {
 		if key == name {
 			return workerPool[key]
@@ -47,3 +78,31 @@ func SelectWorker(name string) Worker {
 	}
 	return nil
 }
+
+// RegisterProbe 注册处理器的管理接口探测函数，供Whoami选择处理器。
+func RegisterProbe(name string, probe Probe) {
+	mux.Lock()
+	defer mux.Unlock()
+	if probe == nil {
+		panic("oob: RegisterProbe probe is nil")
+	}
+	name = strings.ToUpper(name)
+	if _, dup := probes[name]; dup {
+		panic("oob: RegisterProbe called twice for worker " + name)
+	}
+	probes[name] = probe
+}
+
+// registeredProbes 返回按处理器名称排序的探测函数
+func registeredProbes() (names []string, items []Probe) {
+	mux.Lock()
+	defer mux.Unlock()
+	for name := range probes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		items = append(items, probes[name])
+	}
+	return names, items
+}
@@ -0,0 +1,327 @@
+package oob
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SensorType 传感器分类
+type SensorType string
+
+const (
+	// TemperatureSensor 传感器分类-温度
+	TemperatureSensor SensorType = "temperature"
+	// FanSensor 传感器分类-风扇
+	FanSensor SensorType = "fan"
+	// VoltageSensor 传感器分类-电压
+	VoltageSensor SensorType = "voltage"
+	// CurrentSensor 传感器分类-电流
+	CurrentSensor SensorType = "current"
+	// PowerSensor 传感器分类-功率
+	PowerSensor SensorType = "power"
+	// PSUSensor 传感器分类-电源模块
+	PSUSensor SensorType = "psu"
+	// DriveSensor 传感器分类-硬盘
+	DriveSensor SensorType = "drive"
+	// OtherSensor 传感器分类-其他
+	OtherSensor SensorType = "other"
+)
+
+// SensorUnit 传感器读数单位，取值与'ipmitool sensor list'一致。
+type SensorUnit string
+
+const (
+	// UnitCelsius 单位-摄氏度
+	UnitCelsius SensorUnit = "degrees C"
+	// UnitFahrenheit 单位-华氏度
+	UnitFahrenheit SensorUnit = "degrees F"
+	// UnitRPM 单位-转/分钟
+	UnitRPM SensorUnit = "RPM"
+	// UnitVolts 单位-伏特
+	UnitVolts SensorUnit = "Volts"
+	// UnitAmps 单位-安培
+	UnitAmps SensorUnit = "Amps"
+	// UnitWatts 单位-瓦特
+	UnitWatts SensorUnit = "Watts"
+	// UnitPercent 单位-百分比
+	UnitPercent SensorUnit = "percent"
+	// UnitDiscrete 离散传感器，无数值单位。
+	UnitDiscrete SensorUnit = "discrete"
+	// UnitUnknown 单位-未知
+	UnitUnknown SensorUnit = ""
+)
+
+// Health 健康状态
+type Health string
+
+const (
+	// HealthOK 健康状态-正常
+	HealthOK Health = "ok"
+	// HealthWarning 健康状态-警告（越过非严重阈值）
+	HealthWarning Health = "warning"
+	// HealthCritical 健康状态-严重（越过严重或不可恢复阈值）
+	HealthCritical Health = "critical"
+	// HealthUnknown 健康状态-未知（无读数或无可用阈值）
+	HealthUnknown Health = "unknown"
+)
+
+// healthRank 健康状态的严重程度
+var healthRank = map[Health]int{
+	HealthUnknown:  0,
+	HealthOK:       1,
+	HealthWarning:  2,
+	HealthCritical: 3,
+}
+
+// Thresholds 传感器阈值，不可读的阈值为nil。
+type Thresholds struct {
+	LowerNonRecoverable *float64 `json:"lower_non_recoverable,omitempty"`
+	LowerCritical       *float64 `json:"lower_critical,omitempty"`
+	LowerNonCritical    *float64 `json:"lower_non_critical,omitempty"`
+	UpperNonCritical    *float64 `json:"upper_non_critical,omitempty"`
+	UpperCritical       *float64 `json:"upper_critical,omitempty"`
+	UpperNonRecoverable *float64 `json:"upper_non_recoverable,omitempty"`
+}
+
+// Sensor 结构化的传感器信息
+type Sensor struct {
+	Name       string     `json:"name"`
+	Type       SensorType `json:"type"`
+	Value      *float64   `json:"value,omitempty"` // 无读数时为nil
+	Unit       SensorUnit `json:"unit"`
+	State      string     `json:"state"` // 原始状态，如ok、nc、cr、nr、ns。
+	Thresholds Thresholds `json:"thresholds"`
+}
+
+// NewSensor 将SensorDevice中的字符串字段转换为结构化的传感器信息
+func NewSensor(d *SensorDevice) *Sensor {
+	if d == nil {
+		return nil
+	}
+	unit := SensorUnit(strings.TrimSpace(d.Units))
+	return &Sensor{
+		Name:  d.Name,
+		Type:  classify(d.Name, unit),
+		Value: parseFloat(d.Value),
+		Unit:  unit,
+		State: strings.ToLower(strings.TrimSpace(d.State)),
+		Thresholds: Thresholds{
+			LowerNonRecoverable: parseFloat(d.Lonorec),
+			LowerCritical:       parseFloat(d.Locrit),
+			LowerNonCritical:    parseFloat(d.Lonocrit),
+			UpperNonCritical:    parseFloat(d.Upnocrit),
+			UpperCritical:       parseFloat(d.Upcrit),
+			UpperNonRecoverable: parseFloat(d.Upnorec),
+		},
+	}
+}
+
+// parseFloat 解析数值，空值、na及十六进制的离散状态值均返回nil。
+func parseFloat(s string) *float64 {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.HasPrefix(s, "0x") {
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+var (
+	psuPattern   = regexp.MustCompile(`(?i)\bpsu?\s*\d|\bpsu\b|power\s*supply|pwr\s*supply`)
+	drivePattern = regexp.MustCompile(`(?i)\b(drive|disk|hdd|ssd|nvme)`)
+)
+
+// classify 根据传感器名称及单位对传感器分类。电源模块及硬盘按名称优先识别，其余按单位识别。
+func classify(name string, unit SensorUnit) SensorType {
+	switch {
+	case psuPattern.MatchString(name):
+		return PSUSensor
+	case drivePattern.MatchString(name):
+		return DriveSensor
+	}
+	switch unit {
+	case UnitCelsius, UnitFahrenheit, "degrees K":
+		return TemperatureSensor
+	case UnitRPM:
+		return FanSensor
+	case UnitVolts:
+		return VoltageSensor
+	case UnitAmps:
+		return CurrentSensor
+	case UnitWatts:
+		return PowerSensor
+	}
+	lower := strings.ToLower(name)
+	switch {
+	case strings.Contains(lower, "temp"):
+		return TemperatureSensor
+	case strings.Contains(lower, "fan"):
+		return FanSensor
+	case strings.Contains(lower, "volt"):
+		return VoltageSensor
+	case strings.Contains(lower, "power") || strings.Contains(lower, "pwr"):
+		return PowerSensor
+	}
+	return OtherSensor
+}
+
+// Health 评估传感器健康状态。优先采用BMC给出的状态，否则将读数与阈值比较。
+// 离散传感器按其状态位或状态描述评估，见discreteHealth。
+func (s *Sensor) Health() Health {
+	switch s.State {
+	case "nr", "cr":
+		return HealthCritical
+	case "nc":
+		return HealthWarning
+	case "ok", "ns", "":
+	default:
+		return s.discreteHealth()
+	}
+	if s.Value == nil {
+		return HealthUnknown
+	}
+	v, t := *s.Value, &s.Thresholds
+	below := func(th *float64) bool { return th != nil && v <= *th }
+	above := func(th *float64) bool { return th != nil && v >= *th }
+	switch {
+	case below(t.LowerNonRecoverable), below(t.LowerCritical), above(t.UpperCritical), above(t.UpperNonRecoverable):
+		return HealthCritical
+	case below(t.LowerNonCritical), above(t.UpperNonCritical):
+		return HealthWarning
+	}
+	if s.State == "ok" || t.LowerNonRecoverable != nil || t.LowerCritical != nil || t.LowerNonCritical != nil ||
+		t.UpperNonCritical != nil || t.UpperCritical != nil || t.UpperNonRecoverable != nil {
+		return HealthOK
+	}
+	return HealthUnknown
+}
+
+// discreteOffsets 电源模块（传感器类型08h）及硬盘槽位（传感器类型0Dh）离散状态位对应的健康状态，
+// 未列出的状态位（如在位）视为正常。
+var discreteOffsets = map[SensorType]map[uint]Health{
+	PSUSensor: {
+		1: HealthCritical, // Failure detected
+		2: HealthWarning,  // Predictive failure
+		3: HealthCritical, // Power Supply input lost (AC/DC)
+		4: HealthCritical, // Power Supply input lost or out-of-range
+		5: HealthWarning,  // Power Supply input out-of-range, but present
+		6: HealthWarning,  // Configuration error
+	},
+	DriveSensor: {
+		1: HealthCritical, // Drive Fault
+		2: HealthWarning,  // Predictive Failure
+		5: HealthCritical, // In Critical Array
+		6: HealthCritical, // In Failed Array
+		7: HealthWarning,  // Rebuild/Remap in progress
+		8: HealthCritical, // Rebuild/Remap Aborted
+	},
+}
+
+// discreteKeywords 离散传感器状态描述（如'ipmitool sdr elist'的输出）中的关键字及对应的健康状态，按先后顺序匹配。
+var discreteKeywords = []struct {
+	keyword string
+	health  Health
+}{
+	{"predictive", HealthWarning},
+	{"non-redundant", HealthWarning},
+	{"fail", HealthCritical},
+	{"fault", HealthCritical},
+	{"lost", HealthCritical},
+	{"non-recoverable", HealthCritical},
+	{"absent", HealthWarning},
+	{"removed", HealthWarning},
+	{"degraded", HealthWarning},
+	{"rebuild", HealthWarning},
+	{"out-of-range", HealthWarning},
+	{"out of range", HealthWarning},
+	{"error", HealthWarning},
+	{"present", HealthOK},
+	{"presence", HealthOK},
+	{"redundant", HealthOK},
+}
+
+// discreteHealth 评估离散传感器的健康状态。
+// 十六进制状态（如'ipmitool sensor list'输出的0x0280，前一字节为状态位0-7，后一字节为状态位8-14）按传感器分类解码，
+// 其余按状态描述中的关键字评估。无法识别时返回HealthUnknown。
+func (s *Sensor) discreteHealth() Health {
+	if !strings.HasPrefix(s.State, "0x") {
+		for _, kw := range discreteKeywords {
+			if strings.Contains(s.State, kw.keyword) {
+				return kw.health
+			}
+		}
+		return HealthUnknown
+	}
+	offsets, ok := discreteOffsets[s.Type]
+	if !ok {
+		return HealthUnknown
+	}
+	digits := strings.TrimPrefix(s.State, "0x")
+	v, err := strconv.ParseUint(digits, 16, 16)
+	if err != nil {
+		return HealthUnknown
+	}
+	bits := v
+	if len(digits) == 4 {
+		bits = v>>8 | (v&0x7F)<<8
+	}
+	if bits == 0 {
+		return HealthUnknown
+	}
+	health := HealthOK
+	for offset, h := range offsets {
+		if bits&(1<<offset) != 0 && healthRank[h] > healthRank[health] {
+			health = h
+		}
+	}
+	return health
+}
+
+// Sensors 传感器列表
+type Sensors []*Sensor
+
+// NewSensors 将SensorDevice切片转换为结构化的传感器列表
+func NewSensors(items []*SensorDevice) Sensors {
+	sensors := make(Sensors, 0, len(items))
+	for _, item := range items {
+		if s := NewSensor(item); s != nil {
+			sensors = append(sensors, s)
+		}
+	}
+	return sensors
+}
+
+// ReadSensors 通过处理器读取传感器信息并转换为结构化的传感器列表
+func ReadSensors(w Worker) (Sensors, error) {
+	items, err := w.SensorList()
+	if err != nil {
+		return nil, err
+	}
+	return NewSensors(items), nil
+}
+
+// Filter 返回指定分类的传感器
+func (sensors Sensors) Filter(t SensorType) Sensors {
+	var items Sensors
+	for _, s := range sensors {
+		if s.Type == t {
+			items = append(items, s)
+		}
+	}
+	return items
+}
+
+// Health 返回全部传感器中最差的健康状态。所有传感器的健康状态均未知时返回HealthUnknown。
+func (sensors Sensors) Health() Health {
+	health := HealthUnknown
+	for _, s := range sensors {
+		if h := s.Health(); healthRank[h] > healthRank[health] {
+			health = h
+		}
+	}
+	return health
+}
@@ -1,18 +1,24 @@
 package main
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/hashicorp/go-plugin"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/oob/ipmi"
+	_ "github.com/licairong/cloudboot-provider-framework/oob/ipmi/native"
+	_ "github.com/licairong/cloudboot-provider-framework/oob/redfish"
+	"github.com/licairong/cloudboot-provider-framework/raid/avago"
 	"github.com/licairong/cloudboot-provider-framework/shared"
-	"github.com/licairong/raid-avago"
-	"github.com/licairong/oob-dell"
 )
 
 func main() {
 	plugin.Serve(&plugin.ServeConfig{
 		HandshakeConfig: shared.Handshake,
 		Plugins: map[string]plugin.Plugin{
-			"RaidPlugin": &shared.GRPCRaidPlugin{Impl: &raid.RaidPlugin{}},
-			"OobPlugin": &shared.GRPCOobPlugin{Impl: &oob.OobPlugin{}},
+			"RaidPlugin": &shared.GRPCRaidPlugin{Impl: avago.NewRaidPlugin(nil)},
+			"OobPlugin":  &shared.GRPCOobPlugin{Impl: oobWorker()},
 			//"BiosPlugin": &shared.GRPCBiosPlugin{Impl: &bios.BiosPlugin{}},
 			//"FirmwarePlugin": &shared.GRPCFirmwarePlugin{Impl: &firmware.FirmwarePlugin{}},
 		},
@@ -20,3 +26,40 @@ func main() {
 		GRPCServer: plugin.DefaultGRPCServer,
 	})
 }
+
+// oobWorker 返回BMC支持的OOB处理器。
+// 通过CLOUDBOOT_OOB_HOST等环境变量指定带外主机时探测其管理接口（如Redfish），否则经由本机IPMI接口操作。
+// CLOUDBOOT_OOB_WORKER指定处理器名时不再探测，如IPMI-NATIVE经由本机OpenIPMI设备操作，无需安装ipmitool。
+func oobWorker() oob.Worker {
+	setters := []func(*oob.Options){oob.WithChannelID(-1)}
+	if host := os.Getenv("CLOUDBOOT_OOB_HOST"); host != "" {
+		setters = append(setters, oob.WithRemote(
+			envOr("CLOUDBOOT_OOB_INTERFACE", oob.LANPlusInterface),
+			host,
+			os.Getenv("CLOUDBOOT_OOB_USERNAME"),
+			os.Getenv("CLOUDBOOT_OOB_PASSWORD"),
+		))
+	}
+
+	name := os.Getenv("CLOUDBOOT_OOB_WORKER")
+	if name == "" {
+		var err error
+		if name, err = oob.Whoami(setters...); err != nil {
+			fmt.Fprintf(os.Stderr, "oob: whoami: %s\n", err)
+			name = oob.DefaultWorker
+		}
+	}
+	if w := oob.SelectWorker(name, setters...); w != nil {
+		return w
+	}
+	fmt.Fprintf(os.Stderr, "oob: worker %s not registered, use %s\n", name, oob.DefaultWorker)
+	return ipmi.NewWorker(setters...)
+}
+
+// envOr 返回环境变量的值，未设置时返回def。
+func envOr(key, def string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return def
+}
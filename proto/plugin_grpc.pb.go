@@ -22,8 +22,16 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RaidPluginClient interface {
-	RAID(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
-	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*Response, error)
+	RAID(ctx context.Context, in *Request, opts ...grpc.CallOption) (*RAID, error)
+	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*Empty, error)
+	Controllers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*RAID, error)
+	PhysicalDrives(ctx context.Context, in *ControllerRequest, opts ...grpc.CallOption) (*PhysicalDrives, error)
+	CreateLogicalDrive(ctx context.Context, in *CreateLogicalDriveRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteLogicalDrive(ctx context.Context, in *DeleteLogicalDriveRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetGlobalHotspares(ctx context.Context, in *HotsparesRequest, opts ...grpc.CallOption) (*Empty, error)
+	SetControllerMode(ctx context.Context, in *ControllerModeRequest, opts ...grpc.CallOption) (*Empty, error)
+	InitDisk(ctx context.Context, in *ControllerRequest, opts ...grpc.CallOption) (*Empty, error)
+	PostCheck(ctx context.Context, in *RaidSetting, opts ...grpc.CallOption) (*CheckingItems, error)
 }
 
 type raidPluginClient struct {
@@ -34,8 +42,8 @@ func NewRaidPluginClient(cc grpc.ClientConnInterface) RaidPluginClient {
 	return &raidPluginClient{cc}
 }
 
-func (c *raidPluginClient) RAID(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
+func (c *raidPluginClient) RAID(ctx context.Context, in *Request, opts ...grpc.CallOption) (*RAID, error) {
+	out := new(RAID)
 	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/RAID", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -43,8 +51,8 @@ func (c *raidPluginClient) RAID(ctx context.Context, in *Request, opts ...grpc.C
 	return out, nil
 }
 
-func (c *raidPluginClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
+func (c *raidPluginClient) Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/Clear", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -52,12 +60,92 @@ func (c *raidPluginClient) Clear(ctx context.Context, in *ClearRequest, opts ...
 	return out, nil
 }
 
+func (c *raidPluginClient) Controllers(ctx context.Context, in *Request, opts ...grpc.CallOption) (*RAID, error) {
+	out := new(RAID)
+	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/Controllers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidPluginClient) PhysicalDrives(ctx context.Context, in *ControllerRequest, opts ...grpc.CallOption) (*PhysicalDrives, error) {
+	out := new(PhysicalDrives)
+	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/PhysicalDrives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidPluginClient) CreateLogicalDrive(ctx context.Context, in *CreateLogicalDriveRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/CreateLogicalDrive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidPluginClient) DeleteLogicalDrive(ctx context.Context, in *DeleteLogicalDriveRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/DeleteLogicalDrive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidPluginClient) SetGlobalHotspares(ctx context.Context, in *HotsparesRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/SetGlobalHotspares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidPluginClient) SetControllerMode(ctx context.Context, in *ControllerModeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/SetControllerMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidPluginClient) InitDisk(ctx context.Context, in *ControllerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/InitDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raidPluginClient) PostCheck(ctx context.Context, in *RaidSetting, opts ...grpc.CallOption) (*CheckingItems, error) {
+	out := new(CheckingItems)
+	err := c.cc.Invoke(ctx, "/proto.RaidPlugin/PostCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RaidPluginServer is the server API for RaidPlugin service.
 // All implementations must embed UnimplementedRaidPluginServer
 // for forward compatibility
 type RaidPluginServer interface {
-	RAID(context.Context, *Request) (*Response, error)
-	Clear(context.Context, *ClearRequest) (*Response, error)
+	RAID(context.Context, *Request) (*RAID, error)
+	Clear(context.Context, *ClearRequest) (*Empty, error)
+	Controllers(context.Context, *Request) (*RAID, error)
+	PhysicalDrives(context.Context, *ControllerRequest) (*PhysicalDrives, error)
+	CreateLogicalDrive(context.Context, *CreateLogicalDriveRequest) (*Empty, error)
+	DeleteLogicalDrive(context.Context, *DeleteLogicalDriveRequest) (*Empty, error)
+	SetGlobalHotspares(context.Context, *HotsparesRequest) (*Empty, error)
+	SetControllerMode(context.Context, *ControllerModeRequest) (*Empty, error)
+	InitDisk(context.Context, *ControllerRequest) (*Empty, error)
+	PostCheck(context.Context, *RaidSetting) (*CheckingItems, error)
 	mustEmbedUnimplementedRaidPluginServer()
 }
 
@@ -65,12 +153,36 @@ type RaidPluginServer interface {
 type UnimplementedRaidPluginServer struct {
 }
 
-func (UnimplementedRaidPluginServer) RAID(context.Context, *Request) (*Response, error) {
+func (UnimplementedRaidPluginServer) RAID(context.Context, *Request) (*RAID, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RAID not implemented")
 }
-func (UnimplementedRaidPluginServer) Clear(context.Context, *ClearRequest) (*Response, error) {
+func (UnimplementedRaidPluginServer) Clear(context.Context, *ClearRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
 }
+func (UnimplementedRaidPluginServer) Controllers(context.Context, *Request) (*RAID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Controllers not implemented")
+}
+func (UnimplementedRaidPluginServer) PhysicalDrives(context.Context, *ControllerRequest) (*PhysicalDrives, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PhysicalDrives not implemented")
+}
+func (UnimplementedRaidPluginServer) CreateLogicalDrive(context.Context, *CreateLogicalDriveRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLogicalDrive not implemented")
+}
+func (UnimplementedRaidPluginServer) DeleteLogicalDrive(context.Context, *DeleteLogicalDriveRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLogicalDrive not implemented")
+}
+func (UnimplementedRaidPluginServer) SetGlobalHotspares(context.Context, *HotsparesRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGlobalHotspares not implemented")
+}
+func (UnimplementedRaidPluginServer) SetControllerMode(context.Context, *ControllerModeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetControllerMode not implemented")
+}
+func (UnimplementedRaidPluginServer) InitDisk(context.Context, *ControllerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitDisk not implemented")
+}
+func (UnimplementedRaidPluginServer) PostCheck(context.Context, *RaidSetting) (*CheckingItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostCheck not implemented")
+}
 func (UnimplementedRaidPluginServer) mustEmbedUnimplementedRaidPluginServer() {}
 
 // UnsafeRaidPluginServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +232,150 @@ func _RaidPlugin_Clear_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RaidPlugin_Controllers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidPluginServer).Controllers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RaidPlugin/Controllers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidPluginServer).Controllers(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidPlugin_PhysicalDrives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidPluginServer).PhysicalDrives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RaidPlugin/PhysicalDrives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidPluginServer).PhysicalDrives(ctx, req.(*ControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidPlugin_CreateLogicalDrive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLogicalDriveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidPluginServer).CreateLogicalDrive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RaidPlugin/CreateLogicalDrive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidPluginServer).CreateLogicalDrive(ctx, req.(*CreateLogicalDriveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidPlugin_DeleteLogicalDrive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLogicalDriveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidPluginServer).DeleteLogicalDrive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RaidPlugin/DeleteLogicalDrive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidPluginServer).DeleteLogicalDrive(ctx, req.(*DeleteLogicalDriveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidPlugin_SetGlobalHotspares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HotsparesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidPluginServer).SetGlobalHotspares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RaidPlugin/SetGlobalHotspares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidPluginServer).SetGlobalHotspares(ctx, req.(*HotsparesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidPlugin_SetControllerMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControllerModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidPluginServer).SetControllerMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RaidPlugin/SetControllerMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidPluginServer).SetControllerMode(ctx, req.(*ControllerModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidPlugin_InitDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidPluginServer).InitDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RaidPlugin/InitDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidPluginServer).InitDisk(ctx, req.(*ControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaidPlugin_PostCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaidSetting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaidPluginServer).PostCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.RaidPlugin/PostCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaidPluginServer).PostCheck(ctx, req.(*RaidSetting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RaidPlugin_ServiceDesc is the grpc.ServiceDesc for RaidPlugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +391,38 @@ var RaidPlugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Clear",
 			Handler:    _RaidPlugin_Clear_Handler,
 		},
+		{
+			MethodName: "Controllers",
+			Handler:    _RaidPlugin_Controllers_Handler,
+		},
+		{
+			MethodName: "PhysicalDrives",
+			Handler:    _RaidPlugin_PhysicalDrives_Handler,
+		},
+		{
+			MethodName: "CreateLogicalDrive",
+			Handler:    _RaidPlugin_CreateLogicalDrive_Handler,
+		},
+		{
+			MethodName: "DeleteLogicalDrive",
+			Handler:    _RaidPlugin_DeleteLogicalDrive_Handler,
+		},
+		{
+			MethodName: "SetGlobalHotspares",
+			Handler:    _RaidPlugin_SetGlobalHotspares_Handler,
+		},
+		{
+			MethodName: "SetControllerMode",
+			Handler:    _RaidPlugin_SetControllerMode_Handler,
+		},
+		{
+			MethodName: "InitDisk",
+			Handler:    _RaidPlugin_InitDisk_Handler,
+		},
+		{
+			MethodName: "PostCheck",
+			Handler:    _RaidPlugin_PostCheck_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "plugin.proto",
@@ -144,8 +432,35 @@ var RaidPlugin_ServiceDesc = grpc.ServiceDesc{
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OobPluginClient interface {
-	OOB(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
-	PowerReset(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	OOB(ctx context.Context, in *Request, opts ...grpc.CallOption) (*OOB, error)
+	Name(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	FRUDevice(ctx context.Context, in *Request, opts ...grpc.CallOption) (*FRUDevice, error)
+	ValidateSN(ctx context.Context, in *ValidateSNRequest, opts ...grpc.CallOption) (*Empty, error)
+	PowerStatus(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	PowerOn(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error)
+	PowerOff(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error)
+	PowerReset(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error)
+	PXEBoot(ctx context.Context, in *PXEBootRequest, opts ...grpc.CallOption) (*Empty, error)
+	Channel(ctx context.Context, in *Request, opts ...grpc.CallOption) (*ChannelResponse, error)
+	PostCheck(ctx context.Context, in *OobSetting, opts ...grpc.CallOption) (*CheckingItems, error)
+	Raw(ctx context.Context, in *RawRequest, opts ...grpc.CallOption) (*RawResponse, error)
+	SelClear(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error)
+	EventLogs(ctx context.Context, in *EventLogsRequest, opts ...grpc.CallOption) (*EventLogs, error)
+	SensorList(ctx context.Context, in *Request, opts ...grpc.CallOption) (*SensorDevices, error)
+	SetSnmpTrap(ctx context.Context, in *SnmpSet, opts ...grpc.CallOption) (*Empty, error)
+	// NetworkWorker
+	SetDHCP(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error)
+	SetStaticIP(ctx context.Context, in *SetStaticIPRequest, opts ...grpc.CallOption) (*Empty, error)
+	Network(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Network, error)
+	// UserWorker
+	ChangeUserPassword(ctx context.Context, in *ChangeUserPasswordRequest, opts ...grpc.CallOption) (*Empty, error)
+	GenerateUser(ctx context.Context, in *UserSettingItem, opts ...grpc.CallOption) (*Empty, error)
+	EnableUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Empty, error)
+	DisableUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Empty, error)
+	Users(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Users, error)
+	// BMCWorker
+	BMC(ctx context.Context, in *Request, opts ...grpc.CallOption) (*BMC, error)
+	BMCColdReset(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type oobPluginClient struct {
@@ -156,8 +471,8 @@ func NewOobPluginClient(cc grpc.ClientConnInterface) OobPluginClient {
 	return &oobPluginClient{cc}
 }
 
-func (c *oobPluginClient) OOB(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
+func (c *oobPluginClient) OOB(ctx context.Context, in *Request, opts ...grpc.CallOption) (*OOB, error) {
+	out := new(OOB)
 	err := c.cc.Invoke(ctx, "/proto.OobPlugin/OOB", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -165,99 +480,1437 @@ func (c *oobPluginClient) OOB(ctx context.Context, in *Request, opts ...grpc.Cal
 	return out, nil
 }
 
-func (c *oobPluginClient) PowerReset(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error) {
+func (c *oobPluginClient) Name(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
-	err := c.cc.Invoke(ctx, "/proto.OobPlugin/PowerReset", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/Name", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// OobPluginServer is the server API for OobPlugin service.
-// All implementations must embed UnimplementedOobPluginServer
-// for forward compatibility
-type OobPluginServer interface {
-	OOB(context.Context, *Request) (*Response, error)
-	PowerReset(context.Context, *Request) (*Response, error)
-	mustEmbedUnimplementedOobPluginServer()
+func (c *oobPluginClient) FRUDevice(ctx context.Context, in *Request, opts ...grpc.CallOption) (*FRUDevice, error) {
+	out := new(FRUDevice)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/FRUDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedOobPluginServer must be embedded to have forward compatible implementations.
-type UnimplementedOobPluginServer struct {
+func (c *oobPluginClient) ValidateSN(ctx context.Context, in *ValidateSNRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/ValidateSN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedOobPluginServer) OOB(context.Context, *Request) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OOB not implemented")
-}
-func (UnimplementedOobPluginServer) PowerReset(context.Context, *Request) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PowerReset not implemented")
+func (c *oobPluginClient) PowerStatus(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/PowerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedOobPluginServer) mustEmbedUnimplementedOobPluginServer() {}
 
-// UnsafeOobPluginServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to OobPluginServer will
-// result in compilation errors.
-type UnsafeOobPluginServer interface {
-	mustEmbedUnimplementedOobPluginServer()
+func (c *oobPluginClient) PowerOn(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/PowerOn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterOobPluginServer(s grpc.ServiceRegistrar, srv OobPluginServer) {
-	s.RegisterService(&OobPlugin_ServiceDesc, srv)
+func (c *oobPluginClient) PowerOff(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/PowerOff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func _OobPlugin_OOB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
+func (c *oobPluginClient) PowerReset(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/PowerReset", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(OobPluginServer).OOB(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/proto.OobPlugin/OOB",
+	return out, nil
+}
+
+func (c *oobPluginClient) PXEBoot(ctx context.Context, in *PXEBootRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/PXEBoot", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OobPluginServer).OOB(ctx, req.(*Request))
+	return out, nil
+}
+
+func (c *oobPluginClient) Channel(ctx context.Context, in *Request, opts ...grpc.CallOption) (*ChannelResponse, error) {
+	out := new(ChannelResponse)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/Channel", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _OobPlugin_PowerReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Request)
-	if err := dec(in); err != nil {
+func (c *oobPluginClient) PostCheck(ctx context.Context, in *OobSetting, opts ...grpc.CallOption) (*CheckingItems, error) {
+	out := new(CheckingItems)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/PostCheck", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(OobPluginServer).PowerReset(ctx, in)
+	return out, nil
+}
+
+func (c *oobPluginClient) Raw(ctx context.Context, in *RawRequest, opts ...grpc.CallOption) (*RawResponse, error) {
+	out := new(RawResponse)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/Raw", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) SelClear(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/SelClear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) EventLogs(ctx context.Context, in *EventLogsRequest, opts ...grpc.CallOption) (*EventLogs, error) {
+	out := new(EventLogs)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/EventLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) SensorList(ctx context.Context, in *Request, opts ...grpc.CallOption) (*SensorDevices, error) {
+	out := new(SensorDevices)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/SensorList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) SetSnmpTrap(ctx context.Context, in *SnmpSet, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/SetSnmpTrap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) SetDHCP(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/SetDHCP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) SetStaticIP(ctx context.Context, in *SetStaticIPRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/SetStaticIP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) Network(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Network, error) {
+	out := new(Network)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/Network", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) ChangeUserPassword(ctx context.Context, in *ChangeUserPasswordRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/ChangeUserPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) GenerateUser(ctx context.Context, in *UserSettingItem, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/GenerateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) EnableUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/EnableUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) DisableUser(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/DisableUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) Users(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Users, error) {
+	out := new(Users)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/Users", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) BMC(ctx context.Context, in *Request, opts ...grpc.CallOption) (*BMC, error) {
+	out := new(BMC)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/BMC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oobPluginClient) BMCColdReset(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.OobPlugin/BMCColdReset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OobPluginServer is the server API for OobPlugin service.
+// All implementations must embed UnimplementedOobPluginServer
+// for forward compatibility
+type OobPluginServer interface {
+	OOB(context.Context, *Request) (*OOB, error)
+	Name(context.Context, *Request) (*Response, error)
+	FRUDevice(context.Context, *Request) (*FRUDevice, error)
+	ValidateSN(context.Context, *ValidateSNRequest) (*Empty, error)
+	PowerStatus(context.Context, *Request) (*Response, error)
+	PowerOn(context.Context, *Request) (*Empty, error)
+	PowerOff(context.Context, *Request) (*Empty, error)
+	PowerReset(context.Context, *Request) (*Empty, error)
+	PXEBoot(context.Context, *PXEBootRequest) (*Empty, error)
+	Channel(context.Context, *Request) (*ChannelResponse, error)
+	PostCheck(context.Context, *OobSetting) (*CheckingItems, error)
+	Raw(context.Context, *RawRequest) (*RawResponse, error)
+	SelClear(context.Context, *Request) (*Empty, error)
+	EventLogs(context.Context, *EventLogsRequest) (*EventLogs, error)
+	SensorList(context.Context, *Request) (*SensorDevices, error)
+	SetSnmpTrap(context.Context, *SnmpSet) (*Empty, error)
+	// NetworkWorker
+	SetDHCP(context.Context, *Request) (*Empty, error)
+	SetStaticIP(context.Context, *SetStaticIPRequest) (*Empty, error)
+	Network(context.Context, *Request) (*Network, error)
+	// UserWorker
+	ChangeUserPassword(context.Context, *ChangeUserPasswordRequest) (*Empty, error)
+	GenerateUser(context.Context, *UserSettingItem) (*Empty, error)
+	EnableUser(context.Context, *UserRequest) (*Empty, error)
+	DisableUser(context.Context, *UserRequest) (*Empty, error)
+	Users(context.Context, *Request) (*Users, error)
+	// BMCWorker
+	BMC(context.Context, *Request) (*BMC, error)
+	BMCColdReset(context.Context, *Request) (*Empty, error)
+	mustEmbedUnimplementedOobPluginServer()
+}
+
+// UnimplementedOobPluginServer must be embedded to have forward compatible implementations.
+type UnimplementedOobPluginServer struct {
+}
+
+func (UnimplementedOobPluginServer) OOB(context.Context, *Request) (*OOB, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OOB not implemented")
+}
+func (UnimplementedOobPluginServer) Name(context.Context, *Request) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Name not implemented")
+}
+func (UnimplementedOobPluginServer) FRUDevice(context.Context, *Request) (*FRUDevice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FRUDevice not implemented")
+}
+func (UnimplementedOobPluginServer) ValidateSN(context.Context, *ValidateSNRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateSN not implemented")
+}
+func (UnimplementedOobPluginServer) PowerStatus(context.Context, *Request) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerStatus not implemented")
+}
+func (UnimplementedOobPluginServer) PowerOn(context.Context, *Request) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerOn not implemented")
+}
+func (UnimplementedOobPluginServer) PowerOff(context.Context, *Request) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerOff not implemented")
+}
+func (UnimplementedOobPluginServer) PowerReset(context.Context, *Request) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerReset not implemented")
+}
+func (UnimplementedOobPluginServer) PXEBoot(context.Context, *PXEBootRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PXEBoot not implemented")
+}
+func (UnimplementedOobPluginServer) Channel(context.Context, *Request) (*ChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Channel not implemented")
+}
+func (UnimplementedOobPluginServer) PostCheck(context.Context, *OobSetting) (*CheckingItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostCheck not implemented")
+}
+func (UnimplementedOobPluginServer) Raw(context.Context, *RawRequest) (*RawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Raw not implemented")
+}
+func (UnimplementedOobPluginServer) SelClear(context.Context, *Request) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelClear not implemented")
+}
+func (UnimplementedOobPluginServer) EventLogs(context.Context, *EventLogsRequest) (*EventLogs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EventLogs not implemented")
+}
+func (UnimplementedOobPluginServer) SensorList(context.Context, *Request) (*SensorDevices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SensorList not implemented")
+}
+func (UnimplementedOobPluginServer) SetSnmpTrap(context.Context, *SnmpSet) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSnmpTrap not implemented")
+}
+func (UnimplementedOobPluginServer) SetDHCP(context.Context, *Request) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDHCP not implemented")
+}
+func (UnimplementedOobPluginServer) SetStaticIP(context.Context, *SetStaticIPRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStaticIP not implemented")
+}
+func (UnimplementedOobPluginServer) Network(context.Context, *Request) (*Network, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Network not implemented")
+}
+func (UnimplementedOobPluginServer) ChangeUserPassword(context.Context, *ChangeUserPasswordRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeUserPassword not implemented")
+}
+func (UnimplementedOobPluginServer) GenerateUser(context.Context, *UserSettingItem) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateUser not implemented")
+}
+func (UnimplementedOobPluginServer) EnableUser(context.Context, *UserRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableUser not implemented")
+}
+func (UnimplementedOobPluginServer) DisableUser(context.Context, *UserRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUser not implemented")
+}
+func (UnimplementedOobPluginServer) Users(context.Context, *Request) (*Users, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Users not implemented")
+}
+func (UnimplementedOobPluginServer) BMC(context.Context, *Request) (*BMC, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BMC not implemented")
+}
+func (UnimplementedOobPluginServer) BMCColdReset(context.Context, *Request) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BMCColdReset not implemented")
+}
+func (UnimplementedOobPluginServer) mustEmbedUnimplementedOobPluginServer() {}
+
+// UnsafeOobPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OobPluginServer will
+// result in compilation errors.
+type UnsafeOobPluginServer interface {
+	mustEmbedUnimplementedOobPluginServer()
+}
+
+func RegisterOobPluginServer(s grpc.ServiceRegistrar, srv OobPluginServer) {
+	s.RegisterService(&OobPlugin_ServiceDesc, srv)
+}
+
+func _OobPlugin_OOB_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).OOB(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/OOB",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).OOB(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_Name_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).Name(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/Name",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).Name(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_FRUDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).FRUDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/FRUDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).FRUDevice(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_ValidateSN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateSNRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).ValidateSN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/ValidateSN",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).ValidateSN(ctx, req.(*ValidateSNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_PowerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).PowerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/PowerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).PowerStatus(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_PowerOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).PowerOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/PowerOn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).PowerOn(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_PowerOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).PowerOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/PowerOff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).PowerOff(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_PowerReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).PowerReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/PowerReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).PowerReset(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_PXEBoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PXEBootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).PXEBoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/PXEBoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).PXEBoot(ctx, req.(*PXEBootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_Channel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).Channel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/Channel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).Channel(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_PostCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OobSetting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).PostCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/PostCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).PostCheck(ctx, req.(*OobSetting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_Raw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).Raw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/Raw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).Raw(ctx, req.(*RawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_SelClear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).SelClear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/SelClear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).SelClear(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_EventLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).EventLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/EventLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).EventLogs(ctx, req.(*EventLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_SensorList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).SensorList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/SensorList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).SensorList(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_SetSnmpTrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnmpSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).SetSnmpTrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/SetSnmpTrap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).SetSnmpTrap(ctx, req.(*SnmpSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_SetDHCP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).SetDHCP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/SetDHCP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).SetDHCP(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_SetStaticIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStaticIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).SetStaticIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/SetStaticIP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).SetStaticIP(ctx, req.(*SetStaticIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_Network_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).Network(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/Network",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).Network(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_ChangeUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).ChangeUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/ChangeUserPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).ChangeUserPassword(ctx, req.(*ChangeUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_GenerateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSettingItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).GenerateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/GenerateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).GenerateUser(ctx, req.(*UserSettingItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_EnableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).EnableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/EnableUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).EnableUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_DisableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).DisableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/DisableUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).DisableUser(ctx, req.(*UserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_Users_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).Users(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/Users",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).Users(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_BMC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).BMC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/BMC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).BMC(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OobPlugin_BMCColdReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OobPluginServer).BMCColdReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.OobPlugin/BMCColdReset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OobPluginServer).BMCColdReset(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OobPlugin_ServiceDesc is the grpc.ServiceDesc for OobPlugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OobPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.OobPlugin",
+	HandlerType: (*OobPluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OOB",
+			Handler:    _OobPlugin_OOB_Handler,
+		},
+		{
+			MethodName: "Name",
+			Handler:    _OobPlugin_Name_Handler,
+		},
+		{
+			MethodName: "FRUDevice",
+			Handler:    _OobPlugin_FRUDevice_Handler,
+		},
+		{
+			MethodName: "ValidateSN",
+			Handler:    _OobPlugin_ValidateSN_Handler,
+		},
+		{
+			MethodName: "PowerStatus",
+			Handler:    _OobPlugin_PowerStatus_Handler,
+		},
+		{
+			MethodName: "PowerOn",
+			Handler:    _OobPlugin_PowerOn_Handler,
+		},
+		{
+			MethodName: "PowerOff",
+			Handler:    _OobPlugin_PowerOff_Handler,
+		},
+		{
+			MethodName: "PowerReset",
+			Handler:    _OobPlugin_PowerReset_Handler,
+		},
+		{
+			MethodName: "PXEBoot",
+			Handler:    _OobPlugin_PXEBoot_Handler,
+		},
+		{
+			MethodName: "Channel",
+			Handler:    _OobPlugin_Channel_Handler,
+		},
+		{
+			MethodName: "PostCheck",
+			Handler:    _OobPlugin_PostCheck_Handler,
+		},
+		{
+			MethodName: "Raw",
+			Handler:    _OobPlugin_Raw_Handler,
+		},
+		{
+			MethodName: "SelClear",
+			Handler:    _OobPlugin_SelClear_Handler,
+		},
+		{
+			MethodName: "EventLogs",
+			Handler:    _OobPlugin_EventLogs_Handler,
+		},
+		{
+			MethodName: "SensorList",
+			Handler:    _OobPlugin_SensorList_Handler,
+		},
+		{
+			MethodName: "SetSnmpTrap",
+			Handler:    _OobPlugin_SetSnmpTrap_Handler,
+		},
+		{
+			MethodName: "SetDHCP",
+			Handler:    _OobPlugin_SetDHCP_Handler,
+		},
+		{
+			MethodName: "SetStaticIP",
+			Handler:    _OobPlugin_SetStaticIP_Handler,
+		},
+		{
+			MethodName: "Network",
+			Handler:    _OobPlugin_Network_Handler,
+		},
+		{
+			MethodName: "ChangeUserPassword",
+			Handler:    _OobPlugin_ChangeUserPassword_Handler,
+		},
+		{
+			MethodName: "GenerateUser",
+			Handler:    _OobPlugin_GenerateUser_Handler,
+		},
+		{
+			MethodName: "EnableUser",
+			Handler:    _OobPlugin_EnableUser_Handler,
+		},
+		{
+			MethodName: "DisableUser",
+			Handler:    _OobPlugin_DisableUser_Handler,
+		},
+		{
+			MethodName: "Users",
+			Handler:    _OobPlugin_Users_Handler,
+		},
+		{
+			MethodName: "BMC",
+			Handler:    _OobPlugin_BMC_Handler,
+		},
+		{
+			MethodName: "BMCColdReset",
+			Handler:    _OobPlugin_BMCColdReset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "plugin.proto",
+}
+
+// BiosPluginClient is the client API for BiosPlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BiosPluginClient interface {
+	Settings(ctx context.Context, in *Request, opts ...grpc.CallOption) (*BiosSettings, error)
+	Apply(ctx context.Context, in *BiosSettings, opts ...grpc.CallOption) (*Empty, error)
+	ResetDefaults(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error)
+	SetBootOrder(ctx context.Context, in *BootOrder, opts ...grpc.CallOption) (*Empty, error)
+	PostCheck(ctx context.Context, in *BiosSetting, opts ...grpc.CallOption) (*CheckingItems, error)
+}
+
+type biosPluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBiosPluginClient(cc grpc.ClientConnInterface) BiosPluginClient {
+	return &biosPluginClient{cc}
+}
+
+func (c *biosPluginClient) Settings(ctx context.Context, in *Request, opts ...grpc.CallOption) (*BiosSettings, error) {
+	out := new(BiosSettings)
+	err := c.cc.Invoke(ctx, "/proto.BiosPlugin/Settings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *biosPluginClient) Apply(ctx context.Context, in *BiosSettings, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.BiosPlugin/Apply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *biosPluginClient) ResetDefaults(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.BiosPlugin/ResetDefaults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *biosPluginClient) SetBootOrder(ctx context.Context, in *BootOrder, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/proto.BiosPlugin/SetBootOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *biosPluginClient) PostCheck(ctx context.Context, in *BiosSetting, opts ...grpc.CallOption) (*CheckingItems, error) {
+	out := new(CheckingItems)
+	err := c.cc.Invoke(ctx, "/proto.BiosPlugin/PostCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BiosPluginServer is the server API for BiosPlugin service.
+// All implementations must embed UnimplementedBiosPluginServer
+// for forward compatibility
+type BiosPluginServer interface {
+	Settings(context.Context, *Request) (*BiosSettings, error)
+	Apply(context.Context, *BiosSettings) (*Empty, error)
+	ResetDefaults(context.Context, *Request) (*Empty, error)
+	SetBootOrder(context.Context, *BootOrder) (*Empty, error)
+	PostCheck(context.Context, *BiosSetting) (*CheckingItems, error)
+	mustEmbedUnimplementedBiosPluginServer()
+}
+
+// UnimplementedBiosPluginServer must be embedded to have forward compatible implementations.
+type UnimplementedBiosPluginServer struct {
+}
+
+func (UnimplementedBiosPluginServer) Settings(context.Context, *Request) (*BiosSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Settings not implemented")
+}
+func (UnimplementedBiosPluginServer) Apply(context.Context, *BiosSettings) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
+}
+func (UnimplementedBiosPluginServer) ResetDefaults(context.Context, *Request) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetDefaults not implemented")
+}
+func (UnimplementedBiosPluginServer) SetBootOrder(context.Context, *BootOrder) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBootOrder not implemented")
+}
+func (UnimplementedBiosPluginServer) PostCheck(context.Context, *BiosSetting) (*CheckingItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostCheck not implemented")
+}
+func (UnimplementedBiosPluginServer) mustEmbedUnimplementedBiosPluginServer() {}
+
+// UnsafeBiosPluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BiosPluginServer will
+// result in compilation errors.
+type UnsafeBiosPluginServer interface {
+	mustEmbedUnimplementedBiosPluginServer()
+}
+
+func RegisterBiosPluginServer(s grpc.ServiceRegistrar, srv BiosPluginServer) {
+	s.RegisterService(&BiosPlugin_ServiceDesc, srv)
+}
+
+func _BiosPlugin_Settings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiosPluginServer).Settings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.OobPlugin/PowerReset",
+		FullMethod: "/proto.BiosPlugin/Settings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OobPluginServer).PowerReset(ctx, req.(*Request))
+		return srv.(BiosPluginServer).Settings(ctx, req.(*Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// OobPlugin_ServiceDesc is the grpc.ServiceDesc for OobPlugin service.
+func _BiosPlugin_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BiosSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiosPluginServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.BiosPlugin/Apply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiosPluginServer).Apply(ctx, req.(*BiosSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BiosPlugin_ResetDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiosPluginServer).ResetDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.BiosPlugin/ResetDefaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiosPluginServer).ResetDefaults(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BiosPlugin_SetBootOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiosPluginServer).SetBootOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.BiosPlugin/SetBootOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiosPluginServer).SetBootOrder(ctx, req.(*BootOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BiosPlugin_PostCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BiosSetting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BiosPluginServer).PostCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.BiosPlugin/PostCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BiosPluginServer).PostCheck(ctx, req.(*BiosSetting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BiosPlugin_ServiceDesc is the grpc.ServiceDesc for BiosPlugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var OobPlugin_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "proto.OobPlugin",
-	HandlerType: (*OobPluginServer)(nil),
+var BiosPlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.BiosPlugin",
+	HandlerType: (*BiosPluginServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "OOB",
-			Handler:    _OobPlugin_OOB_Handler,
+			MethodName: "Settings",
+			Handler:    _BiosPlugin_Settings_Handler,
 		},
 		{
-			MethodName: "PowerReset",
-			Handler:    _OobPlugin_PowerReset_Handler,
+			MethodName: "Apply",
+			Handler:    _BiosPlugin_Apply_Handler,
+		},
+		{
+			MethodName: "ResetDefaults",
+			Handler:    _BiosPlugin_ResetDefaults_Handler,
+		},
+		{
+			MethodName: "SetBootOrder",
+			Handler:    _BiosPlugin_SetBootOrder_Handler,
+		},
+		{
+			MethodName: "PostCheck",
+			Handler:    _BiosPlugin_PostCheck_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "plugin.proto",
 }
+
+// FirmwarePluginClient is the client API for FirmwarePlugin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FirmwarePluginClient interface {
+	Components(ctx context.Context, in *Request, opts ...grpc.CallOption) (*FirmwareComponents, error)
+	Upload(ctx context.Context, opts ...grpc.CallOption) (FirmwarePlugin_UploadClient, error)
+	Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error)
+	Progress(ctx context.Context, in *ProgressRequest, opts ...grpc.CallOption) (*FirmwareProgress, error)
+	PostCheck(ctx context.Context, in *FirmwareSetting, opts ...grpc.CallOption) (*CheckingItems, error)
+}
+
+type firmwarePluginClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFirmwarePluginClient(cc grpc.ClientConnInterface) FirmwarePluginClient {
+	return &firmwarePluginClient{cc}
+}
+
+func (c *firmwarePluginClient) Components(ctx context.Context, in *Request, opts ...grpc.CallOption) (*FirmwareComponents, error) {
+	out := new(FirmwareComponents)
+	err := c.cc.Invoke(ctx, "/proto.FirmwarePlugin/Components", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firmwarePluginClient) Upload(ctx context.Context, opts ...grpc.CallOption) (FirmwarePlugin_UploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &FirmwarePlugin_ServiceDesc.Streams[0], "/proto.FirmwarePlugin/Upload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &firmwarePluginUploadClient{stream}
+	return x, nil
+}
+
+type FirmwarePlugin_UploadClient interface {
+	Send(*ImageChunk) error
+	CloseAndRecv() (*UploadResponse, error)
+	grpc.ClientStream
+}
+
+type firmwarePluginUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *firmwarePluginUploadClient) Send(m *ImageChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *firmwarePluginUploadClient) CloseAndRecv() (*UploadResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *firmwarePluginClient) Apply(ctx context.Context, in *ApplyRequest, opts ...grpc.CallOption) (*ApplyResponse, error) {
+	out := new(ApplyResponse)
+	err := c.cc.Invoke(ctx, "/proto.FirmwarePlugin/Apply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firmwarePluginClient) Progress(ctx context.Context, in *ProgressRequest, opts ...grpc.CallOption) (*FirmwareProgress, error) {
+	out := new(FirmwareProgress)
+	err := c.cc.Invoke(ctx, "/proto.FirmwarePlugin/Progress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firmwarePluginClient) PostCheck(ctx context.Context, in *FirmwareSetting, opts ...grpc.CallOption) (*CheckingItems, error) {
+	out := new(CheckingItems)
+	err := c.cc.Invoke(ctx, "/proto.FirmwarePlugin/PostCheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FirmwarePluginServer is the server API for FirmwarePlugin service.
+// All implementations must embed UnimplementedFirmwarePluginServer
+// for forward compatibility
+type FirmwarePluginServer interface {
+	Components(context.Context, *Request) (*FirmwareComponents, error)
+	Upload(FirmwarePlugin_UploadServer) error
+	Apply(context.Context, *ApplyRequest) (*ApplyResponse, error)
+	Progress(context.Context, *ProgressRequest) (*FirmwareProgress, error)
+	PostCheck(context.Context, *FirmwareSetting) (*CheckingItems, error)
+	mustEmbedUnimplementedFirmwarePluginServer()
+}
+
+// UnimplementedFirmwarePluginServer must be embedded to have forward compatible implementations.
+type UnimplementedFirmwarePluginServer struct {
+}
+
+func (UnimplementedFirmwarePluginServer) Components(context.Context, *Request) (*FirmwareComponents, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Components not implemented")
+}
+func (UnimplementedFirmwarePluginServer) Upload(FirmwarePlugin_UploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedFirmwarePluginServer) Apply(context.Context, *ApplyRequest) (*ApplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Apply not implemented")
+}
+func (UnimplementedFirmwarePluginServer) Progress(context.Context, *ProgressRequest) (*FirmwareProgress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Progress not implemented")
+}
+func (UnimplementedFirmwarePluginServer) PostCheck(context.Context, *FirmwareSetting) (*CheckingItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostCheck not implemented")
+}
+func (UnimplementedFirmwarePluginServer) mustEmbedUnimplementedFirmwarePluginServer() {}
+
+// UnsafeFirmwarePluginServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FirmwarePluginServer will
+// result in compilation errors.
+type UnsafeFirmwarePluginServer interface {
+	mustEmbedUnimplementedFirmwarePluginServer()
+}
+
+func RegisterFirmwarePluginServer(s grpc.ServiceRegistrar, srv FirmwarePluginServer) {
+	s.RegisterService(&FirmwarePlugin_ServiceDesc, srv)
+}
+
+func _FirmwarePlugin_Components_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirmwarePluginServer).Components(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FirmwarePlugin/Components",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirmwarePluginServer).Components(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirmwarePlugin_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FirmwarePluginServer).Upload(&firmwarePluginUploadServer{stream})
+}
+
+type FirmwarePlugin_UploadServer interface {
+	SendAndClose(*UploadResponse) error
+	Recv() (*ImageChunk, error)
+	grpc.ServerStream
+}
+
+type firmwarePluginUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *firmwarePluginUploadServer) SendAndClose(m *UploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *firmwarePluginUploadServer) Recv() (*ImageChunk, error) {
+	m := new(ImageChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FirmwarePlugin_Apply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirmwarePluginServer).Apply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FirmwarePlugin/Apply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirmwarePluginServer).Apply(ctx, req.(*ApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirmwarePlugin_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirmwarePluginServer).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FirmwarePlugin/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirmwarePluginServer).Progress(ctx, req.(*ProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FirmwarePlugin_PostCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FirmwareSetting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirmwarePluginServer).PostCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.FirmwarePlugin/PostCheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirmwarePluginServer).PostCheck(ctx, req.(*FirmwareSetting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// FirmwarePlugin_ServiceDesc is the grpc.ServiceDesc for FirmwarePlugin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var FirmwarePlugin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.FirmwarePlugin",
+	HandlerType: (*FirmwarePluginServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Components",
+			Handler:    _FirmwarePlugin_Components_Handler,
+		},
+		{
+			MethodName: "Apply",
+			Handler:    _FirmwarePlugin_Apply_Handler,
+		},
+		{
+			MethodName: "Progress",
+			Handler:    _FirmwarePlugin_Progress_Handler,
+		},
+		{
+			MethodName: "PostCheck",
+			Handler:    _FirmwarePlugin_PostCheck_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Upload",
+			Handler:       _FirmwarePlugin_Upload_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "plugin.proto",
+}
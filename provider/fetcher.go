@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+const (
+	// ManifestFile 插件包中的清单文件名
+	ManifestFile = "manifest.json"
+	// SignatureFile 插件包中清单文件的ed25519签名（base64编码）文件名
+	SignatureFile = "manifest.json.sig"
+)
+
+var (
+	// ErrInvalidSignature 插件包清单签名校验失败
+	ErrInvalidSignature = errors.New("invalid provider manifest signature")
+	// ErrChecksumMismatch 插件包内文件的SHA-256校验和与清单不符
+	ErrChecksumMismatch = errors.New("provider file checksum mismatch")
+	// ErrPackageNotFound 服务端不存在该插件包
+	ErrPackageNotFound = errors.New("provider package not found")
+)
+
+// Manifest 插件包清单
+type Manifest struct {
+	Name    string            `json:"name"`    // 插件名称
+	Version string            `json:"version"` // 插件版本
+	Binary  string            `json:"binary"`  // 插件可执行文件在包内的路径
+	Files   map[string]string `json:"files"`   // 包内文件路径与其SHA-256校验和（十六进制）
+}
+
+// Package 已下载、校验并解压的插件包
+type Package struct {
+	Manifest
+	Dir      string // 解压目录
+	Checksum []byte // 插件可执行文件的SHA-256校验和
+}
+
+// BinaryPath 返回插件可执行文件的路径
+func (pkg *Package) BinaryPath() string {
+	return filepath.Join(pkg.Dir, filepath.FromSlash(pkg.Binary))
+}
+
+// SecureConfig 返回go-plugin启动插件前校验可执行文件校验和的配置
+func (pkg *Package) SecureConfig() *plugin.SecureConfig {
+	return &plugin.SecureConfig{
+		Checksum: pkg.Checksum,
+		Hash:     sha256.New(),
+	}
+}
+
+// Fetcher 插件包下载器。
+// 从服务端下载<Server>/plugins/<name>_<arch>.zip，校验清单签名及文件校验和后，
+// 解压至<Dir>/<name>_<arch>/<version>目录。
+type Fetcher struct {
+	Server        string            // 服务端地址，如http://10.0.0.1:8083
+	Dir           string            // 插件根目录
+	PublicKey     ed25519.PublicKey // 校验清单签名的公钥
+	Client        *http.Client      // 为nil时使用http.DefaultClient
+	Retries       int               // 下载失败后的重试次数
+	RetryInterval time.Duration     // 重试间隔
+}
+
+// Fetch 下载、校验并解压插件包。ctx被取消后终止下载且不再重试；服务端不存在该插件包时返回包装了ErrPackageNotFound的错误，不再重试。
+func (f *Fetcher) Fetch(ctx context.Context, name, arch string) (*Package, error) {
+	if len(f.PublicKey) != ed25519.PublicKeySize {
+		return nil, util.NewInvalidOptionError("public_key")
+	}
+	pkgName := fmt.Sprintf("%s_%s", name, arch)
+	archive := filepath.Join(f.Dir, ".download", pkgName+".zip")
+
+	var pkg *Package
+	var err error
+	for i := 0; i <= f.Retries; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, err
+			case <-time.After(f.RetryInterval):
+			}
+		}
+		if err = f.download(ctx, fmt.Sprintf("%s/plugins/%s.zip", strings.TrimRight(f.Server, "/"), pkgName), archive); err != nil {
+			if errors.Is(err, ErrPackageNotFound) {
+				_ = os.Remove(archive)
+				return nil, err
+			}
+			continue
+		}
+		if pkg, err = f.verify(archive); err != nil {
+			// 内容损坏的文件无法续传，删除后重新下载。
+			_ = os.Remove(archive)
+			if errors.Is(err, ErrInvalidSignature) {
+				return nil, err
+			}
+			continue
+		}
+		break
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pkg.Dir = filepath.Join(f.Dir, pkgName, pkg.Version)
+	if err = extract(archive, pkg.Dir); err != nil {
+		return nil, err
+	}
+	_ = os.Remove(archive)
+	return pkg, nil
+}
+
+// download 下载文件。若本地已存在部分内容，则通过Range请求续传。
+func (f *Fetcher) download(ctx context.Context, url, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	offset, err := out.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK: // 服务端不支持续传
+		if err = out.Truncate(0); err != nil {
+			return err
+		}
+		if _, err = out.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	case http.StatusRequestedRangeNotSatisfiable: // 本地文件已完整
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("download %s: %w", url, ErrPackageNotFound)
+	default:
+		return fmt.Errorf("download %s: unexpected status %s", url, resp.Status)
+	}
+	_, err = io.Copy(out, resp.Body)
+	return err
+}
+
+// verify 校验插件包清单签名及包内所有文件的校验和
+func (f *Fetcher) verify(archive string) (*Package, error) {
+	zr, err := zip.OpenReader(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, file := range zr.File {
+		if !file.FileInfo().IsDir() {
+			files[file.Name] = file
+		}
+	}
+
+	manifest, err := readZipFile(files[ManifestFile])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+	sig, err := readZipFile(files[SignatureFile])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+	if sig, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig))); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+	if !ed25519.Verify(f.PublicKey, manifest, sig) {
+		return nil, ErrInvalidSignature
+	}
+
+	var pkg Package
+	if err = json.Unmarshal(manifest, &pkg.Manifest); err != nil {
+		return nil, err
+	}
+	if pkg.Version == "" || pkg.Version != filepath.Base(pkg.Version) || strings.HasPrefix(pkg.Version, ".") {
+		return nil, util.NewInvalidOptionError("version", pkg.Version)
+	}
+	if _, ok := pkg.Files[pkg.Binary]; !ok {
+		return nil, util.NewInvalidOptionError("binary", pkg.Binary)
+	}
+
+	// 包内除清单及签名外的文件须与清单完全一致
+	delete(files, ManifestFile)
+	delete(files, SignatureFile)
+	if len(files) != len(pkg.Files) {
+		return nil, fmt.Errorf("%w: files not match the manifest", ErrChecksumMismatch)
+	}
+	for name, expected := range pkg.Files {
+		sum, err := checksum(files[name])
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(hex.EncodeToString(sum), expected) {
+			return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, name)
+		}
+		if name == pkg.Binary {
+			pkg.Checksum = sum
+		}
+	}
+	return &pkg, nil
+}
+
+// extract 将插件包解压至目标目录。先解压至临时目录，成功后再替换目标目录。
+func extract(archive, dst string) error {
+	tmp := dst + ".tmp"
+	_ = os.RemoveAll(tmp)
+	if err := util.UnZip(tmp, archive); err != nil {
+		_ = os.RemoveAll(tmp)
+		return err
+	}
+	_ = os.RemoveAll(dst)
+	return os.Rename(tmp, dst)
+}
+
+func readZipFile(file *zip.File) ([]byte, error) {
+	if file == nil {
+		return nil, os.ErrNotExist
+	}
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// checksum 返回压缩文件条目内容的SHA-256校验和
+func checksum(file *zip.File) ([]byte, error) {
+	if file == nil {
+		return nil, fmt.Errorf("%w: file missing", ErrChecksumMismatch)
+	}
+	r, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, r); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// buildPackage 生成插件包。tamper非nil时在签名后篡改包内文件。
+func buildPackage(priv ed25519.PrivateKey, files map[string]string, tamper map[string]string) []byte {
+	manifest := Manifest{Name: "dell-poweredge-r730", Version: "1.0.0", Binary: "bin/provider", Files: map[string]string{}}
+	for name, content := range files {
+		sum := sha256.Sum256([]byte(content))
+		manifest.Files[name] = hex.EncodeToString(sum[:])
+	}
+	data, _ := json.Marshal(manifest)
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, data))
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	write := func(name, content string) {
+		header := zip.FileHeader{Name: name, Method: zip.Deflate}
+		header.SetMode(0755)
+		w, _ := zw.CreateHeader(&header)
+		_, _ = w.Write([]byte(content))
+	}
+	write(ManifestFile, string(data))
+	write(SignatureFile, sig)
+	for name, content := range files {
+		if v, ok := tamper[name]; ok {
+			content = v
+		}
+		write(name, content)
+	}
+	_ = zw.Close()
+	return buf.Bytes()
+}
+
+func TestFetch(t *testing.T) {
+	Convey("下载并校验插件包", t, func() {
+		pub, priv, _ := ed25519.GenerateKey(nil)
+		dir, err := ioutil.TempDir("", "fetcher")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		var archive []byte
+		var requests, failures int
+		var ranges []string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			ranges = append(ranges, r.Header.Get("Range"))
+			if r.URL.Path != "/plugins/dell-poweredge-r730_x86_64.zip" {
+				http.NotFound(w, r)
+				return
+			}
+			if failures > 0 {
+				failures--
+				http.Error(w, "busy", http.StatusServiceUnavailable)
+				return
+			}
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(archive))
+		}))
+		defer srv.Close()
+
+		f := Fetcher{Server: srv.URL + "/", Dir: dir, PublicKey: pub, Retries: 2}
+		files := map[string]string{"bin/provider": "#!/bin/sh\n", "README": "dell"}
+
+		Convey("下载成功", func() {
+			archive = buildPackage(priv, files, nil)
+			pkg, err := f.Fetch(context.Background(), "dell-poweredge-r730", "x86_64")
+			So(err, ShouldBeNil)
+			So(pkg.Dir, ShouldEqual, filepath.Join(dir, "dell-poweredge-r730_x86_64", "1.0.0"))
+			data, err := ioutil.ReadFile(pkg.BinaryPath())
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "#!/bin/sh\n")
+
+			sum := sha256.Sum256(data)
+			So(pkg.SecureConfig().Checksum, ShouldResemble, sum[:])
+			ok, err := pkg.SecureConfig().Check(pkg.BinaryPath())
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+		})
+
+		Convey("失败后重试", func() {
+			archive = buildPackage(priv, files, nil)
+			failures = 2
+			_, err := f.Fetch(context.Background(), "dell-poweredge-r730", "x86_64")
+			So(err, ShouldBeNil)
+			So(requests, ShouldEqual, 3)
+		})
+
+		Convey("断点续传", func() {
+			archive = buildPackage(priv, files, nil)
+			partial := filepath.Join(dir, ".download", "dell-poweredge-r730_x86_64.zip")
+			So(os.MkdirAll(filepath.Dir(partial), 0755), ShouldBeNil)
+			So(ioutil.WriteFile(partial, archive[:100], 0644), ShouldBeNil)
+
+			_, err := f.Fetch(context.Background(), "dell-poweredge-r730", "x86_64")
+			So(err, ShouldBeNil)
+			So(ranges, ShouldResemble, []string{"bytes=100-"})
+		})
+
+		Convey("文件校验和不符", func() {
+			archive = buildPackage(priv, files, map[string]string{"bin/provider": "#!/bin/sh\nrm -rf /\n"})
+			_, err := f.Fetch(context.Background(), "dell-poweredge-r730", "x86_64")
+			So(errors.Is(err, ErrChecksumMismatch), ShouldBeTrue)
+		})
+
+		Convey("签名无效", func() {
+			_, other, _ := ed25519.GenerateKey(nil)
+			archive = buildPackage(other, files, nil)
+			_, err := f.Fetch(context.Background(), "dell-poweredge-r730", "x86_64")
+			So(errors.Is(err, ErrInvalidSignature), ShouldBeTrue)
+			So(requests, ShouldEqual, 1)
+		})
+
+		Convey("拒绝越出解压目录的条目", func() {
+			archive = buildPackage(priv, map[string]string{"bin/provider": "#!/bin/sh\n", "../../evil": "evil"}, nil)
+			_, err := f.Fetch(context.Background(), "dell-poweredge-r730", "x86_64")
+			So(errors.Is(err, util.ErrIllegalZipEntry), ShouldBeTrue)
+		})
+
+		Convey("插件包不存在", func() {
+			_, err := f.Fetch(context.Background(), "dell-poweredge-r630", "x86_64")
+			So(errors.Is(err, ErrPackageNotFound), ShouldBeTrue)
+			So(requests, ShouldEqual, 1)
+		})
+
+		Convey("缺少公钥", func() {
+			_, err := (&Fetcher{Server: srv.URL}).Fetch(context.Background(), "dell-poweredge-r730", "x86_64")
+			So(util.IsInvalidOptionError(err), ShouldBeTrue)
+		})
+	})
+}
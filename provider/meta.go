@@ -0,0 +1,292 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/firmware"
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// MetaVersion 当前的meta.json版本
+const MetaVersion = 2
+
+const (
+	// ServiceRAID 插件服务-RAID
+	ServiceRAID = "raid"
+	// ServiceOOB 插件服务-OOB
+	ServiceOOB = "oob"
+	// ServiceBIOS 插件服务-BIOS
+	ServiceBIOS = "bios"
+	// ServiceFirmware 插件服务-固件
+	ServiceFirmware = "firmware"
+)
+
+// Meta provider元数据（meta.json）
+type Meta struct {
+	Version      int               `json:"version"`      // meta.json版本
+	Manufacturer string            `json:"manufacturer"` // 厂商
+	Models       []string          `json:"models"`       // 适用的型号匹配模式，支持path.Match通配符语法，大小写不敏感。
+	Services     []string          `json:"services"`     // 插件实现的服务。可选值：raid|oob|bios|firmware
+	RAID         []*RaidMeta       `json:"raid"`         // 支持的RAID控制器
+	BIOS         *ComponentMeta    `json:"bios"`         // BIOS
+	OOB          *ComponentMeta    `json:"oob"`          // OOB
+	MinFirmware  map[string]string `json:"min_firmware"` // 各类部件要求的最低固件版本，key为部件类型。
+}
+
+// RaidMeta RAID控制器元数据
+type RaidMeta struct {
+	Manufacturer string  `json:"manufacturer"` // RAID控制器厂商
+	Model        string  `json:"model"`        // RAID控制器型号
+	Tools        []*Tool `json:"tools"`        // 配置工具
+}
+
+// ComponentMeta 部件元数据
+type ComponentMeta struct {
+	Version string  `json:"version"` // 部件版本
+	Tools   []*Tool `json:"tools"`   // 配置工具
+}
+
+// Tool 硬件配置工具安装包
+type Tool struct {
+	Name         string `json:"name"`          // 工具名称
+	Version      string `json:"version"`       // 工具版本
+	Arch         string `json:"arch"`          // 适用的硬件架构。为空或*表示任意架构。
+	OS           string `json:"os"`            // 适用的操作系统，如centos、centos7。为空或*表示任意操作系统。
+	DownloadAddr string `json:"download_addr"` // 安装包下载地址
+}
+
+// Match 返回工具是否适用于指定的硬件架构及操作系统（如centos7）
+func (t *Tool) Match(arch, os string) bool {
+	if t.Arch != "" && t.Arch != Any && !strings.EqualFold(t.Arch, arch) {
+		return false
+	}
+	return t.OS == "" || t.OS == Any || strings.HasPrefix(strings.ToLower(os), strings.ToLower(t.OS))
+}
+
+// MatchModel 返回provider是否适用于指定型号的设备
+func (m *Meta) MatchModel(model string) bool {
+	model = strings.ToLower(strings.TrimSpace(model))
+	for _, pattern := range m.Models {
+		if ok, _ := path.Match(strings.ToLower(pattern), model); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Implements 返回provider是否实现了指定的插件服务
+func (m *Meta) Implements(service string) bool {
+	for _, s := range m.Services {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+// Tools 返回适用于指定硬件架构及操作系统的所有配置工具。同一部件的同名工具仅返回首个匹配项。
+func (m *Meta) Tools(arch, os string) (tools []*Tool) {
+	var groups [][]*Tool
+	for _, ctrl := range m.RAID {
+		groups = append(groups, ctrl.Tools)
+	}
+	for _, comp := range []*ComponentMeta{m.BIOS, m.OOB} {
+		if comp != nil {
+			groups = append(groups, comp.Tools)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		for _, tool := range group {
+			if !tool.Match(arch, os) || seen[tool.Name] {
+				continue
+			}
+			seen[tool.Name] = true
+			tools = append(tools, tool)
+		}
+	}
+	return tools
+}
+
+// LoadMeta 读取并校验meta.json，旧版本的文件将被自动升级至当前版本。
+func LoadMeta(file string) (*Meta, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMeta(data)
+}
+
+// ParseMeta 解析并校验meta.json内容，旧版本的内容将被自动升级至当前版本。
+func ParseMeta(data []byte) (*Meta, error) {
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, err
+	}
+
+	var meta *Meta
+	switch header.Version {
+	case 0, 1: // 初始版本的meta.json不含version字段
+		var v1 metaV1
+		if err := decodeStrict(data, &v1); err != nil {
+			return nil, err
+		}
+		meta = v1.upgrade()
+	case MetaVersion:
+		meta = new(Meta)
+		if err := decodeStrict(data, meta); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, util.NewInvalidOptionError("version", fmt.Sprint(header.Version))
+	}
+
+	if err := meta.Validate(); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// Validate 校验元数据。元数据无效时返回util.InvalidOptionError，其选项名为无效字段的路径。
+func (m *Meta) Validate() error {
+	if m.Version != MetaVersion {
+		return util.NewInvalidOptionError("version", fmt.Sprint(m.Version))
+	}
+	if strings.TrimSpace(m.Manufacturer) == "" {
+		return util.NewInvalidOptionError("manufacturer")
+	}
+	if len(m.Models) == 0 {
+		return util.NewInvalidOptionError("models")
+	}
+	for i, pattern := range m.Models {
+		if _, err := path.Match(pattern, ""); err != nil || strings.TrimSpace(pattern) == "" {
+			return util.NewInvalidOptionError(fmt.Sprintf("models[%d]", i), pattern)
+		}
+	}
+	if len(m.Services) == 0 {
+		return util.NewInvalidOptionError("services")
+	}
+	for i, service := range m.Services {
+		switch service {
+		case ServiceRAID, ServiceOOB, ServiceBIOS, ServiceFirmware:
+		default:
+			return util.NewInvalidOptionError(fmt.Sprintf("services[%d]", i), service)
+		}
+	}
+	for i, ctrl := range m.RAID {
+		field := fmt.Sprintf("raid[%d]", i)
+		if ctrl == nil || ctrl.Model == "" {
+			return util.NewInvalidOptionError(field + ".model")
+		}
+		if err := validateTools(field, ctrl.Tools); err != nil {
+			return err
+		}
+	}
+	if m.BIOS != nil {
+		if err := validateTools("bios", m.BIOS.Tools); err != nil {
+			return err
+		}
+	}
+	if m.OOB != nil {
+		if err := validateTools("oob", m.OOB.Tools); err != nil {
+			return err
+		}
+	}
+	for typ, version := range m.MinFirmware {
+		switch typ {
+		case firmware.BIOS, firmware.BMC, firmware.RAID, firmware.NIC, firmware.Backplane:
+		default:
+			return util.NewInvalidOptionError("min_firmware", typ)
+		}
+		if strings.TrimSpace(version) == "" {
+			return util.NewInvalidOptionError("min_firmware."+typ, version)
+		}
+	}
+	return nil
+}
+
+func validateTools(field string, tools []*Tool) error {
+	for i, tool := range tools {
+		if tool == nil || strings.TrimSpace(tool.Name) == "" {
+			return util.NewInvalidOptionError(fmt.Sprintf("%s.tools[%d].name", field, i))
+		}
+	}
+	return nil
+}
+
+// decodeStrict 解析JSON，存在未知字段时返回错误。
+func decodeStrict(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(v)
+}
+
+// metaV1 初始版本的meta.json，仅支持单个RAID控制器。
+type metaV1 struct {
+	Version      int         `json:"version"`
+	Manufacturer string      `json:"manufacturer"`
+	Model        string      `json:"model"`
+	Raid         *hardwareV1 `json:"raid"`
+	Bios         *hardwareV1 `json:"bios"`
+	Oob          *hardwareV1 `json:"oob"`
+}
+
+type hardwareV1 struct {
+	Manufacturer     string      `json:"manufacturer"`
+	Model            string      `json:"model"`
+	Version          string      `json:"version"`
+	Raid             *hardwareV1 `json:"raid"` // 历史遗留字段，忽略。
+	Tool             string      `json:"tool"`
+	ToolVersion      string      `json:"tool_version"`
+	ToolDownloadAddr string      `json:"tool_download_addr"`
+}
+
+func (hw *hardwareV1) tools() []*Tool {
+	if hw.Tool == "" {
+		return nil
+	}
+	return []*Tool{{Name: hw.Tool, Version: hw.ToolVersion, DownloadAddr: hw.ToolDownloadAddr}}
+}
+
+// upgradeServices 升级旧版本meta.json时可声明的插件服务，须与provider插件（plugin/plugin.go）实际提供的服务一致。
+var upgradeServices = map[string]bool{
+	ServiceRAID: true,
+	ServiceOOB:  true,
+}
+
+// upgrade 升级至当前版本。旧版本未声明插件服务，以指定了配置工具的部件推断，且仅声明插件实际提供的服务。
+func (v1 *metaV1) upgrade() *Meta {
+	meta := Meta{
+		Version:      MetaVersion,
+		Manufacturer: v1.Manufacturer,
+	}
+	if v1.Model != "" {
+		meta.Models = []string{v1.Model}
+	}
+	declare := func(service string, hw *hardwareV1) {
+		if hw.Tool != "" && upgradeServices[service] {
+			meta.Services = append(meta.Services, service)
+		}
+	}
+	if v1.Raid != nil {
+		declare(ServiceRAID, v1.Raid)
+		meta.RAID = []*RaidMeta{{Manufacturer: v1.Raid.Manufacturer, Model: v1.Raid.Model, Tools: v1.Raid.tools()}}
+	}
+	if v1.Bios != nil {
+		declare(ServiceBIOS, v1.Bios)
+		meta.BIOS = &ComponentMeta{Version: v1.Bios.Version, Tools: v1.Bios.tools()}
+	}
+	if v1.Oob != nil {
+		declare(ServiceOOB, v1.Oob)
+		meta.OOB = &ComponentMeta{Version: v1.Oob.Version, Tools: v1.Oob.tools()}
+	}
+	return &meta
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLoadMeta(t *testing.T) {
+	Convey("加载meta.json", t, func() {
+		Convey("当前版本", func() {
+			meta, err := LoadMeta("testdata/meta_v2.json")
+			So(err, ShouldBeNil)
+			So(meta.MatchModel("poweredge r730XD"), ShouldBeTrue)
+			So(meta.MatchModel("PowerEdge R740"), ShouldBeFalse)
+			So(meta.Implements(ServiceRAID), ShouldBeTrue)
+			So(meta.Implements(ServiceBIOS), ShouldBeFalse)
+			So(meta.MinFirmware, ShouldResemble, map[string]string{"BIOS": "2.12.1", "BMC": "2.81.81.81"})
+
+			tools := meta.Tools("aarch64", "centos7")
+			So(len(tools), ShouldEqual, 2)
+			So(tools[0].DownloadAddr, ShouldEqual, "http://xxx/storcli.aarch64.rpm")
+			So(tools[1].Name, ShouldEqual, "ipmitool")
+			So(len(meta.Tools("x86_64", "ubuntu20")), ShouldEqual, 1)
+		})
+
+		Convey("旧版本自动升级", func() {
+			meta, err := LoadMeta("testdata/meta_v1.json")
+			So(err, ShouldBeNil)
+			So(meta.Version, ShouldEqual, MetaVersion)
+			So(meta.Models, ShouldResemble, []string{"PowerEdge R730"})
+			So(meta.Services, ShouldResemble, []string{ServiceRAID}) // 未指定配置工具的部件不声明服务
+			So(len(meta.RAID), ShouldEqual, 1)
+			So(meta.RAID[0].Tools, ShouldResemble, []*Tool{{Name: "storcli", Version: "1.0.27", DownloadAddr: "http://xxx/storcli.rpm"}})
+			So(meta.OOB.Tools, ShouldBeEmpty)
+
+			meta, err = ParseMeta([]byte(`{"manufacturer":"Dell","model":"R730","bios":{"tool":"racadm"},"oob":{"tool":"ipmitool"}}`))
+			So(err, ShouldBeNil)
+			So(meta.Services, ShouldResemble, []string{ServiceOOB}) // 插件未提供BIOS服务
+			So(meta.BIOS.Tools[0].Name, ShouldEqual, "racadm")
+		})
+
+		Convey("校验失败", func() {
+			cases := map[string]string{
+				`{"version":2,"manufacturer":"Dell","models":["R730"],"services":["raid"],"unknown":1}`: "",
+				`{"version":3}`: "version",
+				`{"version":2,"models":["R730"],"services":["raid"]}`:                                                  "manufacturer",
+				`{"version":2,"manufacturer":"Dell","models":["R[730"],"services":["raid"]}`:                           "models[0]",
+				`{"version":2,"manufacturer":"Dell","models":["R730"],"services":["storage"]}`:                         "services[0]",
+				`{"version":2,"manufacturer":"Dell","models":["R730"],"services":["raid"],"raid":[{"tools":[]}]}`:      "raid[0].model",
+				`{"version":2,"manufacturer":"Dell","models":["R730"],"services":["oob"],"oob":{"tools":[{}]}}`:        "oob.tools[0].name",
+				`{"version":2,"manufacturer":"Dell","models":["R730"],"services":["raid"],"min_firmware":{"CPU":"1"}}`: "min_firmware",
+			}
+			for data, field := range cases {
+				_, err := ParseMeta([]byte(data))
+				So(err, ShouldNotBeNil)
+				if field != "" {
+					So(util.IsInvalidOptionError(err), ShouldBeTrue)
+					So(err.(*util.InvalidOptionError).Name, ShouldEqual, field)
+				}
+			}
+		})
+	})
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"path"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// Any 匹配任意厂商、型号或硬件架构的通配符
+const Any = "*"
+
+// Provider 硬件配置插件（provider）及其适用的设备范围
+type Provider struct {
+	Manufacturer string `json:"manufacturer"` // 厂商。*表示任意厂商。
+	Model        string `json:"model"`        // 型号匹配模式，支持path.Match通配符语法，大小写不敏感。*表示任意型号。
+	Arch         string `json:"arch"`         // 硬件架构。为空或*表示任意架构。
+	Binary       string `json:"binary"`       // 插件可执行文件名，相对于插件目录。
+}
+
+// Name 返回设备对应的约定插件名称，如"dell-poweredge-r730"。
+func Name(manufacturer, model string) string {
+	name := strings.ToLower(util.ManufacturerName(manufacturer) + " " + strings.TrimSpace(model))
+	return strings.Join(strings.Fields(name), "-")
+}
+
+// score 返回插件与设备的匹配程度，值越大匹配越精确。不匹配时返回-1。
+func (p *Provider) score(manufacturer, model, arch string) int {
+	var score int
+	switch {
+	case p.Manufacturer == Any:
+	case strings.EqualFold(util.ManufacturerName(p.Manufacturer), manufacturer):
+		score += 100
+	default:
+		return -1
+	}
+
+	pattern := strings.ToLower(strings.TrimSpace(p.Model))
+	switch {
+	case pattern == Any:
+	case pattern == model:
+		score += 20
+	default:
+		if ok, _ := path.Match(pattern, model); !ok {
+			return -1
+		}
+		score += 10
+	}
+
+	switch {
+	case p.Arch == "" || p.Arch == Any:
+	case strings.EqualFold(p.Arch, arch):
+		score++
+	default:
+		return -1
+	}
+	return score
+}
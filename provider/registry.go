@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// ErrProviderNotFound 插件目录中不存在与设备匹配的插件
+var ErrProviderNotFound = errors.New("provider not found")
+
+// Registry 插件注册表，根据设备的厂商、型号及硬件架构在插件目录中查找插件。
+type Registry struct {
+	dir       string
+	mux       sync.Mutex
+	providers []*Provider
+}
+
+// NewRegistry 返回以dir为插件目录的插件注册表
+func NewRegistry(dir string) *Registry {
+	return &Registry{dir: dir}
+}
+
+// Register 注册插件
+func (r *Registry) Register(providers ...*Provider) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	for _, p := range providers {
+		if p == nil || p.Binary == "" {
+			panic("provider: Register provider without binary")
+		}
+		r.providers = append(r.providers, p)
+	}
+}
+
+// LoadFile 注册JSON文件中描述的插件列表
+func (r *Registry) LoadFile(file string) error {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var providers []*Provider
+	if err = json.Unmarshal(data, &providers); err != nil {
+		return err
+	}
+	r.Register(providers...)
+	return nil
+}
+
+// Lookup 返回与设备匹配的插件可执行文件路径。
+// 按匹配精确程度依次选用插件，插件目录中不存在可执行文件的插件将被跳过。
+// 按约定命名（见Name）的插件视为已注册的适用于该厂商、型号全部硬件架构的插件，
+// 因此指定了硬件架构的同型号插件优先于约定命名的插件，约定命名的插件优先于通配的插件。
+func (r *Registry) Lookup(manufacturer, model, arch string) (string, error) {
+	for _, p := range r.Candidates(manufacturer, model, arch) {
+		if name, ok := r.Executable(p); ok {
+			return name, nil
+		}
+	}
+	return "", ErrProviderNotFound
+}
+
+// Candidates 返回与设备匹配的全部插件（含约定命名的插件），按Lookup的选用顺序排列，不检查插件目录中是否存在可执行文件。
+// 用于从服务端下载插件等需逐个尝试的场景。
+func (r *Registry) Candidates(manufacturer, model, arch string) []*Provider {
+	manufacturer = util.ManufacturerName(manufacturer)
+	model = strings.ToLower(strings.TrimSpace(model))
+
+	conventional := &Provider{Manufacturer: manufacturer, Model: model, Binary: Name(manufacturer, model)}
+	return r.match(manufacturer, model, arch, conventional)
+}
+
+// Executable 返回插件在插件目录中的可执行文件绝对路径，文件不存在或不可执行时ok为false。
+func (r *Registry) Executable(p *Provider) (name string, ok bool) {
+	name = filepath.Join(r.dir, p.Binary)
+	if !isExecutable(name) {
+		return "", false
+	}
+	// 返回绝对路径，避免exec.Command在PATH中查找同名命令。
+	abs, err := filepath.Abs(name)
+	if err != nil {
+		return "", false
+	}
+	return abs, true
+}
+
+// match 返回与设备匹配的插件，按匹配精确程度由高到低排列，精确程度相同时已注册的插件在前。
+// extra为参与匹配的额外插件。
+func (r *Registry) match(manufacturer, model, arch string, extra ...*Provider) []*Provider {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	type scored struct {
+		provider *Provider
+		score    int
+	}
+	var items []scored
+	for _, p := range append(append([]*Provider(nil), r.providers...), extra...) {
+		if score := p.score(manufacturer, model, arch); score >= 0 {
+			items = append(items, scored{provider: p, score: score})
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].score > items[j].score
+	})
+
+	providers := make([]*Provider, 0, len(items))
+	for i := range items {
+		providers = append(providers, items[i].provider)
+	}
+	return providers
+}
+
+// isExecutable 返回文件是否是可执行的普通文件
+func isExecutable(name string) bool {
+	fi, err := os.Stat(name)
+	if err != nil {
+		return false
+	}
+	return fi.Mode().IsRegular() && fi.Mode().Perm()&0111 != 0
+}
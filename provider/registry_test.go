@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestName(t *testing.T) {
+	Convey("约定插件名称", t, func() {
+		So(Name("Dell Inc.", "PowerEdge R730"), ShouldEqual, "dell-poweredge-r730")
+		So(Name(" Inspur ", "NF5280M5 "), ShouldEqual, "inspur-nf5280m5")
+	})
+}
+
+func TestLookup(t *testing.T) {
+	Convey("查找与设备匹配的插件", t, func() {
+		dir, err := ioutil.TempDir("", "provider")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		install := func(binary string) {
+			So(ioutil.WriteFile(filepath.Join(dir, binary), []byte("#!/bin/sh\n"), 0755), ShouldBeNil)
+		}
+		install("generic-ipmi")
+		install("dell-14g")
+		install("dell-poweredge-r730")
+		install("dell-r730-arm")
+		So(ioutil.WriteFile(filepath.Join(dir, "dell-idrac"), nil, 0644), ShouldBeNil)
+
+		r := NewRegistry(dir)
+		r.Register(
+			&Provider{Manufacturer: Any, Model: Any, Binary: "generic-ipmi"},
+			&Provider{Manufacturer: "Dell", Model: Any, Binary: "dell-idrac"},
+			&Provider{Manufacturer: "Dell", Model: "PowerEdge R?40", Arch: "x86_64", Binary: "dell-14g"},
+			&Provider{Manufacturer: "Huawei", Model: Any, Binary: "huawei"},
+			&Provider{Manufacturer: "Dell", Model: "PowerEdge R730", Arch: "aarch64", Binary: "dell-r730-arm"},
+		)
+
+		Convey("按约定命名的插件优先于通配的插件", func() {
+			name, err := r.Lookup("Dell Inc.", "PowerEdge R730", "x86_64")
+			So(err, ShouldBeNil)
+			So(name, ShouldEqual, filepath.Join(dir, "dell-poweredge-r730"))
+		})
+
+		Convey("指定硬件架构的同型号插件优先于约定命名的插件", func() {
+			name, err := r.Lookup("Dell Inc.", "PowerEdge R730", "aarch64")
+			So(err, ShouldBeNil)
+			So(name, ShouldEqual, filepath.Join(dir, "dell-r730-arm"))
+		})
+
+		Convey("型号通配符匹配", func() {
+			name, err := r.Lookup("Dell Inc.", "PowerEdge R740", "x86_64")
+			So(err, ShouldBeNil)
+			So(name, ShouldEqual, filepath.Join(dir, "dell-14g"))
+		})
+
+		Convey("硬件架构不匹配时回退", func() {
+			name, err := r.Lookup("Dell Inc.", "PowerEdge R740", "aarch64")
+			So(err, ShouldBeNil)
+			So(name, ShouldEqual, filepath.Join(dir, "generic-ipmi"))
+		})
+
+		Convey("插件不可执行时回退", func() {
+			name, err := r.Lookup("DELL", "PowerEdge R630", "x86_64")
+			So(err, ShouldBeNil)
+			So(name, ShouldEqual, filepath.Join(dir, "generic-ipmi"))
+		})
+
+		Convey("全部候选插件", func() {
+			var binaries []string
+			for _, p := range r.Candidates("Dell Inc.", "PowerEdge R630", "x86_64") {
+				binaries = append(binaries, p.Binary)
+			}
+			So(binaries, ShouldResemble, []string{"dell-poweredge-r630", "dell-idrac", "generic-ipmi"})
+		})
+
+		Convey("插件不存在", func() {
+			_, err := NewRegistry(dir).Lookup("Huawei", "2288H V5", "x86_64")
+			So(err, ShouldEqual, ErrProviderNotFound)
+		})
+	})
+}
@@ -0,0 +1,393 @@
+// Package avago 基于storcli实现的AVAGO(LSI) MegaRAID控制器RAID插件服务。
+package avago
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/raid"
+	"github.com/licairong/cloudboot-provider-framework/shared"
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+var _ shared.ContextRaidService = (*RaidPlugin)(nil)
+
+// tool RAID配置工具
+const tool = "/opt/MegaRAID/storcli/storcli64"
+
+// Manufacturer RAID控制器厂商
+const Manufacturer = "AVAGO"
+
+var (
+	// driveRe 物理驱动器标识，格式为'enclosure:slot'，如'252:0'。
+	driveRe = regexp.MustCompile(`^\d+:\d+$`)
+	// nameRe 逻辑驱动器名称
+	nameRe = regexp.MustCompile(`^[\w-]{0,15}$`)
+)
+
+// RaidPlugin storcli实现的RAID插件服务
+type RaidPlugin struct {
+	executor util.Executor
+}
+
+// NewRaidPlugin 返回RAID插件服务实例。executor为nil时使用默认的Bash执行器。
+func NewRaidPlugin(executor util.Executor) *RaidPlugin {
+	if executor == nil {
+		executor = util.NewBash()
+	}
+	return &RaidPlugin{executor: executor}
+}
+
+// WithContext 返回绑定了context的插件服务副本
+func (p *RaidPlugin) WithContext(ctx context.Context) shared.RaidService {
+	cp := *p
+	cp.executor = util.WithContext(p.executor, ctx)
+	return &cp
+}
+
+// response storcli以J参数输出的JSON结果
+type response struct {
+	Controllers []struct {
+		CommandStatus struct {
+			Status      string `json:"Status"`
+			Description string `json:"Description"`
+			ErrorMsg    string `json:"ErrorMsg"`
+		} `json:"Command Status"`
+		ResponseData json.RawMessage `json:"Response Data"`
+	} `json:"Controllers"`
+}
+
+// controllerData 'storcli /cx show J'的响应数据
+type controllerData struct {
+	ProductName        string `json:"Product Name"`
+	SerialNumber       string `json:"Serial Number"`
+	PCIAddress         string `json:"PCI Address"`
+	FWVersion          string `json:"FW Version"`
+	CurrentPersonality string `json:"Current Personality"`
+	VDList             []struct {
+		DGVD  string `json:"DG/VD"`
+		Type  string `json:"TYPE"`
+		State string `json:"State"`
+		Size  string `json:"Size"`
+		Name  string `json:"Name"`
+	} `json:"VD LIST"`
+	PDList []struct {
+		EIDSlt string `json:"EID:Slt"`
+		State  string `json:"State"`
+		Size   string `json:"Size"`
+		Intf   string `json:"Intf"`
+		Med    string `json:"Med"`
+		Model  string `json:"Model"`
+	} `json:"PD LIST"`
+}
+
+// exec 执行storcli命令。命令执行失败或storcli返回失败状态时返回error，否则返回响应数据。
+func (p *RaidPlugin) exec(args ...string) (json.RawMessage, error) {
+	output, err := p.executor.Exec(nil, tool, append(args, "J")...)
+	if err != nil {
+		return nil, err
+	}
+	var resp response
+	if err = json.Unmarshal(output, &resp); err != nil {
+		return nil, fmt.Errorf("storcli %s: %s", strings.Join(args, " "), err)
+	}
+	if len(resp.Controllers) == 0 {
+		return nil, fmt.Errorf("storcli %s: empty response", strings.Join(args, " "))
+	}
+	status := resp.Controllers[0].CommandStatus
+	if status.Status != "Success" {
+		msg := status.ErrorMsg
+		if msg == "" {
+			msg = status.Description
+		}
+		return nil, fmt.Errorf("storcli %s: %s", strings.Join(args, " "), msg)
+	}
+	return resp.Controllers[0].ResponseData, nil
+}
+
+// ctrl 校验并返回控制器参数，如'/c0'。
+func ctrl(ctrlID string) (string, error) {
+	if _, err := strconv.Atoi(ctrlID); err != nil {
+		return "", util.NewInvalidOptionError("ctrl_id", ctrlID)
+	}
+	return "/c" + ctrlID, nil
+}
+
+// show 返回指定RAID控制器的概要信息
+func (p *RaidPlugin) show(ctrlID string) (*controllerData, error) {
+	c, err := ctrl(ctrlID)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := p.exec(c, "show")
+	if err != nil {
+		return nil, err
+	}
+	var data controllerData
+	if err = json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// RAID 返回当前设备的RAID信息
+func (p *RaidPlugin) RAID() (*collector.RAID, error) {
+	items, err := p.Controllers()
+	if err != nil {
+		return nil, err
+	}
+	return &collector.RAID{Items: items}, nil
+}
+
+// Controllers 返回RAID控制器列表
+func (p *RaidPlugin) Controllers() ([]*collector.RaidController, error) {
+	raw, err := p.exec("show", "ctrlcount")
+	if err != nil {
+		return nil, err
+	}
+	var count struct {
+		ControllerCount int `json:"Controller Count"`
+	}
+	if err = json.Unmarshal(raw, &count); err != nil {
+		return nil, err
+	}
+	items := make([]*collector.RaidController, 0, count.ControllerCount)
+	for i := 0; i < count.ControllerCount; i++ {
+		data, err := p.show(strconv.Itoa(i))
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &collector.RaidController{
+			ID:              strconv.Itoa(i),
+			Manufacturer:    Manufacturer,
+			Model:           strings.TrimSpace(data.ProductName),
+			FirmwareVersion: strings.TrimSpace(data.FWVersion),
+			SerialNumber:    strings.TrimSpace(data.SerialNumber),
+			PCIAddress:      strings.TrimSpace(data.PCIAddress),
+			Mode:            mode(data.CurrentPersonality),
+		})
+	}
+	return items, nil
+}
+
+// mode 将storcli的控制器personality转换为RAID|JBOD
+func mode(personality string) string {
+	switch p := strings.ToUpper(strings.TrimSpace(personality)); {
+	case strings.HasPrefix(p, "JBOD"), strings.HasPrefix(p, "HBA"):
+		return raid.JBODMode
+	case p == "":
+		return ""
+	}
+	return raid.RAIDMode
+}
+
+// PhysicalDrives 返回指定RAID控制器下的物理驱动器列表
+func (p *RaidPlugin) PhysicalDrives(ctrlID string) ([]*collector.PhysicalDrive, error) {
+	data, err := p.show(ctrlID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*collector.PhysicalDrive, 0, len(data.PDList))
+	for _, pd := range data.PDList {
+		var slot string
+		if arr := strings.SplitN(pd.EIDSlt, ":", 2); len(arr) == 2 {
+			slot = arr[1]
+		}
+		items = append(items, &collector.PhysicalDrive{
+			Location:      pd.EIDSlt,
+			Slot:          slot,
+			Model:         strings.TrimSpace(pd.Model),
+			BusType:       pd.Intf,
+			MediaType:     pd.Med,
+			Size:          parseSize(pd.Size),
+			FirmwareState: pd.State,
+			ControllerID:  ctrlID,
+		})
+	}
+	return items, nil
+}
+
+// parseSize 将storcli输出的容量（如'558.406 GB'）转换为字节数，无法解析时返回0。
+func parseSize(s string) int64 {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return 0
+	}
+	v, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0
+	}
+	units := map[string]float64{"KB": 1 << 10, "MB": 1 << 20, "GB": 1 << 30, "TB": 1 << 40}
+	return int64(v * units[strings.ToUpper(fields[1])])
+}
+
+// Clear 清除指定RAID控制器的配置，包括全部逻辑驱动器及外来配置。
+func (p *RaidPlugin) Clear(ctrlID string) error {
+	c, err := ctrl(ctrlID)
+	if err != nil {
+		return err
+	}
+	if _, err = p.exec(c+"/vall", "del", "force"); err != nil {
+		return err
+	}
+	_, err = p.exec(c+"/fall", "del")
+	return err
+}
+
+// CreateLogicalDrive 在指定RAID控制器上创建逻辑驱动器（阵列）
+func (p *RaidPlugin) CreateLogicalDrive(ctrlID string, ld *raid.LogicalDrive) error {
+	c, err := ctrl(ctrlID)
+	if err != nil {
+		return err
+	}
+	if ld == nil {
+		return errors.New("logical drive is nil")
+	}
+	if err = ld.Validate(); err != nil {
+		return err
+	}
+	if !nameRe.MatchString(ld.Name) {
+		return util.NewInvalidOptionError("name", ld.Name)
+	}
+	for _, d := range ld.Drives {
+		if !driveRe.MatchString(d) {
+			return util.NewInvalidOptionError("drives", d)
+		}
+	}
+
+	// storcli64 /c0 add vd type=raid10 size=all drives=134:0,134:1 pdperarray=2
+	level := strings.ToLower(ld.Level)
+	args := []string{c, "add", "vd", "type=" + level, "size=all", "drives=" + strings.Join(ld.Drives, ",")}
+	if ld.Name != "" {
+		args = append(args, "name="+ld.Name)
+	}
+	switch level {
+	case raid.RAID10, raid.RAID50, raid.RAID60: // 固定为两个span
+		args = append(args, "pdperarray="+strconv.Itoa(len(ld.Drives)/2))
+	}
+	if ld.StripSize > 0 {
+		args = append(args, "strip="+strconv.Itoa(ld.StripSize))
+	}
+	for _, policy := range []string{ld.ReadPolicy, ld.WritePolicy, ld.IOPolicy} {
+		if policy != "" {
+			args = append(args, strings.ToLower(policy))
+		}
+	}
+	_, err = p.exec(args...)
+	return err
+}
+
+// DeleteLogicalDrive 删除指定RAID控制器上的逻辑驱动器
+func (p *RaidPlugin) DeleteLogicalDrive(ctrlID, ldID string) error {
+	c, err := ctrl(ctrlID)
+	if err != nil {
+		return err
+	}
+	if _, err = strconv.Atoi(ldID); err != nil {
+		return util.NewInvalidOptionError("ld_id", ldID)
+	}
+	_, err = p.exec(c+"/v"+ldID, "del", "force")
+	return err
+}
+
+// SetGlobalHotspares 将物理驱动器设置为指定RAID控制器的全局热备盘
+func (p *RaidPlugin) SetGlobalHotspares(ctrlID string, drives []string) error {
+	c, err := ctrl(ctrlID)
+	if err != nil {
+		return err
+	}
+	for _, d := range drives {
+		if !driveRe.MatchString(d) {
+			return util.NewInvalidOptionError("drives", d)
+		}
+	}
+	for _, d := range drives {
+		arr := strings.SplitN(d, ":", 2)
+		// storcli64 /cx/ex/sx add hotsparedrive
+		if _, err = p.exec(fmt.Sprintf("%s/e%s/s%s", c, arr[0], arr[1]), "add", "hotsparedrive"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetControllerMode 切换RAID控制器模式。可选值：RAID|JBOD
+func (p *RaidPlugin) SetControllerMode(ctrlID, mode string) error {
+	c, err := ctrl(ctrlID)
+	if err != nil {
+		return err
+	}
+	if err = raid.ValidateMode(mode); err != nil {
+		return err
+	}
+	jbod := "off"
+	if mode == raid.JBODMode {
+		jbod = "on"
+	}
+	_, err = p.exec(c, "set", "jbod="+jbod)
+	return err
+}
+
+// InitDisk 初始化指定RAID控制器下的逻辑驱动器
+func (p *RaidPlugin) InitDisk(ctrlID string) error {
+	c, err := ctrl(ctrlID)
+	if err != nil {
+		return err
+	}
+	_, err = p.exec(c+"/vall", "start", "init", "force")
+	return err
+}
+
+// PostCheck RAID配置实施后置检查
+func (p *RaidPlugin) PostCheck(sett *raid.Setting) (items []*util.CheckingItem) {
+	if sett == nil {
+		return nil
+	}
+	for _, cs := range sett.Controllers {
+		data, err := p.show(cs.CtrlID)
+		if err != nil {
+			items = append(items, &util.CheckingItem{
+				Title:   fmt.Sprintf("Controller %s", cs.CtrlID),
+				Matched: util.MatchedUnknown,
+				Error:   err.Error(),
+			})
+			continue
+		}
+		if cs.Mode != "" {
+			items = append(items, util.NewCheckingHelper(fmt.Sprintf("Controller %s Mode", cs.CtrlID), cs.Mode, mode(data.CurrentPersonality)).Do())
+		}
+		if len(cs.LogicalDrives) > 0 {
+			expected := make([]string, 0, len(cs.LogicalDrives))
+			for _, ld := range cs.LogicalDrives {
+				expected = append(expected, strings.ToLower(ld.Level))
+			}
+			actual := make([]string, 0, len(data.VDList))
+			for _, vd := range data.VDList {
+				actual = append(actual, strings.ToLower(vd.Type))
+			}
+			sort.Strings(expected)
+			sort.Strings(actual)
+			items = append(items, util.NewCheckingHelper(fmt.Sprintf("Controller %s Logical Drives", cs.CtrlID), strings.Join(expected, ","), strings.Join(actual, ",")).Do())
+		}
+		if len(cs.Hotspares) > 0 {
+			var actual []string
+			for _, pd := range data.PDList {
+				if pd.State == "GHS" {
+					actual = append(actual, pd.EIDSlt)
+				}
+			}
+			expected := append([]string(nil), cs.Hotspares...)
+			sort.Strings(expected)
+			sort.Strings(actual)
+			items = append(items, util.NewCheckingHelper(fmt.Sprintf("Controller %s Hotspares", cs.CtrlID), strings.Join(expected, ","), strings.Join(actual, ",")).Do())
+		}
+	}
+	return items
+}
@@ -0,0 +1,128 @@
+package avago
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/raid"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeExecutor 以testdata中的文件内容作为storcli输出的执行器，并记录执行过的命令。
+type fakeExecutor struct {
+	util.Executor
+	outputs map[string]string
+	cmds    []string
+}
+
+func (e *fakeExecutor) Exec(opts *util.ExecutionOptions, cmd string, args ...string) ([]byte, error) {
+	cmdArgs := strings.Join(args, " ")
+	e.cmds = append(e.cmds, cmdArgs)
+	file, ok := e.outputs[cmdArgs]
+	if !ok {
+		file = "storcli_success.json"
+	}
+	if file == "" {
+		return nil, errors.New("exec error")
+	}
+	return ioutil.ReadFile("testdata/" + file)
+}
+
+func newTestPlugin() (*RaidPlugin, *fakeExecutor) {
+	e := &fakeExecutor{outputs: map[string]string{
+		"show ctrlcount J": "storcli_show_ctrlcount.json",
+		"/c0 show J":       "storcli_c0_show.json",
+		"/c1 show J":       "", // 控制器不存在
+	}}
+	return NewRaidPlugin(e), e
+}
+
+func TestRaidPlugin(t *testing.T) {
+	Convey("storcli RAID插件服务", t, func() {
+		p, e := newTestPlugin()
+
+		Convey("RAID控制器", func() {
+			info, err := p.RAID()
+			So(err, ShouldBeNil)
+			So(len(info.Items), ShouldEqual, 1)
+			ctrl := info.Items[0]
+			So(ctrl.ID, ShouldEqual, "0")
+			So(ctrl.Model, ShouldEqual, "AVAGO MegaRAID SAS 9361-8i")
+			So(ctrl.FirmwareVersion, ShouldEqual, "4.680.00-8249")
+			So(ctrl.SerialNumber, ShouldEqual, "SK81234567")
+			So(ctrl.PCIAddress, ShouldEqual, "00:03:00:00")
+			So(ctrl.Mode, ShouldEqual, raid.RAIDMode)
+		})
+
+		Convey("物理驱动器", func() {
+			drives, err := p.PhysicalDrives("0")
+			So(err, ShouldBeNil)
+			So(len(drives), ShouldEqual, 3)
+			So(drives[0].Location, ShouldEqual, "252:0")
+			So(drives[0].Slot, ShouldEqual, "0")
+			So(drives[0].Model, ShouldEqual, "ST600MM0208")
+			So(drives[0].Size, ShouldEqual, 599583876972)
+			So(drives[2].MediaType, ShouldEqual, "SSD")
+			So(drives[2].FirmwareState, ShouldEqual, "UGood")
+
+			_, err = p.PhysicalDrives("0;reboot")
+			So(util.IsInvalidOptionError(err), ShouldBeTrue)
+		})
+
+		Convey("创建逻辑驱动器", func() {
+			So(p.CreateLogicalDrive("0", &raid.LogicalDrive{
+				Name:        "data",
+				Level:       "RAID10",
+				Drives:      []string{"252:0", "252:1", "252:2", "252:3"},
+				StripSize:   256,
+				WritePolicy: raid.WriteBack,
+			}), ShouldBeNil)
+			So(e.cmds, ShouldResemble, []string{"/c0 add vd type=raid10 size=all drives=252:0,252:1,252:2,252:3 name=data pdperarray=2 strip=256 wb J"})
+
+			err := p.CreateLogicalDrive("0", &raid.LogicalDrive{Level: raid.RAID1, Drives: []string{"252:0", "252:1 && reboot"}})
+			So(util.IsInvalidOptionError(err), ShouldBeTrue)
+
+			e.outputs["/c0 add vd type=raid0 size=all drives=252:0 J"] = "storcli_failure.json"
+			err = p.CreateLogicalDrive("0", &raid.LogicalDrive{Level: raid.RAID0, Drives: []string{"252:0"}})
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "drives are already in use")
+		})
+
+		Convey("清除配置、热备盘、模式及初始化", func() {
+			So(p.Clear("0"), ShouldBeNil)
+			So(p.DeleteLogicalDrive("0", "1"), ShouldBeNil)
+			So(p.SetGlobalHotspares("0", []string{"252:2"}), ShouldBeNil)
+			So(p.SetControllerMode("0", raid.JBODMode), ShouldBeNil)
+			So(p.InitDisk("0"), ShouldBeNil)
+			So(e.cmds, ShouldResemble, []string{
+				"/c0/vall del force J",
+				"/c0/fall del J",
+				"/c0/v1 del force J",
+				"/c0/e252/s2 add hotsparedrive J",
+				"/c0 set jbod=on J",
+				"/c0/vall start init force J",
+			})
+			So(util.IsInvalidOptionError(p.SetControllerMode("0", "HBA")), ShouldBeTrue)
+		})
+
+		Convey("后置检查", func() {
+			items := p.PostCheck(&raid.Setting{Controllers: []*raid.ControllerSetting{
+				{
+					CtrlID:        "0",
+					Mode:          raid.RAIDMode,
+					LogicalDrives: []*raid.LogicalDrive{{Level: raid.RAID1}},
+					Hotspares:     []string{"252:2"},
+				},
+				{CtrlID: "1"},
+			}})
+			So(len(items), ShouldEqual, 4)
+			So(items[0].Matched, ShouldEqual, util.MatchedYES)
+			So(items[1].Matched, ShouldEqual, util.MatchedYES)
+			So(items[2].Matched, ShouldEqual, util.MatchedNO)
+			So(items[3].Matched, ShouldEqual, util.MatchedUnknown)
+		})
+	})
+}
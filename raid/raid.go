@@ -0,0 +1,116 @@
+package raid
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+const (
+	// RAID0 RAID级别-raid0
+	RAID0 = "raid0"
+	// RAID1 RAID级别-raid1
+	RAID1 = "raid1"
+	// RAID5 RAID级别-raid5
+	RAID5 = "raid5"
+	// RAID6 RAID级别-raid6
+	RAID6 = "raid6"
+	// RAID10 RAID级别-raid10
+	RAID10 = "raid10"
+	// RAID50 RAID级别-raid50
+	RAID50 = "raid50"
+	// RAID60 RAID级别-raid60
+	RAID60 = "raid60"
+)
+
+const (
+	// RAIDMode RAID控制器模式-RAID
+	RAIDMode = "RAID"
+	// JBODMode RAID控制器模式-JBOD
+	JBODMode = "JBOD"
+)
+
+const (
+	// ReadAhead 读策略-预读
+	ReadAhead = "ra"
+	// NoReadAhead 读策略-不预读
+	NoReadAhead = "nora"
+	// WriteThrough 写策略-直写
+	WriteThrough = "wt"
+	// WriteBack 写策略-回写
+	WriteBack = "wb"
+	// AlwaysWriteBack 写策略-强制回写
+	AlwaysWriteBack = "awb"
+	// DirectIO IO策略-直接IO
+	DirectIO = "direct"
+	// CachedIO IO策略-缓存IO
+	CachedIO = "cached"
+)
+
+// minDrives 各RAID级别所需的最少物理驱动器数量
+var minDrives = map[string]int{
+	RAID0:  1,
+	RAID1:  2,
+	RAID5:  3,
+	RAID6:  4,
+	RAID10: 4,
+	RAID50: 6,
+	RAID60: 8,
+}
+
+// LogicalDrive 逻辑驱动器（阵列）配置参数
+type LogicalDrive struct {
+	Name        string   `json:"name"`         // 逻辑驱动器名称
+	Level       string   `json:"level"`        // RAID级别
+	Drives      []string `json:"drives"`       // 物理驱动器标识列表，如"252:0"。
+	StripSize   int      `json:"strip_size"`   // 条带大小（单位KB）。为0时使用控制器默认值。
+	ReadPolicy  string   `json:"read_policy"`  // 读策略。可选值：ra|nora
+	WritePolicy string   `json:"write_policy"` // 写策略。可选值：wt|wb|awb
+	IOPolicy    string   `json:"io_policy"`    // IO策略。可选值：direct|cached
+}
+
+// Validate 校验逻辑驱动器配置参数。参数无效时返回util.InvalidOptionError。
+func (ld *LogicalDrive) Validate() error {
+	min, ok := minDrives[strings.ToLower(ld.Level)]
+	if !ok {
+		return util.NewInvalidOptionError("level", ld.Level)
+	}
+	if len(ld.Drives) < min {
+		return util.NewInvalidOptionError("drives", strings.Join(ld.Drives, ","))
+	}
+	if ld.StripSize < 0 || ld.StripSize&(ld.StripSize-1) != 0 {
+		return util.NewInvalidOptionError("strip_size", strconv.Itoa(ld.StripSize))
+	}
+	if !oneOf(ld.ReadPolicy, ReadAhead, NoReadAhead) {
+		return util.NewInvalidOptionError("read_policy", ld.ReadPolicy)
+	}
+	if !oneOf(ld.WritePolicy, WriteThrough, WriteBack, AlwaysWriteBack) {
+		return util.NewInvalidOptionError("write_policy", ld.WritePolicy)
+	}
+	if !oneOf(ld.IOPolicy, DirectIO, CachedIO) {
+		return util.NewInvalidOptionError("io_policy", ld.IOPolicy)
+	}
+	return nil
+}
+
+// ValidateMode 校验RAID控制器模式。参数无效时返回util.InvalidOptionError。
+func ValidateMode(mode string) error {
+	if mode != RAIDMode && mode != JBODMode {
+		return util.NewInvalidOptionError("mode", mode)
+	}
+	return nil
+}
+
+// oneOf 判断值是否为空或为可选值之一
+func oneOf(value string, options ...string) bool {
+	if value == "" {
+		return true
+	}
+	for _, opt := range options {
+		if strings.EqualFold(value, opt) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package raid
+
+import (
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLogicalDriveValidate(t *testing.T) {
+	Convey("校验逻辑驱动器配置参数", t, func() {
+		Convey("有效配置", func() {
+			ld := LogicalDrive{Level: "RAID5", Drives: []string{"252:0", "252:1", "252:2"}, StripSize: 256, WritePolicy: WriteBack}
+			So(ld.Validate(), ShouldBeNil)
+		})
+
+		Convey("不支持的RAID级别", func() {
+			ld := LogicalDrive{Level: "raid7", Drives: []string{"252:0"}}
+			So(ld.Validate(), ShouldResemble, util.NewInvalidOptionError("level", "raid7"))
+		})
+
+		Convey("物理驱动器数量不足", func() {
+			ld := LogicalDrive{Level: RAID10, Drives: []string{"252:0", "252:1"}}
+			So(util.IsInvalidOptionError(ld.Validate()), ShouldBeTrue)
+		})
+
+		Convey("条带大小不是2的幂", func() {
+			ld := LogicalDrive{Level: RAID0, Drives: []string{"252:0"}, StripSize: 100}
+			So(ld.Validate(), ShouldResemble, util.NewInvalidOptionError("strip_size", "100"))
+		})
+
+		Convey("无效的缓存策略", func() {
+			ld := LogicalDrive{Level: RAID1, Drives: []string{"252:0", "252:1"}, IOPolicy: "lazy"}
+			So(ld.Validate(), ShouldResemble, util.NewInvalidOptionError("io_policy", "lazy"))
+		})
+	})
+
+	Convey("校验RAID控制器模式", t, func() {
+		So(ValidateMode(JBODMode), ShouldBeNil)
+		So(util.IsInvalidOptionError(ValidateMode("HBA")), ShouldBeTrue)
+	})
+}
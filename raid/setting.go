@@ -0,0 +1,16 @@
+package raid
+
+// Setting RAID配置参数
+type Setting struct {
+	Controllers []*ControllerSetting `json:"controllers"`
+}
+
+// ControllerSetting 单个RAID控制器的配置参数
+type ControllerSetting struct {
+	CtrlID        string          `json:"ctrl_id"`        // RAID控制器ID
+	Clear         bool            `json:"clear"`          // 实施前是否清除控制器原有配置
+	Mode          string          `json:"mode"`           // 控制器模式。可选值：RAID|JBOD，为空时不修改。
+	LogicalDrives []*LogicalDrive `json:"logical_drives"` // 待创建的逻辑驱动器
+	Hotspares     []string        `json:"hotspares"`      // 全局热备盘
+	InitDisk      bool            `json:"init_disk"`      // 是否初始化逻辑驱动器
+}
@@ -0,0 +1,258 @@
+package report
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+)
+
+const (
+	// spoolExt 暂存的上报内容文件扩展名
+	spoolExt = ".json"
+	// rejectedExt 被服务端拒绝的暂存文件扩展名，不再重放。
+	rejectedExt = ".rejected"
+	// idSep 暂存文件名中设备序列号与上报ID的分隔符，不会出现在转义后的序列号中。
+	idSep = "@"
+)
+
+// ErrSpooled 服务端不可达，上报内容已暂存至本地，待重放。
+var ErrSpooled = errors.New("device info spooled")
+
+// StatusError 服务端返回的非成功状态
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("report device info: unexpected status %d: %s", e.StatusCode, e.Body)
+}
+
+// retryable 返回该状态是否可重试
+func (e *StatusError) retryable() bool {
+	return e.StatusCode >= 500 || e.StatusCode == http.StatusRequestTimeout || e.StatusCode == http.StatusTooManyRequests
+}
+
+// Reporter 设备信息上报器。
+// 上报内容经gzip压缩后POST至服务端，失败时按指数退避重试；服务端不可达时将上报内容暂存至本地目录，待Replay重放。
+type Reporter struct {
+	Endpoint   string        // 上报地址
+	Token      string        // 认证令牌
+	SpoolDir   string        // 暂存目录。为空时不暂存。
+	Client     *http.Client  // 为nil时使用http.DefaultClient
+	Retries    int           // 失败后的重试次数
+	Backoff    time.Duration // 首次重试的等待时间，此后每次翻倍。
+	MaxBackoff time.Duration // 重试等待时间上限。为0时不设上限。
+}
+
+// Report 上报设备信息。服务端不可达或ctx被取消时暂存上报内容并返回包装了ErrSpooled的错误。
+// 每次上报生成新的上报ID，重试及重放时沿用该ID，以便服务端识别重复的上报。
+func (r *Reporter) Report(ctx context.Context, dev *collector.Device) error {
+	dev.Setup()
+	body, err := json.Marshal(dev)
+	if err != nil {
+		return err
+	}
+	id, err := newReportID()
+	if err != nil {
+		return err
+	}
+	key := IdempotencyKey(dev.SN, id)
+	if err = r.post(ctx, key, body); err == nil || !isRetryable(err) || r.SpoolDir == "" {
+		return err
+	}
+	if e := r.spool(dev.SN, id, body); e != nil {
+		return fmt.Errorf("%s; spool: %w", err, e)
+	}
+	return fmt.Errorf("%w: %s", ErrSpooled, err)
+}
+
+// Replay 按暂存先后重放暂存的上报内容，返回成功重放的数量。
+// 服务端仍不可达时停止重放；被服务端拒绝的内容将被标记，不再重放。
+func (r *Reporter) Replay(ctx context.Context) (n int, err error) {
+	files, err := r.spooled()
+	if err != nil {
+		return 0, err
+	}
+	for _, file := range files {
+		body, err := ioutil.ReadFile(file)
+		if err != nil {
+			return n, err
+		}
+		var dev struct {
+			SN string `json:"sn"`
+		}
+		_ = json.Unmarshal(body, &dev)
+
+		if err = r.post(ctx, IdempotencyKey(dev.SN, spooledID(file)), body); err != nil {
+			if isRetryable(err) {
+				return n, err
+			}
+			_ = os.Rename(file, strings.TrimSuffix(file, spoolExt)+rejectedExt)
+			continue
+		}
+		_ = os.Remove(file)
+		n++
+	}
+	return n, nil
+}
+
+// post 以幂等键key上报内容，失败时按指数退避重试，ctx被取消后不再重试。
+func (r *Reporter) post(ctx context.Context, key string, body []byte) (err error) {
+	backoff := r.Backoff
+	for i := 0; i <= r.Retries; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if r.MaxBackoff > 0 && backoff > r.MaxBackoff {
+				backoff = r.MaxBackoff
+			}
+		}
+		if err = r.do(ctx, key, body); err == nil || !isRetryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (r *Reporter) do(ctx context.Context, key string, body []byte) error {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(body); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.Endpoint, &buf)
+	if err != nil {
+		return &StatusError{Body: err.Error()}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Encoding", "gzip")
+	req.Header.Set("Idempotency-Key", key)
+	if r.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.Token)
+	}
+
+	client := r.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// 服务端在响应中回传相同Idempotency-Key的409表示重复的上报，视为成功；其余409为冲突错误。
+	if resp.StatusCode/100 == 2 || (resp.StatusCode == http.StatusConflict && resp.Header.Get("Idempotency-Key") == key) {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	return &StatusError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(msg))}
+}
+
+// IdempotencyKey 返回设备的一次上报的幂等键。同一次上报的重试及重放具有相同的幂等键。
+func IdempotencyKey(sn, reportID string) string {
+	return sn + "-" + reportID
+}
+
+// newReportID 返回随机生成的上报ID
+func newReportID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// isRetryable 返回错误是否可重试。除服务端明确拒绝外的错误（如网络错误）均可重试。
+func isRetryable(err error) bool {
+	var e *StatusError
+	if errors.As(err, &e) {
+		return e.retryable()
+	}
+	return true
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// spool 暂存上报内容，文件名中记录上报ID。同一设备仅保留最近一次的上报内容。
+func (r *Reporter) spool(sn, id string, body []byte) error {
+	if err := os.MkdirAll(r.SpoolDir, 0700); err != nil {
+		return err
+	}
+	name := unsafeChars.ReplaceAllString(sn, "_")
+	if name == "" {
+		name = "unknown"
+	}
+	olds, _ := filepath.Glob(filepath.Join(r.SpoolDir, name+idSep+"*"+spoolExt))
+	file := filepath.Join(r.SpoolDir, name+idSep+id+spoolExt)
+	tmp := file + ".tmp"
+	if err := ioutil.WriteFile(tmp, body, 0600); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, file); err != nil {
+		return err
+	}
+	for _, old := range olds {
+		_ = os.Remove(old)
+	}
+	return nil
+}
+
+// spooledID 返回暂存文件名中记录的上报ID。文件名中不含上报ID时以文件名作为上报ID，保证每次重放的幂等键一致。
+func spooledID(file string) string {
+	name := strings.TrimSuffix(filepath.Base(file), spoolExt)
+	if i := strings.LastIndex(name, idSep); i >= 0 {
+		return name[i+len(idSep):]
+	}
+	return name
+}
+
+// spooled 返回按暂存时间排序的暂存文件
+func (r *Reporter) spooled() ([]string, error) {
+	if r.SpoolDir == "" {
+		return nil, nil
+	}
+	infos, err := ioutil.ReadDir(r.SpoolDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(infos, func(i, j int) bool {
+		return infos[i].ModTime().Before(infos[j].ModTime())
+	})
+	var files []string
+	for _, info := range infos {
+		if info.Mode().IsRegular() && strings.HasSuffix(info.Name(), spoolExt) {
+			files = append(files, filepath.Join(r.SpoolDir, info.Name()))
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,160 @@
+package report
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// received 服务端收到的上报
+type received struct {
+	sn   string
+	key  string
+	auth string
+}
+
+func TestReporter(t *testing.T) {
+	Convey("上报设备信息", t, func() {
+		dir, err := ioutil.TempDir("", "spool")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+
+		var reports []received
+		var statuses []int
+		var echoKey bool
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(statuses) > 0 {
+				code := statuses[0]
+				statuses = statuses[1:]
+				if code != http.StatusOK {
+					if code == http.StatusConflict && echoKey {
+						w.Header().Set("Idempotency-Key", r.Header.Get("Idempotency-Key"))
+					}
+					w.WriteHeader(code)
+					return
+				}
+			}
+			zr, err := gzip.NewReader(r.Body)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			var dev collector.Device
+			if err = json.NewDecoder(zr).Decode(&dev); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			reports = append(reports, received{sn: dev.SN, key: r.Header.Get("Idempotency-Key"), auth: r.Header.Get("Authorization")})
+		}))
+		defer srv.Close()
+
+		r := Reporter{Endpoint: srv.URL, Token: "secret", SpoolDir: dir, Retries: 2, Backoff: time.Millisecond}
+		dev := &collector.Device{SN: "CN7016"}
+		ctx := context.Background()
+
+		Convey("上报成功", func() {
+			So(r.Report(ctx, dev), ShouldBeNil)
+			So(len(reports), ShouldEqual, 1)
+			So(reports[0].sn, ShouldEqual, "CN7016")
+			So(reports[0].auth, ShouldEqual, "Bearer secret")
+			So(reports[0].key, ShouldStartWith, "CN7016-")
+		})
+
+		Convey("失败后重试", func() {
+			statuses = []int{http.StatusBadGateway, http.StatusTooManyRequests}
+			So(r.Report(ctx, dev), ShouldBeNil)
+			So(len(reports), ShouldEqual, 1)
+			So(r.Report(ctx, dev), ShouldBeNil)
+			So(reports[1].key, ShouldNotEqual, reports[0].key)
+		})
+
+		Convey("服务端拒绝时不重试也不暂存", func() {
+			statuses = []int{http.StatusUnauthorized}
+			err := r.Report(ctx, dev)
+			var e *StatusError
+			So(errors.As(err, &e), ShouldBeTrue)
+			So(e.StatusCode, ShouldEqual, http.StatusUnauthorized)
+			files, _ := ioutil.ReadDir(dir)
+			So(files, ShouldBeEmpty)
+		})
+
+		Convey("服务端确认重复上报", func() {
+			statuses, echoKey = []int{http.StatusConflict}, true
+			So(r.Report(ctx, dev), ShouldBeNil)
+		})
+
+		Convey("未确认幂等键的冲突视为失败", func() {
+			statuses = []int{http.StatusConflict}
+			var e *StatusError
+			So(errors.As(r.Report(ctx, dev), &e), ShouldBeTrue)
+			So(e.StatusCode, ShouldEqual, http.StatusConflict)
+		})
+
+		Convey("服务端不可达时暂存并重放", func() {
+			statuses = []int{503, 503, 503, 503, 503, 503, 503, 503, 503}
+			So(errors.Is(r.Report(ctx, dev), ErrSpooled), ShouldBeTrue)
+			So(errors.Is(r.Report(ctx, &collector.Device{SN: "../CN7017"}), ErrSpooled), ShouldBeTrue)
+			So(reports, ShouldBeEmpty)
+
+			So(errors.Is(r.Report(ctx, dev), ErrSpooled), ShouldBeTrue)
+			So(reports, ShouldBeEmpty)
+
+			files, _ := ioutil.ReadDir(dir)
+			So(len(files), ShouldEqual, 2)
+			var id string
+			for _, f := range files {
+				if strings.HasPrefix(f.Name(), "CN7016@") {
+					id = spooledID(f.Name())
+				}
+			}
+			So(id, ShouldNotBeBlank)
+
+			n, err := r.Replay(ctx)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 2)
+			So(len(reports), ShouldEqual, 2)
+			So([]string{reports[0].key, reports[1].key}, ShouldContain, IdempotencyKey("CN7016", id))
+			files, _ = ioutil.ReadDir(dir)
+			So(files, ShouldBeEmpty)
+		})
+
+		Convey("服务端仍不可达时停止重放", func() {
+			statuses = []int{503, 503, 503}
+			So(errors.Is(r.Report(ctx, dev), ErrSpooled), ShouldBeTrue)
+			statuses = []int{503, 503, 503}
+			n, err := r.Replay(ctx)
+			So(err, ShouldNotBeNil)
+			So(n, ShouldEqual, 0)
+			files, _ := filepath.Glob(filepath.Join(dir, "CN7016@*.json"))
+			So(len(files), ShouldEqual, 1)
+		})
+
+		Convey("重放被拒绝的内容", func() {
+			So(ioutil.WriteFile(filepath.Join(dir, "CN7018.json"), []byte(`{"sn":"CN7018"}`), 0600), ShouldBeNil)
+			statuses = []int{http.StatusBadRequest}
+			n, err := r.Replay(ctx)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 0)
+			_, err = os.Stat(filepath.Join(dir, "CN7018.rejected"))
+			So(err, ShouldBeNil)
+		})
+	})
+
+	Convey("幂等键", t, func() {
+		So(IdempotencyKey("CN7016", "1f2e3d4c5b6a7988"), ShouldEqual, "CN7016-1f2e3d4c5b6a7988")
+		So(spooledID("/var/spool/CN7016@1f2e3d4c5b6a7988.json"), ShouldEqual, "1f2e3d4c5b6a7988")
+		So(spooledID("/var/spool/CN7016.json"), ShouldEqual, "CN7016")
+	})
+}
@@ -1,17 +1,29 @@
 package main
 
 import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/go-plugin"
 	"github.com/licairong/cloudboot-provider-framework/collector"
+	_ "github.com/licairong/cloudboot-provider-framework/collector/bootos"
+	"github.com/licairong/cloudboot-provider-framework/installer"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/provider"
+	"github.com/licairong/cloudboot-provider-framework/report"
 	"github.com/licairong/cloudboot-provider-framework/shared"
 	"github.com/licairong/cloudboot-provider-framework/util"
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime/debug"
+	"sort"
 	"strings"
+	"time"
 	//"github.com/astaxie/beego/httplib"
 )
 
@@ -19,33 +31,46 @@ var (
 	base         *collector.Base
 	plugin_name  string
 	protocol     plugin.ClientProtocol
-	meta         *Meta
+	// pluginClient 已启动的插件进程
+	pluginClient *plugin.Client
+	meta         *provider.Meta
+	providerDir  string
 	dev          collector.Device
 	executor = util.NewBash()
+	// local 本地设备信息采集器，自检时根据设备基本信息选择。
+	local collector.Collector
+	// callTimeout 单次插件调用的超时时间，超时后插件内正在执行的命令将被终止。
+	callTimeout = 10 * time.Minute
+	// serverAddr 服务端地址。为空时仅使用本地插件目录中的 provider。
+	serverAddr = os.Getenv("CLOUDBOOT_SERVER_ADDR")
+	// publicKeyFile 校验 provider 插件包签名的ed25519公钥（base64编码）文件
+	publicKeyFile = "/etc/cloudboot/provider.pub"
+	// installOptions 硬件配置工具安装选项
+	installOptions = installer.Options{LocalRepo: os.Getenv("CLOUDBOOT_LOCAL_REPO")}
+	// reportEndpoint 设备信息上报地址
+	reportEndpoint = reportURL()
+	// spoolDir 服务端不可达时暂存上报内容的目录
+	spoolDir = "/var/spool/cloudboot"
+	// pluginDir 插件目录
+	pluginDir = "."
+	// fallbackProvider 未找到厂商、型号专用插件时使用的通用IPMI插件
+	fallbackProvider = &provider.Provider{Manufacturer: provider.Any, Model: provider.Any, Binary: "generic-ipmi"}
+	// applyTimeout 实施硬件配置（含固件升级）的超时时间
+	applyTimeout = 2 * time.Hour
 )
 
-type Meta struct {
-	Manufacturer string    `json:"manufacturer"`
-	Model        string    `json:"model"`
-	Raid         *Raid     `json:"raid"`
-	Bios         *Hardware `json:"bios"`
-	Oob          *Hardware `json:"oob"`
-}
-
-type Raid struct {
-	Manufacturer     string `json:"manufacturer"`
-	Model            string `json:"model"`
-	Raid             *Raid  `json:"raid"`
-	Tool             string `json:"tool"`
-	ToolVersion      string `json:"tool_version"`
-	ToolDownloadAddr string `json:"tool_download_addr"`
-}
+// errNotImplemented provider 未实现该插件服务
+var errNotImplemented = errors.New("service not implemented by provider")
 
-type Hardware struct {
-	Version          string `json:"version"`
-	Tool             string `json:"tool"`
-	ToolVersion      string `json:"tool_version"`
-	ToolDownloadAddr string `json:"tool_download_addr"`
+// reportURL 返回设备信息上报地址。未单独配置时使用服务端地址下的默认路径。
+func reportURL() string {
+	if u := os.Getenv("CLOUDBOOT_REPORT_URL"); u != "" {
+		return u
+	}
+	if serverAddr == "" {
+		return ""
+	}
+	return strings.TrimRight(serverAddr, "/") + "/api/cloudboot/v1/devices/collections"
 }
 
 func handleErr(err error, exit bool) {
@@ -58,89 +83,276 @@ func handleErr(err error, exit bool) {
 	}
 }
 
-func SelfCheck() {
-	base, _ = collector.BASE()
-	data, err := json.MarshalIndent(base, "", "    ")
-	handleErr(err, false)
-	fmt.Println("===============Base===============", "\n", string(data))
+// SelfCheck 自检，获取品牌、型号、SN。ctx已被取消时不再更新自检结果。
+func SelfCheck(ctx context.Context) error {
+	b, err := collector.BASE()
+	if err != nil {
+		return err
+	}
+	if err = ctx.Err(); err != nil {
+		return err
+	}
+	base = b
 	if base.Manufacturer == "" || base.Model == "" || base.SN == "" {
-		fmt.Println("Error: device info not collect, exit.")
+		fmt.Fprintln(os.Stderr, "Error: device info not collect, exit.")
 		//os.Exit(1)
 	}
-	plugin_name = strings.Replace(fmt.Sprintf(base.Manufacturer, "-", base.Model), " ", "-", 1)
+	plugin_name = provider.Name(base.Manufacturer, base.Model)
+
+	name, c, err := collector.AutoSelect(base)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Using collector %s\n", name)
+	c.SetLog(logger)
+	local = c
+	return nil
 }
 
-func LoadProvider() {
-	// 下载 provider
-	//tmp_file := "/tmp/plugin_file"
-	//url := fmt.Sprintf("%s/plugins/%s_%s.zip", agent.Cmdline.ServerAddr, plugin_name, base.Arch)
-	//if err := httplib.Get(url).ToFile(tmp_file); err != nil {
-	//	fmt.Println("download provider error: %s", err)
-	//	os.Exit(1)
-	//}
-	//
-	//// 解压 provider 到 /usr/bin
-	//if err := util.UnZip("/usr/bin", tmp_file); err != nil {
-	//	fmt.Println("unzip provider error: %s", err)
-	//	os.Exit(1)
-	//}
+// LoadProvider 查找或下载与设备匹配的 provider 并启动插件进程。
+// 此前已启动的插件进程将被终止；ctx被取消后终止下载，已启动的插件进程也将被终止。
+func LoadProvider(ctx context.Context) error {
+	if pluginClient != nil {
+		pluginClient.Kill()
+		pluginClient, protocol = nil, nil
+	}
+
+
+	binary, secure, err := selectProvider(ctx)
+	if err != nil {
+		return err
+	}
 
 	// 加载 provider
 	pluginClientConfig := &plugin.ClientConfig{
 		HandshakeConfig:  shared.Handshake,
-		Cmd:              exec.Command("./dell-poweredge-r730"),
+		Cmd:              exec.Command(binary),
+		SecureConfig:     secure,
 		Plugins:          map[string]plugin.Plugin{
 			"raid":       &shared.GRPCRaidPlugin{},
-			"oob":        &shared.GRPCOobPlugin{},
+			"oob":        &shared.GRPCOobPlugin{Log: logger},
+			"bios":       &shared.GRPCBiosPlugin{},
+			"firmware":   &shared.GRPCFirmwarePlugin{},
 		},
 		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
 	}
 
 	client := plugin.NewClient(pluginClientConfig)
 	pluginClientConfig.Reattach = client.ReattachConfig()
-	protocol, _ = client.Client()
+	rpc, err := client.Client()
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		client.Kill()
+		return err
+	}
+	pluginClient, protocol = client, rpc
+	return nil
 }
 
-func InstallTools() {
-	// 加载元数据
-	loadMeta()
+// selectProvider 按匹配精确程度依次尝试与设备匹配的 provider，返回首个适用于当前设备型号的 provider 可执行文件。
+// 指定了服务端时从服务端下载，服务端不存在的插件包将被跳过；否则在本地插件目录中查找，不存在可执行文件的插件将被跳过。
+// 元数据声明不支持当前设备型号的 provider 同样被跳过，最终回退至通用IPMI插件。
+func selectProvider(ctx context.Context) (binary string, secure *plugin.SecureConfig, err error) {
+	registry := provider.NewRegistry(pluginDir)
+	if err := registry.LoadFile(filepath.Join(pluginDir, "providers.json")); err != nil && !os.IsNotExist(err) {
+		handleErr(err, false)
+	}
+	registry.Register(fallbackProvider)
 
-	// 安装硬件配置工具
-	_, err := executor.Exec(nil, "yum",  "-y", "install",  meta.Raid.Tool)
-	if err != nil {
-		fmt.Println("install raid tool failed,", err)
+	for _, p := range registry.Candidates(base.Manufacturer, base.Model, base.Arch) {
+		if serverAddr != "" {
+			// 从服务端下载 provider，校验签名后解压至插件目录
+			pkg, err := fetchProvider(ctx, p.Binary)
+			if errors.Is(err, provider.ErrPackageNotFound) {
+				continue
+			}
+			if err != nil {
+				return "", nil, err
+			}
+			binary, secure = pkg.BinaryPath(), pkg.SecureConfig()
+		} else {
+			var ok bool
+			if binary, ok = registry.Executable(p); !ok {
+				continue
+			}
+		}
+
+		// 加载元数据，跳过不适用于当前设备型号的 provider
+		m, err := provider.LoadMeta(filepath.Join(filepath.Dir(binary), "meta.json"))
+		if err != nil {
+			return "", nil, err
+		}
+		if !m.MatchModel(base.Model) {
+			fmt.Fprintf(os.Stderr, "provider %s does not support model %q, skip.\n", p.Binary, base.Model)
+			continue
+		}
+		meta, providerDir = m, filepath.Dir(binary)
+		return binary, secure, nil
 	}
+	return "", nil, provider.ErrProviderNotFound
+}
 
-	_, err = executor.Exec(nil, "yum",  "-y", "install",  meta.Bios.Tool)
+// fetchProvider 从服务端下载指定名称的 provider 插件包
+func fetchProvider(ctx context.Context, name string) (*provider.Package, error) {
+	data, err := ioutil.ReadFile(publicKeyFile)
 	if err != nil {
-		fmt.Println("install bios tool failed,", err)
+		return nil, err
 	}
-
-	_, err = executor.Exec(nil, "yum",  "-y", "install",  meta.Oob.Tool)
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
 	if err != nil {
-		fmt.Println("install oob tool failed,", err)
+		return nil, err
+	}
+	f := provider.Fetcher{
+		Server:        serverAddr,
+		Dir:           pluginDir,
+		PublicKey:     ed25519.PublicKey(key),
+		Retries:       3,
+		RetryInterval: 5 * time.Second,
+	}
+	return f.Fetch(ctx, name, base.Arch)
+}
+
+// InstallTools 安装 provider 所需的硬件配置工具，ctx被取消时终止正在执行的安装命令。
+func InstallTools(ctx context.Context) error {
+	if meta == nil {
+		return errPluginNotLoaded
+	}
+
+	// 安装适用于当前硬件架构及操作系统的硬件配置工具
+	steps, err := installer.New(util.WithContext(executor, ctx), installOptions).Install(meta.Tools(base.Arch, osRelease()))
+	for _, step := range steps {
+		if step.Skip {
+			fmt.Fprintf(os.Stderr, "%s %s already installed\n", step.Tool.Name, step.Installed)
+			continue
+		}
+		for _, cmd := range step.Commands {
+			fmt.Fprintf(os.Stderr, "[%s] %s\n", step.Backend, cmd)
+		}
 	}
+	return err
 }
 
-func loadMeta() {
-	jsonFile, err := os.Open("meta.json")
+// osRelease 返回当前操作系统标识及主版本号，如centos7。
+func osRelease() string {
+	data, err := ioutil.ReadFile("/etc/os-release")
 	if err != nil {
-		fmt.Println(err)
+		return ""
+	}
+	var id, version string
+	for _, line := range strings.Split(string(data), "\n") {
+		kv := strings.SplitN(strings.TrimSpace(line), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "ID":
+			id = strings.Trim(kv[1], `"`)
+		case "VERSION_ID":
+			version = strings.SplitN(strings.Trim(kv[1], `"`), ".", 2)[0]
+		}
 	}
-	defer jsonFile.Close()
+	return id + version
+}
+
+// components 可采集的设备组件。RAID、带外信息经插件采集，其余组件由本地采集器采集。
+var components = map[string]func(ctx context.Context) error{
+	"cpu": func(ctx context.Context) (err error) {
+		dev.CPU, err = local.CPU()
+		return err
+	},
+	"memory": func(ctx context.Context) (err error) {
+		dev.Memory, err = local.Memory()
+		return err
+	},
+	"board": func(ctx context.Context) (err error) {
+		dev.Board, err = local.Motherboard()
+		return err
+	},
+	"logical_disk": func(ctx context.Context) (err error) {
+		dev.LogicalDisk, err = local.LogicalDisk()
+		return err
+	},
+	"physical_disk": func(ctx context.Context) (err error) {
+		dev.PhysicalDisk, err = local.PhysicalDisk("", "", "")
+		return err
+	},
+	"nic": func(ctx context.Context) (err error) {
+		dev.NIC, err = local.NIC()
+		return err
+	},
+	"hba": func(ctx context.Context) (err error) {
+		dev.HBA, err = local.HBA()
+		return err
+	},
+	"bios": func(ctx context.Context) (err error) {
+		dev.BIOS, err = local.BIOS()
+		return err
+	},
+	"pci": func(ctx context.Context) (err error) {
+		dev.PCI, err = local.PCI()
+		return err
+	},
+	"fan": func(ctx context.Context) (err error) {
+		dev.Fan, err = local.Fan()
+		return err
+	},
+	"power_supply": func(ctx context.Context) (err error) {
+		dev.PowerSupply, err = local.PowerSupply()
+		return err
+	},
+	"lldp": func(ctx context.Context) (err error) {
+		dev.LLDP, err = local.LLDP()
+		return err
+	},
+	"raid": func(ctx context.Context) (err error) {
+		svc, err := raidService(ctx)
+		if err != nil {
+			return err
+		}
+		dev.RAID, err = svc.RAID()
+		return err
+	},
+	"oob": func(ctx context.Context) (err error) {
+		svc, err := oobService(ctx)
+		if err != nil {
+			return err
+		}
+		c, ok := svc.(shared.OOBCollector)
+		if !ok {
+			return fmt.Errorf("oob: %w", errNotImplemented)
+		}
+		dev.OOB, err = c.OOB()
+		return err
+	},
+}
 
-	byteValue, _ := ioutil.ReadAll(jsonFile)
-	_ = json.Unmarshal([]byte(byteValue), &meta)
+// componentNames 返回可采集的设备组件名称
+func componentNames() []string {
+	names := make([]string, 0, len(components))
+	for name := range components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
-// CollectDeviceInfo 采集设备信息并丢弃采集过程中的错误。
-func CollectDeviceInfo() {
+// CollectDeviceInfo 采集设备信息。names为空时采集全部组件，否则仅采集指定组件。
+// 采集全部组件时忽略单个组件的采集错误，仅输出至标准错误。ctx被取消后不再采集剩余组件。
+func CollectDeviceInfo(ctx context.Context, names ...string) (err error) {
 	defer func() {
-		if re := recover(); re != any(nil) {
-			fmt.Println("Panic: %v: %s", re, debug.Stack())
+		if re := recover(); re != nil {
+			err = fmt.Errorf("panic: %v: %s", re, debug.Stack())
 		}
 	}()
 
+	for _, name := range names {
+		if _, ok := components[name]; !ok {
+			return util.NewInvalidOptionError("component", name)
+		}
+	}
+
 	dev.IsVM = base.IsVM
 	dev.SN = base.SN
 	dev.Manufacturer = base.Manufacturer
@@ -149,17 +361,137 @@ func CollectDeviceInfo() {
 	dev.ChassisType = base.ChassisType
 	dev.Height = base.Height
 
-	raw, _ := protocol.Dispense("raid")
-	raid_service := raw.(shared.RaidService)
-	////raw, err = protocol.Dispense("oob")
-	////oob_service := raw.(shared.OobService)
-	//
-	dev.Spec, _ = raid_service.RAID()
-	data, err := json.MarshalIndent(dev, "", "    ")
-	handleErr(err, false)
-	fmt.Println("===============Base===============", "\n", string(data))
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	if len(names) == 0 {
+		for _, name := range componentNames() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := components[name](ctx); err != nil {
+				handleErr(fmt.Errorf("collect %s: %w", name, err), false)
+			}
+		}
+		return nil
+	}
+	for _, name := range names {
+		if err := components[name](ctx); err != nil {
+			return fmt.Errorf("collect %s: %w", name, err)
+		}
+	}
+	return nil
 }
 
-func PostDeviceInfo() {
+// dispense 返回 provider 实现的插件服务。插件未加载时返回errPluginNotLoaded，meta.json未声明该服务时返回errNotImplemented。
+func dispense(service string) (interface{}, error) {
+	if protocol == nil {
+		return nil, fmt.Errorf("%s: %w", service, errPluginNotLoaded)
+	}
+	if meta != nil && !meta.Implements(service) {
+		return nil, fmt.Errorf("%s: %w", service, errNotImplemented)
+	}
+	return protocol.Dispense(service)
+}
 
+// raidService 返回绑定ctx的RAID插件服务
+func raidService(ctx context.Context) (shared.RaidService, error) {
+	raw, err := dispense(provider.ServiceRAID)
+	if err != nil {
+		return nil, err
+	}
+	return raw.(shared.ContextRaidService).WithContext(ctx), nil
+}
+
+// oobService 返回绑定ctx的带外插件服务
+func oobService(ctx context.Context) (oob.Worker, error) {
+	raw, err := dispense(provider.ServiceOOB)
+	if err != nil {
+		return nil, err
+	}
+	return raw.(shared.ContextOobService).WithContext(ctx), nil
+}
+
+// applier 返回由 provider 实现的插件服务组成的硬件配置实施器，各插件服务均绑定ctx。
+func applier(ctx context.Context) *Applier {
+	var a Applier
+	if raw, err := dispense(provider.ServiceRAID); err == nil {
+		a.RAID = raw.(shared.ContextRaidService).WithContext(ctx)
+	}
+	if raw, err := dispense(provider.ServiceOOB); err == nil {
+		a.OOB = raw.(shared.ContextOobService).WithContext(ctx)
+	}
+	if raw, err := dispense(provider.ServiceBIOS); err == nil {
+		a.BIOS = raw.(shared.ContextBiosService).WithContext(ctx)
+	}
+	if raw, err := dispense(provider.ServiceFirmware); err == nil {
+		a.Firmware = raw.(shared.ContextFirmwareService).WithContext(ctx)
+	}
+	if meta != nil {
+		a.MinFirmware = meta.MinFirmware
+	}
+	a.ProgressInterval = 10 * time.Second
+	return &a
+}
+
+// loadHardwareSetting 读取硬件配置参数文件
+func loadHardwareSetting(file string) (*shared.HardwareSetting, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var sett shared.HardwareSetting
+	if err = json.Unmarshal(data, &sett); err != nil {
+		return nil, err
+	}
+	return &sett, nil
+}
+
+// ApplyHardwareSetting 实施硬件配置参数文件中的配置，并返回后置检查结果。
+// 配置文件不存在时不做任何处理，返回的检查结果为nil。ctx被取消或超时后各插件服务内正在执行的命令将被终止。
+func ApplyHardwareSetting(ctx context.Context, file string) (*util.CheckingResult, error) {
+	sett, err := loadHardwareSetting(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return applier(ctx).Apply(ctx, sett), nil
+}
+
+// PostCheck 对硬件配置参数文件中的配置实施后置检查，并返回检查结果。
+func PostCheck(ctx context.Context, file string) (*util.CheckingResult, error) {
+	sett, err := loadHardwareSetting(file)
+	if err != nil {
+		return nil, err
+	}
+	return applier(ctx).PostCheck(sett), nil
+}
+
+// PostDeviceInfo 上报设备信息。上报前先重放此前因服务端不可达而暂存的上报内容。
+// 服务端不可达导致上报内容被暂存时不视为失败，暂存内容将在下次上报前重放。
+func PostDeviceInfo(ctx context.Context) error {
+	if reportEndpoint == "" {
+		fmt.Fprintln(os.Stderr, "report endpoint not configured, skip.")
+		return nil
+	}
+	r := report.Reporter{
+		Endpoint:   reportEndpoint,
+		Token:      os.Getenv("CLOUDBOOT_TOKEN"),
+		SpoolDir:   spoolDir,
+		Retries:    5,
+		Backoff:    time.Second,
+		MaxBackoff: 30 * time.Second,
+	}
+	if n, err := r.Replay(ctx); n > 0 || err != nil {
+		fmt.Fprintf(os.Stderr, "replay %d spooled report(s)\n", n)
+		handleErr(err, false)
+	}
+	err := r.Report(ctx, &dev)
+	if errors.Is(err, report.ErrSpooled) {
+		handleErr(err, false)
+		return nil
+	}
+	return err
 }
\ No newline at end of file
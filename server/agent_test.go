@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/provider"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSelectProvider(t *testing.T) {
+	Convey("选用适用于设备型号的provider", t, func() {
+		dir := t.TempDir()
+		install := func(binary, models string) {
+			So(os.MkdirAll(filepath.Join(dir, filepath.Dir(binary)), 0755), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(dir, binary), []byte("#!/bin/sh\n"), 0755), ShouldBeNil)
+			meta := `{"version":2,"manufacturer":"Dell","models":` + models + `,"services":["oob"]}`
+			So(ioutil.WriteFile(filepath.Join(dir, filepath.Dir(binary), "meta.json"), []byte(meta), 0644), ShouldBeNil)
+		}
+		install("dell-poweredge-r730", `["PowerEdge R640"]`)
+		install("dell/provider", `["PowerEdge*"]`)
+		So(ioutil.WriteFile(filepath.Join(dir, "providers.json"), []byte(`[{"manufacturer":"Dell","model":"*","binary":"dell/provider"}]`), 0644), ShouldBeNil)
+
+		savedDir, savedBase, savedMeta, savedServer := pluginDir, base, meta, serverAddr
+		defer func() { pluginDir, base, meta, serverAddr = savedDir, savedBase, savedMeta, savedServer }()
+		pluginDir, serverAddr = dir, ""
+		base = &collector.Base{Manufacturer: "Dell Inc.", Model: "PowerEdge R730", Arch: "x86_64"}
+
+		Convey("跳过型号不匹配的provider", func() {
+			binary, secure, err := selectProvider(context.Background())
+			So(err, ShouldBeNil)
+			So(secure, ShouldBeNil)
+			So(binary, ShouldEqual, filepath.Join(dir, "dell", "provider"))
+			So(meta.Models, ShouldResemble, []string{"PowerEdge*"})
+		})
+
+		Convey("无适用的provider", func() {
+			base.Model = "R930"
+			_, _, err := selectProvider(context.Background())
+			So(err, ShouldEqual, provider.ErrProviderNotFound)
+		})
+	})
+}
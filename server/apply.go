@@ -0,0 +1,345 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/bios"
+	"github.com/licairong/cloudboot-provider-framework/firmware"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/raid"
+	"github.com/licairong/cloudboot-provider-framework/shared"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"github.com/licairong/cloudboot-provider-framework/workflow"
+)
+
+// errPluginNotLoaded 未加载实施该部分配置所需的插件
+var errPluginNotLoaded = errors.New("plugin not loaded")
+
+// applyTitle 记录实施失败原因的检查项名称
+const applyTitle = "Apply"
+
+// Applier 将硬件配置参数的各部分分发至对应的插件服务实施，并汇总各插件的后置检查结果。
+// 插件服务为nil时，对应部分的配置不会被实施，并在检查结果中记录错误。
+type Applier struct {
+	RAID     shared.RaidService
+	OOB      shared.OobService
+	BIOS     shared.BiosService
+	Firmware shared.FirmwareService
+
+	// Fetch 获取固件镜像内容。默认通过HTTP下载。
+	Fetch func(ctx context.Context, url string) (io.ReadCloser, error)
+	// ProgressInterval 查询固件升级进度的时间间隔，默认为defaultProgressInterval。
+	ProgressInterval time.Duration
+	// MinFirmware provider要求的各类部件最低固件版本，key为部件类型。不为空时后置检查包含最低版本检查。
+	MinFirmware map[string]string
+}
+
+// defaultProgressInterval 默认的查询固件升级进度的时间间隔
+const defaultProgressInterval = 10 * time.Second
+
+// Apply 依次实施固件、BIOS、RAID、OOB配置，随后执行各部分的后置检查。
+// 某一部分实施失败不影响其余部分，失败原因将作为检查项记录在该部分的检查结果中。
+// 实施了BIOS配置或RAID清除、模式切换、创建逻辑驱动器时，检查结果标记为需重启设备。
+// ctx被取消或超时后不再等待固件升级完成。
+func (a *Applier) Apply(ctx context.Context, sett *shared.HardwareSetting) *util.CheckingResult {
+	if sett == nil {
+		return new(util.CheckingResult)
+	}
+
+	var fwErr, biosErr, raidErr, oobErr error
+	var reboot bool
+	if sett.Firmware != nil && a.Firmware != nil {
+		fwErr = a.applyFirmware(ctx, sett.Firmware)
+	}
+	if sett.BIOS != nil && a.BIOS != nil {
+		biosErr = a.applyBIOS(sett.BIOS)
+		reboot = reboot || len(sett.BIOS.Items) > 0 || len(sett.BIOS.BootOrder) > 0
+	}
+	if sett.RAID != nil && a.RAID != nil {
+		raidErr = a.applyRAID(sett.RAID)
+		reboot = reboot || raidRebootRequired(sett.RAID)
+	}
+	if sett.OOB != nil && a.OOB != nil {
+		oobErr = a.applyOOB(sett.OOB)
+	}
+
+	result := a.PostCheck(sett)
+	result.FWItems = withError(applyTitle, fwErr, result.FWItems)
+	result.BIOSItems = withError(applyTitle, biosErr, result.BIOSItems)
+	result.RAIDItems = withError(applyTitle, raidErr, result.RAIDItems)
+	result.OOBItems = withError(applyTitle, oobErr, result.OOBItems)
+	result.RebootRequired = reboot
+	return result
+}
+
+// raidRebootRequired 返回RAID配置是否包含需重启后生效的变更
+func raidRebootRequired(sett *raid.Setting) bool {
+	for _, ctrl := range sett.Controllers {
+		if ctrl != nil && (ctrl.Clear || ctrl.Mode != "" || len(ctrl.LogicalDrives) > 0) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyStatus 返回实施结果对应的工作流步骤结果。
+// 存在实施失败的部分时返回错误；配置需重启后生效时返回workflow.ErrRebootRequired，
+// 此时检查项不符视为待生效，由重启后的后置检查步骤检查；否则存在未通过的检查项时返回错误。
+func applyStatus(result *util.CheckingResult) error {
+	var failed, mismatched int
+	for _, item := range result.Failed() {
+		if item.Title == applyTitle {
+			failed++
+		} else {
+			mismatched++
+		}
+	}
+	switch {
+	case failed > 0:
+		return fmt.Errorf("apply hardware setting: %d part(s) failed", failed)
+	case result.RebootRequired:
+		return workflow.ErrRebootRequired
+	case mismatched > 0:
+		return fmt.Errorf("apply hardware setting: %d checking item(s) failed", mismatched)
+	}
+	return nil
+}
+
+// checkStatus 返回后置检查结果对应的工作流步骤结果，存在未通过的检查项时返回错误。
+func checkStatus(result *util.CheckingResult) error {
+	if failed := result.Failed(); len(failed) > 0 {
+		return fmt.Errorf("postcheck hardware setting: %d checking item(s) failed", len(failed))
+	}
+	return nil
+}
+
+// PostCheck 执行各部分配置的后置检查
+func (a *Applier) PostCheck(sett *shared.HardwareSetting) *util.CheckingResult {
+	var result util.CheckingResult
+	if sett == nil {
+		return &result
+	}
+	if sett.Firmware != nil {
+		if a.Firmware == nil {
+			result.FWItems = withError("PostCheck", errPluginNotLoaded, nil)
+		} else {
+			result.FWItems = a.Firmware.PostCheck(sett.Firmware)
+		}
+	}
+	if len(a.MinFirmware) > 0 && a.Firmware != nil {
+		result.FWItems = append(result.FWItems, a.checkMinFirmware()...)
+	}
+	if sett.BIOS != nil {
+		if a.BIOS == nil {
+			result.BIOSItems = withError("PostCheck", errPluginNotLoaded, nil)
+		} else {
+			result.BIOSItems = a.BIOS.PostCheck(sett.BIOS)
+		}
+	}
+	if sett.RAID != nil {
+		if a.RAID == nil {
+			result.RAIDItems = withError("PostCheck", errPluginNotLoaded, nil)
+		} else {
+			result.RAIDItems = a.RAID.PostCheck(sett.RAID)
+		}
+	}
+	if sett.OOB != nil {
+		if a.OOB == nil {
+			result.OOBItems = withError("PostCheck", errPluginNotLoaded, nil)
+		} else {
+			result.OOBItems = a.OOB.PostCheck(sett.OOB)
+		}
+	}
+	return &result
+}
+
+// checkMinFirmware 检查各部件固件版本是否满足provider要求的最低版本
+func (a *Applier) checkMinFirmware() []*util.CheckingItem {
+	components, err := a.Firmware.Components()
+	if err != nil {
+		return withError("Minimum Firmware", err, nil)
+	}
+	return firmware.CheckMinimum(components, a.MinFirmware)
+}
+
+// applyFirmware 上传并升级配置了镜像地址的固件，逐个等待升级完成。
+func (a *Applier) applyFirmware(ctx context.Context, sett *firmware.Setting) error {
+	for _, item := range sett.Items {
+		if item == nil || item.Image == "" {
+			continue
+		}
+		if err := a.upgradeFirmware(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upgradeFirmware 上传并升级固件，按ProgressInterval轮询升级进度直至升级结束或ctx被取消。
+func (a *Applier) upgradeFirmware(ctx context.Context, item *firmware.SettingItem) error {
+	fetch := a.Fetch
+	if fetch == nil {
+		fetch = httpFetch
+	}
+	image, err := fetch(ctx, item.Image)
+	if err != nil {
+		return err
+	}
+	defer image.Close()
+
+	imageID, err := a.Firmware.Upload(path.Base(item.Image), image)
+	if err != nil {
+		return err
+	}
+	taskID, err := a.Firmware.Apply(imageID)
+	if err != nil {
+		return err
+	}
+
+	interval := a.ProgressInterval
+	if interval <= 0 {
+		interval = defaultProgressInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		progress, err := a.Firmware.Progress(taskID)
+		if err != nil {
+			return err
+		}
+		if progress == nil {
+			return fmt.Errorf("upgrade %s firmware: no progress of task %s", item.Type, taskID)
+		}
+		switch progress.Status {
+		case firmware.StatusSucceeded:
+			return nil
+		case firmware.StatusFailed:
+			return fmt.Errorf("upgrade %s firmware failed: %s", item.Type, progress.Message)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("upgrade %s firmware: wait for task %s: %w", item.Type, taskID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *Applier) applyBIOS(sett *bios.Setting) error {
+	if len(sett.Items) > 0 {
+		if err := a.BIOS.Apply(sett.Items); err != nil {
+			return err
+		}
+	}
+	if len(sett.BootOrder) > 0 {
+		return a.BIOS.SetBootOrder(sett.BootOrder)
+	}
+	return nil
+}
+
+func (a *Applier) applyRAID(sett *raid.Setting) error {
+	for _, ctrl := range sett.Controllers {
+		if ctrl == nil {
+			continue
+		}
+		if ctrl.Clear {
+			if err := a.RAID.Clear(ctrl.CtrlID); err != nil {
+				return err
+			}
+		}
+		if ctrl.Mode != "" {
+			if err := a.RAID.SetControllerMode(ctrl.CtrlID, ctrl.Mode); err != nil {
+				return err
+			}
+		}
+		for _, ld := range ctrl.LogicalDrives {
+			if err := a.RAID.CreateLogicalDrive(ctrl.CtrlID, ld); err != nil {
+				return err
+			}
+		}
+		if len(ctrl.Hotspares) > 0 {
+			if err := a.RAID.SetGlobalHotspares(ctrl.CtrlID, ctrl.Hotspares); err != nil {
+				return err
+			}
+		}
+		if ctrl.InitDisk {
+			if err := a.RAID.InitDisk(ctrl.CtrlID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyOOB 依次实施OOB网络、用户配置，最后按需冷启动BMC。
+func (a *Applier) applyOOB(sett *oob.Setting) (err error) {
+	if sett.Network != nil {
+		switch sett.Network.IPSrc {
+		case oob.Static:
+			err = a.OOB.SetStaticIP(sett.Network.StaticIP.IP, sett.Network.StaticIP.Netmask, sett.Network.StaticIP.Gateway)
+		case oob.DHCP:
+			err = a.OOB.SetDHCP()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if sett.User != nil {
+		for _, user := range *sett.User {
+			if user == nil {
+				continue
+			}
+			if err = a.OOB.GenerateUser(user); err != nil {
+				return err
+			}
+			if user.Status == oob.DisabledUser {
+				if err = a.OOB.DisableUser(user.Username); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if sett.BMC != nil && sett.BMC.ColdRest == "ON" {
+		return a.OOB.BMCColdReset()
+	}
+	return nil
+}
+
+// withError 将错误作为检查项插入检查结果之前
+func withError(title string, err error, items []*util.CheckingItem) []*util.CheckingItem {
+	if err == nil {
+		return items
+	}
+	return append([]*util.CheckingItem{
+		{
+			Title:   title,
+			Matched: util.MatchedUnknown,
+			Error:   err.Error(),
+		},
+	}, items...)
+}
+
+// fetchClient 下载固件镜像的HTTP客户端
+var fetchClient = &http.Client{Timeout: 30 * time.Minute}
+
+// httpFetch 通过HTTP下载固件镜像
+func httpFetch(ctx context.Context, url string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := fetchClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("download %s: unexpected status %s", url, resp.Status)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/bios"
+	"github.com/licairong/cloudboot-provider-framework/firmware"
+	"github.com/licairong/cloudboot-provider-framework/raid"
+	"github.com/licairong/cloudboot-provider-framework/shared"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"github.com/licairong/cloudboot-provider-framework/workflow"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeRaid 记录调用顺序的RaidService
+type fakeRaid struct {
+	shared.RaidService
+	calls []string
+}
+
+func (r *fakeRaid) Clear(ctrlID string) error {
+	r.calls = append(r.calls, "clear "+ctrlID)
+	return nil
+}
+
+func (r *fakeRaid) CreateLogicalDrive(ctrlID string, ld *raid.LogicalDrive) error {
+	r.calls = append(r.calls, "create "+ld.Level)
+	return nil
+}
+
+func (r *fakeRaid) InitDisk(ctrlID string) error {
+	r.calls = append(r.calls, "init "+ctrlID)
+	return nil
+}
+
+func (r *fakeRaid) PostCheck(sett *raid.Setting) []*util.CheckingItem {
+	return []*util.CheckingItem{util.NewCheckingHelper("RAID Level", "raid1", "raid1").Do()}
+}
+
+// fakeBios 应用配置失败的BiosService
+type fakeBios struct {
+	shared.BiosService
+}
+
+func (b *fakeBios) Apply(settings map[string]string) error {
+	return errors.New("unknown attribute")
+}
+
+func (b *fakeBios) PostCheck(sett *bios.Setting) []*util.CheckingItem {
+	return []*util.CheckingItem{util.NewCheckingHelper("BootMode", "Uefi", "Bios").Do()}
+}
+
+// fakeFirmware 第二次查询进度时升级完成的FirmwareService。
+// 指定status时始终返回该状态，nilProgress为true时返回nil进度。
+type fakeFirmware struct {
+	shared.FirmwareService
+	image       string
+	progress    int
+	status      string
+	nilProgress bool
+}
+
+func (f *fakeFirmware) Upload(name string, image io.Reader) (string, error) {
+	data, _ := ioutil.ReadAll(image)
+	f.image = name + ":" + string(data)
+	return "img", nil
+}
+
+func (f *fakeFirmware) Apply(imageID string) (string, error) {
+	return "task", nil
+}
+
+func (f *fakeFirmware) Progress(taskID string) (*firmware.Progress, error) {
+	f.progress++
+	if f.nilProgress {
+		return nil, nil
+	}
+	if f.status != "" {
+		return &firmware.Progress{TaskID: taskID, Status: f.status}, nil
+	}
+	if f.progress < 2 {
+		return &firmware.Progress{TaskID: taskID, Status: firmware.StatusRunning}, nil
+	}
+	return &firmware.Progress{TaskID: taskID, Status: firmware.StatusSucceeded, Percent: 100}, nil
+}
+
+func (f *fakeFirmware) Components() ([]*firmware.Component, error) {
+	return []*firmware.Component{{Type: firmware.BIOS, Version: "2.12.1"}, {Type: firmware.BMC, Version: "2.60"}}, nil
+}
+
+func (f *fakeFirmware) PostCheck(sett *firmware.Setting) []*util.CheckingItem {
+	return firmware.PostCheck([]*firmware.Component{{Type: firmware.BIOS, Version: "2.12.1"}}, sett)
+}
+
+func TestApplier(t *testing.T) {
+	Convey("实施硬件配置", t, func() {
+		r, fw := new(fakeRaid), new(fakeFirmware)
+		a := Applier{
+			RAID:     r,
+			BIOS:     new(fakeBios),
+			Firmware: fw,
+			Fetch: func(ctx context.Context, url string) (io.ReadCloser, error) {
+				return ioutil.NopCloser(strings.NewReader("image")), nil
+			},
+			ProgressInterval: time.Millisecond,
+		}
+
+		result := a.Apply(context.Background(), &shared.HardwareSetting{
+			RAID: &raid.Setting{Controllers: []*raid.ControllerSetting{
+				{CtrlID: "0", Clear: true, LogicalDrives: []*raid.LogicalDrive{{Level: raid.RAID1}}, InitDisk: true},
+			}},
+			BIOS: &bios.Setting{Items: map[string]string{"BootMode": "Uefi"}},
+			Firmware: &firmware.Setting{Items: []*firmware.SettingItem{
+				{Type: firmware.BIOS, Version: "2.12.1", Image: "http://repo/BIOS_2.12.1.bin"},
+			}},
+		})
+
+		Convey("按顺序分发至插件服务", func() {
+			So(r.calls, ShouldResemble, []string{"clear 0", "create raid1", "init 0"})
+			So(fw.image, ShouldEqual, "BIOS_2.12.1.bin:image")
+			So(fw.progress, ShouldEqual, 2)
+		})
+
+		Convey("汇总后置检查结果", func() {
+			So(len(result.RAIDItems), ShouldEqual, 1)
+			So(result.RAIDItems[0].Matched, ShouldEqual, util.MatchedYES)
+			So(len(result.FWItems), ShouldEqual, 1)
+			So(result.FWItems[0].Matched, ShouldEqual, util.MatchedYES)
+			So(result.OOBItems, ShouldBeNil)
+		})
+
+		Convey("实施失败的部分记录错误", func() {
+			So(len(result.BIOSItems), ShouldEqual, 2)
+			So(result.BIOSItems[0].Title, ShouldEqual, "Apply")
+			So(result.BIOSItems[0].Error, ShouldEqual, "unknown attribute")
+			So(result.BIOSItems[1].Matched, ShouldEqual, util.MatchedNO)
+			So(applyStatus(result), ShouldNotBeNil)
+		})
+
+		Convey("BIOS及RAID变更需重启", func() {
+			So(result.RebootRequired, ShouldBeTrue)
+			result.BIOSItems = result.BIOSItems[1:]
+			So(applyStatus(result), ShouldEqual, workflow.ErrRebootRequired)
+			So(checkStatus(result), ShouldNotBeNil)
+
+			result.RebootRequired = false
+			So(applyStatus(result), ShouldNotBeNil)
+			result.BIOSItems = nil
+			So(applyStatus(result), ShouldBeNil)
+			So(checkStatus(result), ShouldBeNil)
+		})
+	})
+
+	Convey("检查最低固件版本", t, func() {
+		a := Applier{Firmware: new(fakeFirmware), MinFirmware: map[string]string{firmware.BMC: "2.81"}}
+		result := a.PostCheck(&shared.HardwareSetting{})
+		So(len(result.FWItems), ShouldEqual, 1)
+		So(result.FWItems[0].Title, ShouldEqual, "BMC Firmware Minimum")
+		So(result.FWItems[0].Matched, ShouldEqual, util.MatchedNO)
+	})
+
+	Convey("插件未加载", t, func() {
+		result := new(Applier).Apply(context.Background(), &shared.HardwareSetting{BIOS: &bios.Setting{}})
+		So(len(result.BIOSItems), ShouldEqual, 1)
+		So(result.BIOSItems[0].Error, ShouldEqual, errPluginNotLoaded.Error())
+	})
+
+	Convey("等待固件升级", t, func() {
+		fw := &fakeFirmware{status: firmware.StatusRunning}
+		a := Applier{
+			Firmware: fw,
+			Fetch: func(ctx context.Context, url string) (io.ReadCloser, error) {
+				return ioutil.NopCloser(strings.NewReader("image")), nil
+			},
+			ProgressInterval: time.Millisecond,
+		}
+		sett := &firmware.Setting{Items: []*firmware.SettingItem{{Type: firmware.BIOS, Image: "http://repo/BIOS_2.12.1.bin"}}}
+
+		Convey("超时后不再等待", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			err := a.applyFirmware(ctx, sett)
+			So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			So(fw.progress, ShouldBeGreaterThan, 1)
+		})
+
+		Convey("进度为空", func() {
+			fw.nilProgress = true
+			So(a.applyFirmware(context.Background(), sett), ShouldNotBeNil)
+			So(fw.progress, ShouldEqual, 1)
+		})
+
+		Convey("升级失败", func() {
+			fw.status = firmware.StatusFailed
+			So(a.applyFirmware(context.Background(), sett), ShouldNotBeNil)
+		})
+	})
+}
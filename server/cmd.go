@@ -0,0 +1,440 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/raid"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"github.com/licairong/cloudboot-provider-framework/workflow"
+)
+
+var (
+	// outputFormat 输出格式。可选值：json|yaml|table
+	outputFormat = OutputJSON
+	// logLevel 日志级别。可选值：debug|info|warn|error
+	logLevel = "info"
+	// stdout 命令输出
+	stdout io.Writer = os.Stdout
+	// logger 控制台日志
+	logger = newLogger(os.Stderr, LevelInfo)
+)
+
+// errUsage 命令行参数错误，已输出用法说明。
+var errUsage = errors.New("invalid usage")
+
+// command 子命令
+type command struct {
+	Name  string
+	Usage string
+	Run   func(args []string) error
+}
+
+// commands 返回全部子命令
+func commands() []*command {
+	return []*command{
+		{Name: "run", Usage: "执行可在重启后继续的装机工作流 [-f setting.json] [--state file] [--reset] [--reboot]", Run: runCmd},
+		{Name: "selfcheck", Usage: "自检，输出设备品牌、型号、SN等基础信息", Run: selfcheckCmd},
+		{Name: "collect", Usage: "采集设备信息 [--component cpu,memory,nic,...]", Run: collectCmd},
+		{Name: "report", Usage: "采集并上报设备信息", Run: reportCmd},
+		{Name: "oob", Usage: "带外管理 power on|off|status|reset|pxe, user list|add|passwd|enable|disable", Run: oobCmd},
+		{Name: "raid", Usage: "RAID管理 list|drives|create|delete|hotspare|mode|init|clear", Run: raidCmd},
+		{Name: "apply", Usage: "实施硬件配置并执行后置检查 -f setting.json", Run: applyCmd},
+		{Name: "postcheck", Usage: "对硬件配置执行后置检查 -f setting.json", Run: postcheckCmd},
+	}
+}
+
+// run 解析全局参数并执行子命令。未指定子命令时执行装机工作流。
+func run(args []string) error {
+	fs := flag.NewFlagSet("agent", flag.ContinueOnError)
+	fs.StringVar(&serverAddr, "server", serverAddr, "服务端地址")
+	fs.StringVar(&pluginDir, "plugin-dir", pluginDir, "插件目录")
+	fs.StringVar(&outputFormat, "output", outputFormat, "输出格式：json|yaml|table")
+	fs.StringVar(&logLevel, "log-level", logLevel, "日志级别：debug|info|warn|error")
+	fs.Usage = func() {
+		out := fs.Output()
+		fmt.Fprintf(out, "Usage: %s [flags] [command] [args]\n\nCommands:\n", fs.Name())
+		for _, cmd := range commands() {
+			fmt.Fprintf(out, "  %-10s %s\n", cmd.Name, cmd.Usage)
+		}
+		fmt.Fprintln(out, "\nFlags:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	switch outputFormat {
+	case OutputJSON, OutputYAML, OutputTable:
+	default:
+		return util.NewInvalidOptionError("output", outputFormat)
+	}
+	level, err := parseLevel(logLevel)
+	if err != nil {
+		return err
+	}
+	logger = newLogger(os.Stderr, level)
+	executor.SetLog(logger)
+	reportEndpoint = reportURL()
+
+	defer plugin.CleanupClients()
+
+	if fs.NArg() == 0 {
+		return runWorkflow("setting.json", false, true)
+	}
+	for _, cmd := range commands() {
+		if cmd.Name == fs.Arg(0) {
+			return cmd.Run(fs.Args()[1:])
+		}
+	}
+	fs.Usage()
+	return fmt.Errorf("unknown command %q", fs.Arg(0))
+}
+
+// runOutput 装机工作流的输出，包括各步骤执行汇总及本次运行的硬件配置实施、后置检查结果。
+type runOutput struct {
+	*workflow.Summary
+	stepResults
+}
+
+// runWorkflow 执行装机工作流并输出各步骤执行汇总。步骤要求重启时，若reboot为true则重启设备。
+// 步骤日志输出至标准错误，标准输出仅包含按全局输出格式输出的一份运行结果。
+func runWorkflow(settingFile string, reset, reboot bool) error {
+	var results stepResults
+	engine := workflow.New(stateFile)
+	engine.Log = logger
+	if err := engine.Register(builtinSteps(settingFile, &results)...); err != nil {
+		return err
+	}
+	if reset {
+		if err := engine.Reset(); err != nil {
+			return err
+		}
+	}
+	summary, err := engine.Run(context.Background())
+	if summary != nil {
+		if oerr := output(runOutput{Summary: summary, stepResults: results}); oerr != nil {
+			return oerr
+		}
+	}
+	if errors.Is(err, workflow.ErrRebootRequired) && reboot {
+		logger.Info("reboot required, rebooting...")
+		_, err = executor.Exec(nil, "reboot")
+	}
+	return err
+}
+
+// output 按全局输出格式输出v
+func output(v interface{}) error {
+	return writeOutput(stdout, outputFormat, v)
+}
+
+// prepare 自检并加载插件。install为true时安装硬件配置工具，只读的查询命令无需安装。
+func prepare(install bool) error {
+	ctx := context.Background()
+	if err := SelfCheck(ctx); err != nil {
+		return err
+	}
+	if err := LoadProvider(ctx); err != nil {
+		return err
+	}
+	if !install {
+		return nil
+	}
+	return InstallTools(ctx)
+}
+
+// newFlagSet 返回子命令参数集
+func newFlagSet(name, usage string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s %s\n", name, usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// usageError 输出子命令用法并返回参数错误
+func usageError(fs *flag.FlagSet) error {
+	fs.Usage()
+	return errUsage
+}
+
+// splitList 按逗号拆分参数，忽略空白项。
+func splitList(s string) []string {
+	var list []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func runCmd(args []string) error {
+	fs := newFlagSet("run", "[-f setting.json] [--state file] [--reset] [--reboot]")
+	file := fs.String("f", "setting.json", "硬件配置参数文件，不存在时不实施硬件配置。")
+	fs.StringVar(&stateFile, "state", stateFile, "工作流状态文件")
+	reset := fs.Bool("reset", false, "清除工作流状态，从头执行全部步骤。")
+	reboot := fs.Bool("reboot", true, "步骤要求重启时重启设备")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return runWorkflow(*file, *reset, *reboot)
+}
+
+func selfcheckCmd(args []string) error {
+	fs := newFlagSet("selfcheck", "")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := SelfCheck(context.Background()); err != nil {
+		return err
+	}
+	return output(base)
+}
+
+func collectCmd(args []string) error {
+	fs := newFlagSet("collect", "[--component cpu,memory,nic,...]")
+	component := fs.String("component", "", "待采集的组件，多个组件以逗号分隔。可选值："+strings.Join(componentNames(), ","))
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := prepare(true); err != nil {
+		return err
+	}
+	if err := CollectDeviceInfo(context.Background(), splitList(*component)...); err != nil {
+		return err
+	}
+	return output(&dev)
+}
+
+func reportCmd(args []string) error {
+	fs := newFlagSet("report", "")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := prepare(true); err != nil {
+		return err
+	}
+	if err := CollectDeviceInfo(context.Background()); err != nil {
+		return err
+	}
+	return PostDeviceInfo(context.Background())
+}
+
+func applyCmd(args []string) error {
+	fs := newFlagSet("apply", "-f setting.json")
+	file := fs.String("f", "setting.json", "硬件配置参数文件")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if _, err := os.Stat(*file); err != nil {
+		return err
+	}
+	if err := prepare(true); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), applyTimeout)
+	defer cancel()
+	result, err := ApplyHardwareSetting(ctx, *file)
+	if err != nil {
+		return err
+	}
+	return output(result)
+}
+
+func postcheckCmd(args []string) error {
+	fs := newFlagSet("postcheck", "-f setting.json")
+	file := fs.String("f", "setting.json", "硬件配置参数文件")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := prepare(false); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	result, err := PostCheck(ctx, *file)
+	if err != nil {
+		return err
+	}
+	return output(result)
+}
+
+func oobCmd(args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "power":
+			return oobPowerCmd(args[1:])
+		case "user":
+			return oobUserCmd(args[1:])
+		}
+	}
+	return usageError(newFlagSet("oob", "power|user ..."))
+}
+
+func oobPowerCmd(args []string) error {
+	fs := newFlagSet("oob power", "on|off|status|reset|pxe [--uefi]")
+	uefi := fs.Bool("uefi", false, "以UEFI方式从PXE启动")
+	if len(args) == 0 {
+		return usageError(fs)
+	}
+	action := args[0]
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	if err := prepare(action != "status"); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	w, err := oobService(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "status":
+		status, err := w.PowerStatus()
+		if err != nil {
+			return err
+		}
+		return output(map[string]string{"status": status})
+	case oob.PowerOn:
+		return w.PowerOn()
+	case oob.PowerOff:
+		return w.PowerOff()
+	case "reset":
+		return w.PowerReset()
+	case "pxe":
+		return w.PXEBoot(*uefi, base.Manufacturer)
+	}
+	return usageError(fs)
+}
+
+func oobUserCmd(args []string) error {
+	fs := newFlagSet("oob user", "list|add|passwd|enable|disable [--username name] [--password password] [--level 4] [--status enabled]")
+	username := fs.String("username", "", "用户名")
+	password := fs.String("password", "", "密码")
+	level := fs.Int("level", 4, "用户权限级别")
+	status := fs.String("status", "enabled", "帐号状态：enabled|disabled")
+	if len(args) == 0 {
+		return usageError(fs)
+	}
+	action := args[0]
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if action != "list" && *username == "" {
+		return usageError(fs)
+	}
+
+	if err := prepare(action != "list"); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	w, err := oobService(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "list":
+		users, err := w.Users()
+		if err != nil {
+			return err
+		}
+		return output(users)
+	case "add":
+		return w.GenerateUser(&oob.UserSettingItem{
+			Username:       *username,
+			Password:       *password,
+			PrivilegeLevel: *level,
+			Status:         *status,
+		})
+	case "passwd":
+		return w.ChangeUserPassword(*username, *password)
+	case "enable":
+		return w.EnableUser(*username)
+	case "disable":
+		return w.DisableUser(*username)
+	}
+	return usageError(fs)
+}
+
+func raidCmd(args []string) error {
+	fs := newFlagSet("raid", "list|drives|create|delete|hotspare|mode|init|clear [--ctrl id] ...")
+	ctrlID := fs.String("ctrl", "0", "RAID控制器编号")
+	name := fs.String("name", "", "逻辑驱动器名称")
+	level := fs.String("level", raid.RAID1, "RAID级别")
+	drives := fs.String("drives", "", "物理驱动器，多个驱动器以逗号分隔")
+	stripSize := fs.Int("strip-size", 0, "条带大小（KB），为0时使用控制器默认值")
+	readPolicy := fs.String("read-policy", "", "读策略：ra|nora")
+	writePolicy := fs.String("write-policy", "", "写策略：wt|wb|awb")
+	ioPolicy := fs.String("io-policy", "", "IO策略：direct|cached")
+	ld := fs.String("ld", "", "逻辑驱动器编号")
+	mode := fs.String("mode", "", "控制器模式：RAID|JBOD")
+	if len(args) == 0 {
+		return usageError(fs)
+	}
+	action := args[0]
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	if err := prepare(action != "list" && action != "drives"); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	defer cancel()
+	svc, err := raidService(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch action {
+	case "list":
+		ctrls, err := svc.Controllers()
+		if err != nil {
+			return err
+		}
+		return output(ctrls)
+	case "drives":
+		pds, err := svc.PhysicalDrives(*ctrlID)
+		if err != nil {
+			return err
+		}
+		return output(pds)
+	case "create":
+		return svc.CreateLogicalDrive(*ctrlID, &raid.LogicalDrive{
+			Name:        *name,
+			Level:       *level,
+			Drives:      splitList(*drives),
+			StripSize:   *stripSize,
+			ReadPolicy:  *readPolicy,
+			WritePolicy: *writePolicy,
+			IOPolicy:    *ioPolicy,
+		})
+	case "delete":
+		return svc.DeleteLogicalDrive(*ctrlID, *ld)
+	case "hotspare":
+		return svc.SetGlobalHotspares(*ctrlID, splitList(*drives))
+	case "mode":
+		return svc.SetControllerMode(*ctrlID, *mode)
+	case "init":
+		return svc.InitDisk(*ctrlID)
+	case "clear":
+		return svc.Clear(*ctrlID)
+	}
+	return usageError(fs)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+// 日志级别
+const (
+	LevelDebug = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levels = map[string]int{
+	"debug": LevelDebug,
+	"info":  LevelInfo,
+	"warn":  LevelWarn,
+	"error": LevelError,
+}
+
+var levelNames = [...]string{"DEBUG", "INFO", "WARN", "ERROR"}
+
+// parseLevel 解析日志级别。可选值：debug|info|warn|error
+func parseLevel(name string) (int, error) {
+	level, ok := levels[strings.ToLower(name)]
+	if !ok {
+		return 0, util.NewInvalidOptionError("log-level", name)
+	}
+	return level, nil
+}
+
+// consoleLogger 按级别过滤并输出至控制台的日志实现
+type consoleLogger struct {
+	mu    sync.Mutex
+	w     io.Writer
+	level int
+}
+
+// newLogger 返回输出不低于level级别日志的logger
+func newLogger(w io.Writer, level int) util.Logger {
+	return &consoleLogger{w: w, level: level}
+}
+
+// log 输出日志。若最后一个参数为map[string]string，则将其作为日志字段追加至日志末尾。
+func (l *consoleLogger) log(level int, msg func(v ...interface{}) string, v ...interface{}) {
+	if level < l.level {
+		return
+	}
+	var fields map[string]string
+	if n := len(v); n > 0 {
+		if f, ok := v[n-1].(map[string]string); ok {
+			fields, v = f, v[:n-1]
+		}
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s [%s] %s", time.Now().Format("2006-01-02 15:04:05"), levelNames[level], msg(v...))
+	for k, val := range fields {
+		fmt.Fprintf(&sb, " %s=%s", k, val)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fmt.Fprintln(l.w, sb.String())
+}
+
+func sprint(v ...interface{}) string {
+	return fmt.Sprint(v...)
+}
+
+func sprintf(format string) func(v ...interface{}) string {
+	return func(v ...interface{}) string {
+		return fmt.Sprintf(format, v...)
+	}
+}
+
+func (l *consoleLogger) Debug(v ...interface{}) { l.log(LevelDebug, sprint, v...) }
+func (l *consoleLogger) Debugf(format string, v ...interface{}) {
+	l.log(LevelDebug, sprintf(format), v...)
+}
+func (l *consoleLogger) Info(v ...interface{}) { l.log(LevelInfo, sprint, v...) }
+func (l *consoleLogger) Infof(format string, v ...interface{}) {
+	l.log(LevelInfo, sprintf(format), v...)
+}
+func (l *consoleLogger) Warn(v ...interface{}) { l.log(LevelWarn, sprint, v...) }
+func (l *consoleLogger) Warnf(format string, v ...interface{}) {
+	l.log(LevelWarn, sprintf(format), v...)
+}
+func (l *consoleLogger) Error(v ...interface{}) { l.log(LevelError, sprint, v...) }
+func (l *consoleLogger) Errorf(format string, v ...interface{}) {
+	l.log(LevelError, sprintf(format), v...)
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// OutputJSON 输出格式-JSON
+	OutputJSON = "json"
+	// OutputYAML 输出格式-YAML
+	OutputYAML = "yaml"
+	// OutputTable 输出格式-表格
+	OutputTable = "table"
+)
+
+// writeOutput 按指定格式输出v。YAML及表格格式的字段名及顺序与JSON保持一致。
+func writeOutput(w io.Writer, format string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "    ")
+	if err != nil {
+		return err
+	}
+	switch format {
+	case OutputJSON:
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case OutputYAML:
+		// JSON是YAML的子集，经yaml.MapSlice中转以保留字段顺序。
+		doc, err := orderedYAML(data)
+		if err != nil {
+			return err
+		}
+		out, err := yaml.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(out)
+		return err
+	case OutputTable:
+		return writeTable(w, data)
+	}
+	return util.NewInvalidOptionError("output", format)
+}
+
+// orderedYAML 将JSON内容解析为保留字段顺序的YAML文档
+func orderedYAML(data []byte) (doc interface{}, err error) {
+	switch jsonKind(data) {
+	case '{':
+		var obj yaml.MapSlice
+		err = yaml.Unmarshal(data, &obj)
+		return obj, err
+	case '[':
+		if jsonKind(bytes.TrimSpace(data)[1:]) == '{' {
+			var list []yaml.MapSlice
+			err = yaml.Unmarshal(data, &list)
+			return list, err
+		}
+	}
+	err = yaml.Unmarshal(data, &doc)
+	return doc, err
+}
+
+// jsonKind 返回JSON内容的首个非空白字符，用于区分对象、数组及标量。
+func jsonKind(data []byte) byte {
+	if data = bytes.TrimSpace(data); len(data) == 0 {
+		return 0
+	}
+	return data[0]
+}
+
+// writeTable 以表格形式输出。对象输出为字段、值两列；对象数组以首个元素的字段作为表头，每个元素一行。
+// 嵌套的对象及数组以紧凑的JSON形式输出。
+func writeTable(w io.Writer, data []byte) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	var obj yaml.MapSlice
+	var list []yaml.MapSlice
+	switch kind := jsonKind(data); {
+	case kind == '{' && yaml.Unmarshal(data, &obj) == nil:
+		fmt.Fprintln(tw, "FIELD\tVALUE")
+		for _, item := range obj {
+			fmt.Fprintf(tw, "%v\t%s\n", item.Key, cell(item.Value))
+		}
+	case kind == '[' && jsonKind(bytes.TrimSpace(data)[1:]) == '{' && yaml.Unmarshal(data, &list) == nil:
+		if len(list) == 0 {
+			break
+		}
+		header := make([]string, 0, len(list[0]))
+		for _, item := range list[0] {
+			header = append(header, strings.ToUpper(fmt.Sprint(item.Key)))
+		}
+		fmt.Fprintln(tw, strings.Join(header, "\t"))
+		for _, row := range list {
+			cells := make([]string, 0, len(row))
+			for _, item := range row {
+				cells = append(cells, cell(item.Value))
+			}
+			fmt.Fprintln(tw, strings.Join(cells, "\t"))
+		}
+	default:
+		fmt.Fprintln(tw, strings.TrimSpace(string(data)))
+	}
+	return tw.Flush()
+}
+
+// cell 返回表格单元格内容
+func cell(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "-"
+	case yaml.MapSlice, []interface{}, map[interface{}]interface{}:
+		data, err := json.Marshal(jsonable(v))
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// jsonable 将YAML解析结果转换为可JSON序列化的值
+func jsonable(v interface{}) interface{} {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		m := make(map[string]interface{}, len(v))
+		for _, item := range v {
+			m[fmt.Sprint(item.Key)] = jsonable(item.Value)
+		}
+		return m
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = jsonable(value)
+		}
+		return m
+	case []interface{}:
+		list := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			list = append(list, jsonable(item))
+		}
+		return list
+	}
+	return v
+}
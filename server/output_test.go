@@ -0,0 +1,62 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type outputItem struct {
+	Name  string   `json:"name"`
+	Size  int      `json:"size"`
+	Disks []string `json:"disks,omitempty"`
+}
+
+func TestWriteOutput(t *testing.T) {
+	Convey("按指定格式输出", t, func() {
+		var buf bytes.Buffer
+
+		Convey("JSON", func() {
+			So(writeOutput(&buf, OutputJSON, &outputItem{Name: "sda", Size: 480}), ShouldBeNil)
+			So(buf.String(), ShouldEqual, "{\n    \"name\": \"sda\",\n    \"size\": 480\n}\n")
+		})
+
+		Convey("YAML保留JSON字段名及顺序", func() {
+			So(writeOutput(&buf, OutputYAML, &outputItem{Name: "sda", Size: 480, Disks: []string{"252:0"}}), ShouldBeNil)
+			So(buf.String(), ShouldEqual, "name: sda\nsize: 480\ndisks:\n- \"252:0\"\n")
+		})
+
+		Convey("YAML对象数组", func() {
+			items := []*outputItem{{Name: "sdb", Size: 1920}, {Name: "sda", Size: 480}}
+			So(writeOutput(&buf, OutputYAML, items), ShouldBeNil)
+			So(buf.String(), ShouldEqual, "- name: sdb\n  size: 1920\n- name: sda\n  size: 480\n")
+		})
+
+		Convey("对象输出为字段、值两列", func() {
+			So(writeOutput(&buf, OutputTable, &outputItem{Name: "sda", Size: 480, Disks: []string{"252:0", "252:1"}}), ShouldBeNil)
+			So(buf.String(), ShouldEqual, "FIELD  VALUE\nname   sda\nsize   480\ndisks  [\"252:0\",\"252:1\"]\n")
+		})
+
+		Convey("对象数组每个元素一行", func() {
+			items := []*outputItem{{Name: "sda", Size: 480}, {Name: "sdb", Size: 1920}}
+			So(writeOutput(&buf, OutputTable, items), ShouldBeNil)
+			So(buf.String(), ShouldEqual, "NAME  SIZE\nsda   480\nsdb   1920\n")
+		})
+
+		Convey("不支持的格式", func() {
+			err := writeOutput(&buf, "xml", &outputItem{})
+			So(util.IsInvalidOptionError(err), ShouldBeTrue)
+		})
+	})
+
+	Convey("解析日志级别", t, func() {
+		level, err := parseLevel("WARN")
+		So(err, ShouldBeNil)
+		So(level, ShouldEqual, LevelWarn)
+
+		_, err = parseLevel("verbose")
+		So(util.IsInvalidOptionError(err), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"github.com/licairong/cloudboot-provider-framework/workflow"
+)
+
+// stateFile 装机工作流状态文件，设备重启后据此从上次完成的步骤处继续执行。
+var stateFile = "/var/lib/cloudboot/workflow.json"
+
+// stepResults 内置步骤的硬件配置实施及后置检查结果，随工作流执行汇总一并输出。
+type stepResults struct {
+	Apply     *util.CheckingResult `json:"apply,omitempty"`
+	PostCheck *util.CheckingResult `json:"postcheck,omitempty"`
+}
+
+// builtinSteps 返回内置的装机步骤，实施及后置检查结果记录至results。
+// 自检、加载插件及采集每次运行均执行，以恢复基础信息、插件连接及采集结果等进程内状态。
+func builtinSteps(settingFile string, results *stepResults) []*workflow.Step {
+	return []*workflow.Step{
+		{
+			Name:          "selfcheck",
+			Always:        true,
+			Retries:       2,
+			RetryInterval: 5 * time.Second,
+			Run:           func(ctx context.Context) error { return SelfCheck(ctx) },
+		},
+		{
+			Name:          "load-provider",
+			DependsOn:     []string{"selfcheck"},
+			Always:        true,
+			Retries:       3,
+			RetryInterval: 10 * time.Second,
+			Timeout:       30 * time.Minute,
+			Run:           func(ctx context.Context) error { return LoadProvider(ctx) },
+		},
+		{
+			Name:          "install-tools",
+			DependsOn:     []string{"load-provider"},
+			Retries:       2,
+			RetryInterval: 10 * time.Second,
+			Timeout:       30 * time.Minute,
+			Run:           func(ctx context.Context) error { return InstallTools(ctx) },
+		},
+		{
+			Name:      "collect",
+			DependsOn: []string{"install-tools"},
+			Always:    true,
+			Timeout:   callTimeout,
+			Run:       func(ctx context.Context) error { return CollectDeviceInfo(ctx) },
+		},
+		{
+			Name:      "apply",
+			DependsOn: []string{"collect"},
+			Timeout:   applyTimeout,
+			Run: func(ctx context.Context) error {
+				result, err := ApplyHardwareSetting(ctx, settingFile)
+				if err != nil || result == nil {
+					return err
+				}
+				results.Apply = result
+				return applyStatus(result)
+			},
+		},
+		{
+			Name:      "postcheck",
+			DependsOn: []string{"apply"},
+			Timeout:   callTimeout,
+			Run: func(ctx context.Context) error {
+				result, err := PostCheck(ctx, settingFile)
+				if os.IsNotExist(err) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				results.PostCheck = result
+				return checkStatus(result)
+			},
+		},
+		{
+			Name:          "report",
+			DependsOn:     []string{"collect"},
+			Retries:       3,
+			RetryInterval: 30 * time.Second,
+			Run:           func(ctx context.Context) error { return PostDeviceInfo(ctx) },
+		},
+	}
+}
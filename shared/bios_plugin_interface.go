@@ -0,0 +1,149 @@
+package shared
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/licairong/cloudboot-provider-framework/bios"
+	"github.com/licairong/cloudboot-provider-framework/proto"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"google.golang.org/grpc"
+)
+
+// BiosService BIOS插件服务
+type BiosService interface {
+	// Settings 返回当前BIOS配置项
+	Settings() (map[string]string, error)
+	// Apply 修改BIOS配置项。settings的key为配置项名称，value为配置项取值。
+	Apply(settings map[string]string) error
+	// ResetDefaults 恢复BIOS默认配置
+	ResetDefaults() error
+	// SetBootOrder 设置启动顺序，devices按优先级由高到低排列。
+	SetBootOrder(devices []string) error
+	// PostCheck BIOS配置实施后置检查
+	PostCheck(sett *bios.Setting) []*util.CheckingItem
+}
+
+// ContextBiosService 支持context的BIOS插件服务。
+// 通过WithContext返回的实现执行操作时，若context被取消或超时，则终止正在执行的底层命令。
+type ContextBiosService interface {
+	BiosService
+	WithContext(ctx context.Context) BiosService
+}
+
+// GRPCBiosPlugin implement plugin.GRPCPlugin
+type GRPCBiosPlugin struct {
+	plugin.Plugin
+	Impl BiosService
+}
+
+func (p GRPCBiosPlugin) GRPCServer(broker *plugin.GRPCBroker, server *grpc.Server) error {
+	proto.RegisterBiosPluginServer(server, GRPCBiosPluginServerWrapper{impl: p.Impl})
+	return nil
+}
+
+func (p GRPCBiosPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, conn *grpc.ClientConn) (interface{}, error) {
+	return GRPCBiosPluginClientWrapper{client: proto.NewBiosPluginClient(conn)}, nil
+}
+
+type GRPCBiosPluginServerWrapper struct {
+	impl BiosService
+	proto.UnimplementedBiosPluginServer
+}
+
+// with 若插件实现支持context，则返回绑定了请求context的实现，使调用方的超时及取消能够传递到插件。
+func (_this GRPCBiosPluginServerWrapper) with(ctx context.Context) BiosService {
+	if impl, ok := _this.impl.(ContextBiosService); ok {
+		return impl.WithContext(ctx)
+	}
+	return _this.impl
+}
+
+func (_this GRPCBiosPluginServerWrapper) Settings(ctx context.Context, request *proto.Request) (*proto.BiosSettings, error) {
+	items, err := _this.with(ctx).Settings()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &proto.BiosSettings{Items: items}, nil
+}
+
+func (_this GRPCBiosPluginServerWrapper) Apply(ctx context.Context, request *proto.BiosSettings) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).Apply(request.Items))
+}
+
+func (_this GRPCBiosPluginServerWrapper) ResetDefaults(ctx context.Context, request *proto.Request) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).ResetDefaults())
+}
+
+func (_this GRPCBiosPluginServerWrapper) SetBootOrder(ctx context.Context, request *proto.BootOrder) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).SetBootOrder(request.Devices))
+}
+
+func (_this GRPCBiosPluginServerWrapper) PostCheck(ctx context.Context, request *proto.BiosSetting) (*proto.CheckingItems, error) {
+	return CheckingItemsToProto(_this.with(ctx).PostCheck(BiosSettingFromProto(request))), nil
+}
+
+// GRPCBiosPluginClientWrapper 作为server 调用插件接口的包装器，
+type GRPCBiosPluginClientWrapper struct {
+	client proto.BiosPluginClient
+	ctx    context.Context
+}
+
+var _ ContextBiosService = GRPCBiosPluginClientWrapper{}
+
+// WithContext 返回绑定了context的包装器，context的超时及取消将传递到插件进程。
+func (_this GRPCBiosPluginClientWrapper) WithContext(ctx context.Context) BiosService {
+	_this.ctx = ctx
+	return _this
+}
+
+func (_this GRPCBiosPluginClientWrapper) context() context.Context {
+	if _this.ctx != nil {
+		return _this.ctx
+	}
+	return context.Background()
+}
+
+func (_this GRPCBiosPluginClientWrapper) Settings() (map[string]string, error) {
+	resp, err := _this.client.Settings(_this.context(), &proto.Request{})
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return resp.Items, nil
+}
+
+func (_this GRPCBiosPluginClientWrapper) Apply(settings map[string]string) error {
+	in := proto.BiosSettings{Items: settings}
+	_, err := _this.client.Apply(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCBiosPluginClientWrapper) ResetDefaults() error {
+	_, err := _this.client.ResetDefaults(_this.context(), &proto.Request{})
+	return fromGRPCError(err)
+}
+
+func (_this GRPCBiosPluginClientWrapper) SetBootOrder(devices []string) error {
+	in := proto.BootOrder{Devices: devices}
+	_, err := _this.client.SetBootOrder(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+// PostCheck BIOS配置实施后置检查。调用插件失败时返回一条未知匹配结果的检查项。
+func (_this GRPCBiosPluginClientWrapper) PostCheck(sett *bios.Setting) []*util.CheckingItem {
+	in := BiosSettingToProto(sett)
+	if in == nil {
+		in = &proto.BiosSetting{}
+	}
+	resp, err := _this.client.PostCheck(_this.context(), in)
+	if err != nil {
+		return []*util.CheckingItem{
+			{
+				Title:   "PostCheck",
+				Matched: util.MatchedUnknown,
+				Error:   fromGRPCError(err).Error(),
+			},
+		}
+	}
+	return CheckingItemsFromProto(resp)
+}
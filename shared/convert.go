@@ -0,0 +1,926 @@
+package shared
+
+import (
+	"github.com/licairong/cloudboot-provider-framework/bios"
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/firmware"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/proto"
+	"github.com/licairong/cloudboot-provider-framework/raid"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"time"
+)
+
+// 本文件提供Go结构体与protobuf消息之间的互相转换。
+// 所有转换函数对nil输入均返回nil。
+
+// CheckingItemsToProto util.CheckingItem切片转换为protobuf消息
+func CheckingItemsToProto(items []*util.CheckingItem) *proto.CheckingItems {
+	out := proto.CheckingItems{
+		Items: make([]*proto.CheckingItem, 0, len(items)),
+	}
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		out.Items = append(out.Items, &proto.CheckingItem{
+			Title:    item.Title,
+			Expected: item.Expected,
+			Actual:   item.Actual,
+			Matched:  item.Matched,
+			Error:    item.Error,
+		})
+	}
+	return &out
+}
+
+// CheckingItemsFromProto protobuf消息转换为util.CheckingItem切片
+func CheckingItemsFromProto(p *proto.CheckingItems) []*util.CheckingItem {
+	if p == nil {
+		return nil
+	}
+	items := make([]*util.CheckingItem, 0, len(p.Items))
+	for _, item := range p.Items {
+		if item == nil {
+			continue
+		}
+		items = append(items, &util.CheckingItem{
+			Title:    item.Title,
+			Expected: item.Expected,
+			Actual:   item.Actual,
+			Matched:  item.Matched,
+			Error:    item.Error,
+		})
+	}
+	return items
+}
+
+// RAIDToProto collector.RAID转换为protobuf消息
+func RAIDToProto(raid *collector.RAID) *proto.RAID {
+	if raid == nil {
+		return nil
+	}
+	out := proto.RAID{
+		Items: make([]*proto.RaidController, 0, len(raid.Items)),
+	}
+	for _, ctrl := range raid.Items {
+		if ctrl == nil {
+			continue
+		}
+		out.Items = append(out.Items, RaidControllerToProto(ctrl))
+	}
+	return &out
+}
+
+// RAIDFromProto protobuf消息转换为collector.RAID
+func RAIDFromProto(p *proto.RAID) *collector.RAID {
+	if p == nil {
+		return nil
+	}
+	raid := collector.RAID{
+		Items: make([]*collector.RaidController, 0, len(p.Items)),
+	}
+	for _, ctrl := range p.Items {
+		if ctrl == nil {
+			continue
+		}
+		raid.Items = append(raid.Items, RaidControllerFromProto(ctrl))
+	}
+	return &raid
+}
+
+// RaidControllerToProto collector.RaidController转换为protobuf消息
+func RaidControllerToProto(ctrl *collector.RaidController) *proto.RaidController {
+	if ctrl == nil {
+		return nil
+	}
+	return &proto.RaidController{
+		Id:              ctrl.ID,
+		Manufacturer:    ctrl.Manufacturer,
+		Model:           ctrl.Model,
+		FirmwareVersion: ctrl.FirmwareVersion,
+		SerialNumber:    ctrl.SerialNumber,
+		PciAddress:      ctrl.PCIAddress,
+		Mode:            ctrl.Mode,
+	}
+}
+
+// RaidControllerFromProto protobuf消息转换为collector.RaidController
+func RaidControllerFromProto(p *proto.RaidController) *collector.RaidController {
+	if p == nil {
+		return nil
+	}
+	return &collector.RaidController{
+		ID:              p.Id,
+		Manufacturer:    p.Manufacturer,
+		Model:           p.Model,
+		FirmwareVersion: p.FirmwareVersion,
+		SerialNumber:    p.SerialNumber,
+		PCIAddress:      p.PciAddress,
+		Mode:            p.Mode,
+	}
+}
+
+// PhysicalDiskToProto collector.PhysicalDisk转换为protobuf消息
+func PhysicalDiskToProto(disk *collector.PhysicalDisk) *proto.PhysicalDisk {
+	if disk == nil {
+		return nil
+	}
+	out := proto.PhysicalDisk{
+		TotalSize: disk.TotalSize,
+		Items:     make([]*proto.PhysicalDrive, 0, len(disk.Items)),
+	}
+	for _, pd := range disk.Items {
+		if pd == nil {
+			continue
+		}
+		out.Items = append(out.Items, PhysicalDriveToProto(pd))
+	}
+	return &out
+}
+
+// PhysicalDiskFromProto protobuf消息转换为collector.PhysicalDisk
+func PhysicalDiskFromProto(p *proto.PhysicalDisk) *collector.PhysicalDisk {
+	if p == nil {
+		return nil
+	}
+	disk := collector.PhysicalDisk{
+		TotalSize: p.TotalSize,
+		Items:     make([]*collector.PhysicalDrive, 0, len(p.Items)),
+	}
+	for _, pd := range p.Items {
+		if pd == nil {
+			continue
+		}
+		disk.Items = append(disk.Items, PhysicalDriveFromProto(pd))
+	}
+	return &disk
+}
+
+// PhysicalDrivesToProto collector.PhysicalDrive切片转换为protobuf消息
+func PhysicalDrivesToProto(pds []*collector.PhysicalDrive) *proto.PhysicalDrives {
+	out := proto.PhysicalDrives{
+		Items: make([]*proto.PhysicalDrive, 0, len(pds)),
+	}
+	for _, pd := range pds {
+		if pd == nil {
+			continue
+		}
+		out.Items = append(out.Items, PhysicalDriveToProto(pd))
+	}
+	return &out
+}
+
+// PhysicalDrivesFromProto protobuf消息转换为collector.PhysicalDrive切片
+func PhysicalDrivesFromProto(p *proto.PhysicalDrives) []*collector.PhysicalDrive {
+	if p == nil {
+		return nil
+	}
+	pds := make([]*collector.PhysicalDrive, 0, len(p.Items))
+	for _, pd := range p.Items {
+		if pd == nil {
+			continue
+		}
+		pds = append(pds, PhysicalDriveFromProto(pd))
+	}
+	return pds
+}
+
+// PhysicalDriveToProto collector.PhysicalDrive转换为protobuf消息
+func PhysicalDriveToProto(pd *collector.PhysicalDrive) *proto.PhysicalDrive {
+	if pd == nil {
+		return nil
+	}
+	return &proto.PhysicalDrive{
+		Location:        pd.Location,
+		Slot:            pd.Slot,
+		Manufacturer:    pd.Manufacturer,
+		Model:           pd.Model,
+		Wwn:             pd.WWN,
+		SerialNumber:    pd.SerialNumber,
+		BusType:         pd.BusType,
+		MediaType:       pd.MediaType,
+		Size:            pd.Size,
+		PartNumber:      pd.PartNumber,
+		FirmwareVersion: pd.FirmwareVersion,
+		ErrorCount:      int32(pd.ErrorCount),
+		TransferSpeed:   pd.TransferSpeed,
+		FirmwareState:   pd.FirmwareState,
+		ForeignState:    pd.ForeignState,
+		InquiryData:     pd.InquiryData,
+		ControllerID:    pd.ControllerID,
+	}
+}
+
+// PhysicalDriveFromProto protobuf消息转换为collector.PhysicalDrive
+func PhysicalDriveFromProto(p *proto.PhysicalDrive) *collector.PhysicalDrive {
+	if p == nil {
+		return nil
+	}
+	return &collector.PhysicalDrive{
+		Location:        p.Location,
+		Slot:            p.Slot,
+		Manufacturer:    p.Manufacturer,
+		Model:           p.Model,
+		WWN:             p.Wwn,
+		SerialNumber:    p.SerialNumber,
+		BusType:         p.BusType,
+		MediaType:       p.MediaType,
+		Size:            p.Size,
+		PartNumber:      p.PartNumber,
+		FirmwareVersion: p.FirmwareVersion,
+		ErrorCount:      int(p.ErrorCount),
+		TransferSpeed:   p.TransferSpeed,
+		FirmwareState:   p.FirmwareState,
+		ForeignState:    p.ForeignState,
+		InquiryData:     p.InquiryData,
+		ControllerID:    p.ControllerID,
+	}
+}
+
+// OOBToProto collector.OOB转换为protobuf消息
+func OOBToProto(info *collector.OOB) *proto.OOB {
+	if info == nil {
+		return nil
+	}
+	out := proto.OOB{
+		FirmwareVersion: info.FirmwareVersion,
+		User:            make([]*proto.OOBUser, 0, len(info.User)),
+	}
+	if info.Network != nil {
+		out.Network = &proto.OOBNetwork{
+			IpSrc:   info.Network.IPSrc,
+			Ip:      info.Network.IP,
+			Mac:     info.Network.MAC,
+			Netmask: info.Network.Netmask,
+			Gateway: info.Network.Gateway,
+		}
+	}
+	for _, user := range info.User {
+		if user == nil {
+			continue
+		}
+		out.User = append(out.User, &proto.OOBUser{
+			Id:             int32(user.ID),
+			Name:           user.Name,
+			PrivilegeLevel: int32(user.PrivilegeLevel),
+		})
+	}
+	return &out
+}
+
+// OOBFromProto protobuf消息转换为collector.OOB
+func OOBFromProto(p *proto.OOB) *collector.OOB {
+	if p == nil {
+		return nil
+	}
+	info := collector.OOB{
+		FirmwareVersion: p.FirmwareVersion,
+		User:            make([]*collector.OOBUser, 0, len(p.User)),
+	}
+	if p.Network != nil {
+		info.Network = &collector.OOBNetwork{
+			IPSrc:   p.Network.IpSrc,
+			IP:      p.Network.Ip,
+			MAC:     p.Network.Mac,
+			Netmask: p.Network.Netmask,
+			Gateway: p.Network.Gateway,
+		}
+	}
+	for _, user := range p.User {
+		if user == nil {
+			continue
+		}
+		info.User = append(info.User, &collector.OOBUser{
+			ID:             int(user.Id),
+			Name:           user.Name,
+			PrivilegeLevel: int(user.PrivilegeLevel),
+		})
+	}
+	return &info
+}
+
+// FRUDeviceToProto oob.FRUDevice转换为protobuf消息
+func FRUDeviceToProto(fd *oob.FRUDevice) *proto.FRUDevice {
+	if fd == nil {
+		return nil
+	}
+	return &proto.FRUDevice{
+		ProductManufacturer: fd.ProductManufacturer,
+		ProductName:         fd.ProductName,
+		ProductSerial:       fd.ProductSerial,
+	}
+}
+
+// FRUDeviceFromProto protobuf消息转换为oob.FRUDevice
+func FRUDeviceFromProto(p *proto.FRUDevice) *oob.FRUDevice {
+	if p == nil {
+		return nil
+	}
+	return &oob.FRUDevice{
+		ProductManufacturer: p.ProductManufacturer,
+		ProductName:         p.ProductName,
+		ProductSerial:       p.ProductSerial,
+	}
+}
+
+// BMCToProto oob.BMC转换为protobuf消息
+func BMCToProto(bmc *oob.BMC) *proto.BMC {
+	if bmc == nil {
+		return nil
+	}
+	return &proto.BMC{
+		FirmwareReversion: bmc.FirmwareReversion,
+		IpmiVersion:       bmc.IPMIVersion,
+		ManufacturerID:    bmc.ManufacturerID,
+		ManufacturerName:  bmc.ManufacturerName,
+	}
+}
+
+// BMCFromProto protobuf消息转换为oob.BMC
+func BMCFromProto(p *proto.BMC) *oob.BMC {
+	if p == nil {
+		return nil
+	}
+	return &oob.BMC{
+		FirmwareReversion: p.FirmwareReversion,
+		IPMIVersion:       p.IpmiVersion,
+		ManufacturerID:    p.ManufacturerID,
+		ManufacturerName:  p.ManufacturerName,
+	}
+}
+
+// NetworkToProto oob.Network转换为protobuf消息
+func NetworkToProto(network *oob.Network) *proto.Network {
+	if network == nil {
+		return nil
+	}
+	return &proto.Network{
+		IpSrc:   network.IPSrc,
+		Mac:     network.MAC,
+		Ip:      network.IP,
+		Netmask: network.Netmask,
+		Gateway: network.Gateway,
+	}
+}
+
+// NetworkFromProto protobuf消息转换为oob.Network
+func NetworkFromProto(p *proto.Network) *oob.Network {
+	if p == nil {
+		return nil
+	}
+	return &oob.Network{
+		IPSrc:   p.IpSrc,
+		MAC:     p.Mac,
+		IP:      p.Ip,
+		Netmask: p.Netmask,
+		Gateway: p.Gateway,
+	}
+}
+
+// UsersToProto oob.User切片转换为protobuf消息
+func UsersToProto(users []*oob.User) *proto.Users {
+	out := proto.Users{
+		Items: make([]*proto.User, 0, len(users)),
+	}
+	for _, user := range users {
+		if user == nil {
+			continue
+		}
+		item := proto.User{
+			Channel: int32(user.Channel),
+			Id:      int32(user.ID),
+			Name:    user.Name,
+		}
+		if user.Access != nil {
+			item.Access = &proto.UserAccess{
+				UserID:             int32(user.Access.UserID),
+				UserName:           user.Access.UserName,
+				FixedName:          user.Access.FixedName,
+				AccessAvailable:    user.Access.AccessAvailable,
+				LinkAuthentication: user.Access.LinkAuthentication,
+				IpmiMessaging:      user.Access.IPMIMessaging,
+				PrivilegeLevel:     int32(user.Access.PrivilegeLevel),
+			}
+		}
+		out.Items = append(out.Items, &item)
+	}
+	return &out
+}
+
+// UsersFromProto protobuf消息转换为oob.User切片
+func UsersFromProto(p *proto.Users) []*oob.User {
+	if p == nil {
+		return nil
+	}
+	users := make([]*oob.User, 0, len(p.Items))
+	for _, item := range p.Items {
+		if item == nil {
+			continue
+		}
+		user := oob.User{
+			Channel: int(item.Channel),
+			ID:      int(item.Id),
+			Name:    item.Name,
+		}
+		if item.Access != nil {
+			user.Access = &oob.UserAccess{
+				UserID:             int(item.Access.UserID),
+				UserName:           item.Access.UserName,
+				FixedName:          item.Access.FixedName,
+				AccessAvailable:    item.Access.AccessAvailable,
+				LinkAuthentication: item.Access.LinkAuthentication,
+				IPMIMessaging:      item.Access.IpmiMessaging,
+				PrivilegeLevel:     int(item.Access.PrivilegeLevel),
+			}
+		}
+		users = append(users, &user)
+	}
+	return users
+}
+
+// SensorDevicesToProto oob.SensorDevice切片转换为protobuf消息
+func SensorDevicesToProto(items []*oob.SensorDevice) *proto.SensorDevices {
+	out := proto.SensorDevices{
+		Items: make([]*proto.SensorDevice, 0, len(items)),
+	}
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		out.Items = append(out.Items, &proto.SensorDevice{
+			Name:     item.Name,
+			Value:    item.Value,
+			Units:    item.Units,
+			State:    item.State,
+			Lonorec:  item.Lonorec,
+			Locrit:   item.Locrit,
+			Lonocrit: item.Lonocrit,
+			Upcrit:   item.Upcrit,
+			Upnocrit: item.Upnocrit,
+			Upnorec:  item.Upnorec,
+		})
+	}
+	return &out
+}
+
+// SensorDevicesFromProto protobuf消息转换为oob.SensorDevice切片
+func SensorDevicesFromProto(p *proto.SensorDevices) []*oob.SensorDevice {
+	if p == nil {
+		return nil
+	}
+	items := make([]*oob.SensorDevice, 0, len(p.Items))
+	for _, item := range p.Items {
+		if item == nil {
+			continue
+		}
+		items = append(items, &oob.SensorDevice{
+			Name:     item.Name,
+			Value:    item.Value,
+			Units:    item.Units,
+			State:    item.State,
+			Lonorec:  item.Lonorec,
+			Locrit:   item.Locrit,
+			Lonocrit: item.Lonocrit,
+			Upcrit:   item.Upcrit,
+			Upnocrit: item.Upnocrit,
+			Upnorec:  item.Upnorec,
+		})
+	}
+	return items
+}
+
+// EventLogsToProto oob.EventLog切片转换为protobuf消息
+func EventLogsToProto(items []*oob.EventLog) *proto.EventLogs {
+	out := proto.EventLogs{
+		Items: make([]*proto.EventLog, 0, len(items)),
+	}
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		var ts int64
+		if !item.Timestamp.IsZero() {
+			ts = item.Timestamp.Unix()
+		}
+		out.Items = append(out.Items, &proto.EventLog{
+			SeqNumber: item.SeqNumber,
+			Timestamp: ts,
+			Severity:  item.Severity,
+			Message:   item.Message,
+		})
+	}
+	return &out
+}
+
+// EventLogsFromProto protobuf消息转换为oob.EventLog切片
+func EventLogsFromProto(p *proto.EventLogs) []*oob.EventLog {
+	if p == nil {
+		return nil
+	}
+	items := make([]*oob.EventLog, 0, len(p.Items))
+	for _, item := range p.Items {
+		if item == nil {
+			continue
+		}
+		log := oob.EventLog{
+			SeqNumber: item.SeqNumber,
+			Severity:  item.Severity,
+			Message:   item.Message,
+		}
+		if item.Timestamp != 0 {
+			log.Timestamp = time.Unix(item.Timestamp, 0).UTC()
+		}
+		items = append(items, &log)
+	}
+	return items
+}
+
+// OobSettingToProto oob.Setting转换为protobuf消息
+func OobSettingToProto(sett *oob.Setting) *proto.OobSetting {
+	if sett == nil {
+		return nil
+	}
+	var out proto.OobSetting
+	if sett.Network != nil {
+		out.Network = &proto.NetworkSetting{
+			IpSrc:   sett.Network.IPSrc,
+			Ip:      sett.Network.StaticIP.IP,
+			Netmask: sett.Network.StaticIP.Netmask,
+			Gateway: sett.Network.StaticIP.Gateway,
+		}
+	}
+	if sett.User != nil {
+		for _, item := range *sett.User {
+			if item == nil {
+				continue
+			}
+			out.User = append(out.User, UserSettingItemToProto(item))
+		}
+	}
+	if sett.BMC != nil {
+		out.Bmc = &proto.BMCSetting{
+			ColdReset: sett.BMC.ColdRest,
+		}
+	}
+	return &out
+}
+
+// OobSettingFromProto protobuf消息转换为oob.Setting
+func OobSettingFromProto(p *proto.OobSetting) *oob.Setting {
+	if p == nil {
+		return nil
+	}
+	var sett oob.Setting
+	if p.Network != nil {
+		sett.Network = &oob.NetworkSetting{
+			IPSrc: p.Network.IpSrc,
+		}
+		sett.Network.StaticIP.IP = p.Network.Ip
+		sett.Network.StaticIP.Netmask = p.Network.Netmask
+		sett.Network.StaticIP.Gateway = p.Network.Gateway
+	}
+	if p.User != nil {
+		users := make(oob.UserSetting, 0, len(p.User))
+		for _, item := range p.User {
+			if item == nil {
+				continue
+			}
+			users = append(users, UserSettingItemFromProto(item))
+		}
+		sett.User = &users
+	}
+	if p.Bmc != nil {
+		sett.BMC = &oob.BMCSetting{
+			ColdRest: p.Bmc.ColdReset,
+		}
+	}
+	return &sett
+}
+
+// UserSettingItemToProto oob.UserSettingItem转换为protobuf消息
+func UserSettingItemToProto(item *oob.UserSettingItem) *proto.UserSettingItem {
+	if item == nil {
+		return nil
+	}
+	return &proto.UserSettingItem{
+		Username:       item.Username,
+		Password:       item.Password,
+		PrivilegeLevel: int32(item.PrivilegeLevel),
+		Status:         item.Status,
+	}
+}
+
+// UserSettingItemFromProto protobuf消息转换为oob.UserSettingItem
+func UserSettingItemFromProto(p *proto.UserSettingItem) *oob.UserSettingItem {
+	if p == nil {
+		return nil
+	}
+	return &oob.UserSettingItem{
+		Username:       p.Username,
+		Password:       p.Password,
+		PrivilegeLevel: int(p.PrivilegeLevel),
+		Status:         p.Status,
+	}
+}
+
+// SnmpSetToProto oob.SnmpSet转换为protobuf消息
+func SnmpSetToProto(set *oob.SnmpSet) *proto.SnmpSet {
+	if set == nil {
+		return nil
+	}
+	out := proto.SnmpSet{
+		Manufacturer:          set.Manufacturer,
+		Devicemodel:           set.Devicemodel,
+		SnmpTrapVersion:       set.SnmpTrapVersion,
+		SnmpV3User:            set.SnmpV3User,
+		SnmpV3AuthPassword:    set.SnmpV3AuthPassword,
+		SnmpV3PrivPassword:    set.SnmpV3PrivPassword,
+		SnmpV3AuthProtocol:    set.SnmpV3AuthProtocol,
+		SnmpV3PrivProtocol:    set.SnmpV3PrivProtocol,
+		SnmpTrapAlarmseverity: set.SnmpTrapAlarmseverity,
+		CommunityName:         set.CommunityName,
+		SnmpTrapEngineId:      int32(set.SnmpTrapEngineId),
+		SnmpTrapSystemName:    set.SnmpTrapSystemName,
+		SnmpTrapSystemId:      int32(set.SnmpTrapSystemId),
+		SnmpTrapLocation:      set.SnmpTrapLocation,
+		SnmpTrapContact:       set.SnmpTrapContact,
+		SnmpTrapHostOs:        set.SnmpTrapHostOs,
+		SnmpTrapPortNo:        int32(set.SnmpTrapPortNo),
+	}
+	for _, server := range set.SnmpTrapServer {
+		if server == nil {
+			continue
+		}
+		out.SnmpTrapServer = append(out.SnmpTrapServer, &proto.SnmpTrapServer{
+			TrapID:              int32(server.TrapID),
+			SnmpTrapPolicy:      server.SnmpTrapPolicy,
+			SnmpTrapChannel:     int32(server.SnmpTrapChannel),
+			SnmpTrapType:        server.SnmpTrapType,
+			SnmpTrapDestination: server.SnmpTrapDestination,
+		})
+	}
+	return &out
+}
+
+// SnmpSetFromProto protobuf消息转换为oob.SnmpSet
+func SnmpSetFromProto(p *proto.SnmpSet) *oob.SnmpSet {
+	if p == nil {
+		return nil
+	}
+	set := oob.SnmpSet{
+		Manufacturer:          p.Manufacturer,
+		Devicemodel:           p.Devicemodel,
+		SnmpTrapVersion:       p.SnmpTrapVersion,
+		SnmpV3User:            p.SnmpV3User,
+		SnmpV3AuthPassword:    p.SnmpV3AuthPassword,
+		SnmpV3PrivPassword:    p.SnmpV3PrivPassword,
+		SnmpV3AuthProtocol:    p.SnmpV3AuthProtocol,
+		SnmpV3PrivProtocol:    p.SnmpV3PrivProtocol,
+		SnmpTrapAlarmseverity: p.SnmpTrapAlarmseverity,
+		CommunityName:         p.CommunityName,
+		SnmpTrapEngineId:      int(p.SnmpTrapEngineId),
+		SnmpTrapSystemName:    p.SnmpTrapSystemName,
+		SnmpTrapSystemId:      int(p.SnmpTrapSystemId),
+		SnmpTrapLocation:      p.SnmpTrapLocation,
+		SnmpTrapContact:       p.SnmpTrapContact,
+		SnmpTrapHostOs:        p.SnmpTrapHostOs,
+		SnmpTrapPortNo:        int(p.SnmpTrapPortNo),
+	}
+	for _, server := range p.SnmpTrapServer {
+		if server == nil {
+			continue
+		}
+		set.SnmpTrapServer = append(set.SnmpTrapServer, &oob.SnmpTrapServer{
+			TrapID:              int(server.TrapID),
+			SnmpTrapPolicy:      server.SnmpTrapPolicy,
+			SnmpTrapChannel:     int(server.SnmpTrapChannel),
+			SnmpTrapType:        server.SnmpTrapType,
+			SnmpTrapDestination: server.SnmpTrapDestination,
+		})
+	}
+	return &set
+}
+
+// BiosSettingToProto bios.Setting转换为protobuf消息
+func BiosSettingToProto(sett *bios.Setting) *proto.BiosSetting {
+	if sett == nil {
+		return nil
+	}
+	return &proto.BiosSetting{
+		Items:     sett.Items,
+		BootOrder: sett.BootOrder,
+	}
+}
+
+// BiosSettingFromProto protobuf消息转换为bios.Setting
+func BiosSettingFromProto(p *proto.BiosSetting) *bios.Setting {
+	if p == nil {
+		return nil
+	}
+	return &bios.Setting{
+		Items:     p.Items,
+		BootOrder: p.BootOrder,
+	}
+}
+
+// FirmwareComponentsToProto firmware.Component切片转换为protobuf消息
+func FirmwareComponentsToProto(components []*firmware.Component) *proto.FirmwareComponents {
+	out := proto.FirmwareComponents{
+		Items: make([]*proto.FirmwareComponent, 0, len(components)),
+	}
+	for _, comp := range components {
+		if comp == nil {
+			continue
+		}
+		out.Items = append(out.Items, &proto.FirmwareComponent{
+			Type:    comp.Type,
+			Id:      comp.ID,
+			Name:    comp.Name,
+			Version: comp.Version,
+		})
+	}
+	return &out
+}
+
+// FirmwareComponentsFromProto protobuf消息转换为firmware.Component切片
+func FirmwareComponentsFromProto(p *proto.FirmwareComponents) []*firmware.Component {
+	if p == nil {
+		return nil
+	}
+	components := make([]*firmware.Component, 0, len(p.Items))
+	for _, comp := range p.Items {
+		if comp == nil {
+			continue
+		}
+		components = append(components, &firmware.Component{
+			Type:    comp.Type,
+			ID:      comp.Id,
+			Name:    comp.Name,
+			Version: comp.Version,
+		})
+	}
+	return components
+}
+
+// FirmwareProgressToProto firmware.Progress转换为protobuf消息
+func FirmwareProgressToProto(progress *firmware.Progress) *proto.FirmwareProgress {
+	if progress == nil {
+		return nil
+	}
+	return &proto.FirmwareProgress{
+		TaskID:  progress.TaskID,
+		Status:  progress.Status,
+		Percent: int32(progress.Percent),
+		Message: progress.Message,
+	}
+}
+
+// FirmwareProgressFromProto protobuf消息转换为firmware.Progress
+func FirmwareProgressFromProto(p *proto.FirmwareProgress) *firmware.Progress {
+	if p == nil {
+		return nil
+	}
+	return &firmware.Progress{
+		TaskID:  p.TaskID,
+		Status:  p.Status,
+		Percent: int(p.Percent),
+		Message: p.Message,
+	}
+}
+
+// FirmwareSettingToProto firmware.Setting转换为protobuf消息
+func FirmwareSettingToProto(sett *firmware.Setting) *proto.FirmwareSetting {
+	if sett == nil {
+		return nil
+	}
+	out := proto.FirmwareSetting{
+		Items: make([]*proto.FirmwareSettingItem, 0, len(sett.Items)),
+	}
+	for _, item := range sett.Items {
+		if item == nil {
+			continue
+		}
+		out.Items = append(out.Items, &proto.FirmwareSettingItem{
+			Type:    item.Type,
+			Id:      item.ID,
+			Version: item.Version,
+			Image:   item.Image,
+		})
+	}
+	return &out
+}
+
+// FirmwareSettingFromProto protobuf消息转换为firmware.Setting
+func FirmwareSettingFromProto(p *proto.FirmwareSetting) *firmware.Setting {
+	if p == nil {
+		return nil
+	}
+	sett := firmware.Setting{
+		Items: make([]*firmware.SettingItem, 0, len(p.Items)),
+	}
+	for _, item := range p.Items {
+		if item == nil {
+			continue
+		}
+		sett.Items = append(sett.Items, &firmware.SettingItem{
+			Type:    item.Type,
+			ID:      item.Id,
+			Version: item.Version,
+			Image:   item.Image,
+		})
+	}
+	return &sett
+}
+
+// LogicalDriveToProto raid.LogicalDrive转换为protobuf消息
+func LogicalDriveToProto(ld *raid.LogicalDrive) *proto.LogicalDrive {
+	if ld == nil {
+		return nil
+	}
+	return &proto.LogicalDrive{
+		Name:        ld.Name,
+		Level:       ld.Level,
+		Drives:      ld.Drives,
+		StripSize:   int32(ld.StripSize),
+		ReadPolicy:  ld.ReadPolicy,
+		WritePolicy: ld.WritePolicy,
+		IoPolicy:    ld.IOPolicy,
+	}
+}
+
+// LogicalDriveFromProto protobuf消息转换为raid.LogicalDrive
+func LogicalDriveFromProto(p *proto.LogicalDrive) *raid.LogicalDrive {
+	if p == nil {
+		return nil
+	}
+	return &raid.LogicalDrive{
+		Name:        p.Name,
+		Level:       p.Level,
+		Drives:      p.Drives,
+		StripSize:   int(p.StripSize),
+		ReadPolicy:  p.ReadPolicy,
+		WritePolicy: p.WritePolicy,
+		IOPolicy:    p.IoPolicy,
+	}
+}
+
+// RaidSettingToProto raid.Setting转换为protobuf消息
+func RaidSettingToProto(sett *raid.Setting) *proto.RaidSetting {
+	if sett == nil {
+		return nil
+	}
+	out := proto.RaidSetting{
+		Controllers: make([]*proto.RaidControllerSetting, 0, len(sett.Controllers)),
+	}
+	for _, ctrl := range sett.Controllers {
+		if ctrl == nil {
+			continue
+		}
+		item := proto.RaidControllerSetting{
+			CtrlID:    ctrl.CtrlID,
+			Clear:     ctrl.Clear,
+			Mode:      ctrl.Mode,
+			Hotspares: ctrl.Hotspares,
+			InitDisk:  ctrl.InitDisk,
+		}
+		for _, ld := range ctrl.LogicalDrives {
+			if ld == nil {
+				continue
+			}
+			item.LogicalDrives = append(item.LogicalDrives, LogicalDriveToProto(ld))
+		}
+		out.Controllers = append(out.Controllers, &item)
+	}
+	return &out
+}
+
+// RaidSettingFromProto protobuf消息转换为raid.Setting
+func RaidSettingFromProto(p *proto.RaidSetting) *raid.Setting {
+	if p == nil {
+		return nil
+	}
+	sett := raid.Setting{
+		Controllers: make([]*raid.ControllerSetting, 0, len(p.Controllers)),
+	}
+	for _, ctrl := range p.Controllers {
+		if ctrl == nil {
+			continue
+		}
+		item := raid.ControllerSetting{
+			CtrlID:    ctrl.CtrlID,
+			Clear:     ctrl.Clear,
+			Mode:      ctrl.Mode,
+			Hotspares: ctrl.Hotspares,
+			InitDisk:  ctrl.InitDisk,
+		}
+		for _, ld := range ctrl.LogicalDrives {
+			if ld == nil {
+				continue
+			}
+			item.LogicalDrives = append(item.LogicalDrives, LogicalDriveFromProto(ld))
+		}
+		sett.Controllers = append(sett.Controllers, &item)
+	}
+	return &sett
+}
@@ -0,0 +1,79 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/bios"
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/raid"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConvert(t *testing.T) {
+	Convey("Go结构体与protobuf消息互相转换", t, func() {
+		Convey("nil输入", func() {
+			So(RAIDToProto(nil), ShouldBeNil)
+			So(RAIDFromProto(nil), ShouldBeNil)
+			So(OobSettingFromProto(nil), ShouldBeNil)
+			So(UsersFromProto(nil), ShouldBeNil)
+			So(BiosSettingFromProto(nil), ShouldBeNil)
+		})
+
+		Convey("RAID", func() {
+			raid := &collector.RAID{
+				Items: []*collector.RaidController{
+					{ID: "0", Manufacturer: "avago", Model: "9560-8i", PCIAddress: "0000:3b:00.0", Mode: "RAID"},
+				},
+			}
+			So(RAIDFromProto(RAIDToProto(raid)), ShouldResemble, raid)
+		})
+
+		Convey("OOB配置参数", func() {
+			sett := &oob.Setting{
+				Network: &oob.NetworkSetting{IPSrc: oob.Static},
+				User: &oob.UserSetting{
+					{Username: "root", Password: "calvin", PrivilegeLevel: oob.AdministratorLevel, Status: oob.EnabledUser},
+				},
+				BMC: &oob.BMCSetting{ColdRest: "ON"},
+			}
+			sett.Network.StaticIP.IP = "10.0.0.10"
+			sett.Network.StaticIP.Netmask = "255.255.255.0"
+			sett.Network.StaticIP.Gateway = "10.0.0.1"
+			So(OobSettingFromProto(OobSettingToProto(sett)), ShouldResemble, sett)
+		})
+
+		Convey("OOB用户", func() {
+			users := []*oob.User{
+				{Channel: 1, ID: 2, Name: "root", Access: &oob.UserAccess{UserID: 2, UserName: "root", PrivilegeLevel: oob.AdministratorLevel}},
+				{Channel: 1, ID: 3, Name: "voidint"},
+			}
+			So(UsersFromProto(UsersToProto(users)), ShouldResemble, users)
+		})
+
+		Convey("OOB事件日志", func() {
+			logs := []*oob.EventLog{
+				{SeqNumber: "1", Timestamp: time.Date(2021, 5, 20, 10, 22, 33, 0, time.UTC), Severity: oob.CriticalSeverity, Message: "Temperature #0x30 | Upper Critical going high | Asserted"},
+				{SeqNumber: "2", Severity: oob.UnknownSeverity, Message: "OEM record e0 | 00112233445566778899aabbcc"},
+			}
+			So(EventLogsFromProto(EventLogsToProto(logs)), ShouldResemble, logs)
+		})
+
+		Convey("逻辑驱动器", func() {
+			ld := &raid.LogicalDrive{
+				Level: raid.RAID10, Drives: []string{"252:0", "252:1", "252:2", "252:3"},
+				StripSize: 256, ReadPolicy: raid.ReadAhead, WritePolicy: raid.WriteBack, IOPolicy: raid.DirectIO,
+			}
+			So(LogicalDriveFromProto(LogicalDriveToProto(ld)), ShouldResemble, ld)
+		})
+
+		Convey("BIOS配置参数", func() {
+			sett := &bios.Setting{
+				Items:     map[string]string{"BootMode": "Uefi", "SriovGlobalEnable": "Enabled"},
+				BootOrder: []string{"NIC.PxeDevice.1-1", "HardDisk.List.1-1"},
+			}
+			So(BiosSettingFromProto(BiosSettingToProto(sett)), ShouldResemble, sett)
+		})
+	})
+}
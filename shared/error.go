@@ -0,0 +1,124 @@
+package shared
+
+import (
+	"context"
+	"errors"
+
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/proto"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toGRPCError 将插件实现返回的错误转换为携带proto.ErrorDetail的gRPC status错误。
+// 在插件进程（server端）使用。
+func toGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := codes.Unknown
+	detail := proto.ErrorDetail{
+		Kind:  proto.ErrorKind_UNKNOWN_ERROR,
+		Cause: err.Error(),
+	}
+
+	switch e := err.(type) {
+	case *oob.UserNotFoundError:
+		code, detail.Kind, detail.Name = codes.NotFound, proto.ErrorKind_USER_NOT_FOUND, e.Name
+	case *oob.IPUnreachableError:
+		code, detail.Kind, detail.Ip = codes.Unavailable, proto.ErrorKind_IP_UNREACHABLE, e.IP()
+		detail.Cause = causeOf(e.Unwrap())
+	case *oob.UsernamePasswordError:
+		code, detail.Kind = codes.Unauthenticated, proto.ErrorKind_USERNAME_PASSWORD
+		detail.Cause = causeOf(e.Unwrap())
+	case *oob.FRUDeviceNotPresentError:
+		code, detail.Kind, detail.Id = codes.NotFound, proto.ErrorKind_FRU_DEVICE_NOT_PRESENT, e.ID()
+		detail.Cause = causeOf(e.Unwrap())
+	case *util.InvalidOptionError:
+		code, detail.Kind, detail.Name, detail.Value = codes.InvalidArgument, proto.ErrorKind_INVALID_OPTION, e.Name, e.Value
+	default:
+		switch {
+		case errors.Is(err, oob.ErrChannelNotFound):
+			code, detail.Kind = codes.NotFound, proto.ErrorKind_CHANNEL_NOT_FOUND
+		case errors.Is(err, oob.ErrUnknownHardware):
+			code, detail.Kind = codes.FailedPrecondition, proto.ErrorKind_UNKNOWN_HARDWARE
+		case errors.Is(err, oob.ErrOOBIPAndSNUnmatched):
+			code, detail.Kind = codes.FailedPrecondition, proto.ErrorKind_OOB_IP_AND_SN_UNMATCHED
+		case errors.Is(err, collector.ErrNotSupported):
+			code, detail.Kind = codes.Unimplemented, proto.ErrorKind_NOT_SUPPORTED
+		case errors.Is(err, context.DeadlineExceeded):
+			return status.Error(codes.DeadlineExceeded, err.Error())
+		case errors.Is(err, context.Canceled):
+			return status.Error(codes.Canceled, err.Error())
+		}
+	}
+
+	st, e := status.New(code, err.Error()).WithDetails(&detail)
+	if e != nil {
+		return status.Error(code, err.Error())
+	}
+	return st.Err()
+}
+
+// fromGRPCError 将gRPC status错误还原为oob、util中定义的具体错误，
+// 使得oob.IsIPUnreachableError等判断函数在调用插件时依然有效。
+// 在宿主进程（client端）使用。不携带proto.ErrorDetail的错误（如传输层错误）原样返回。
+func fromGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, d := range st.Details() {
+		detail, ok := d.(*proto.ErrorDetail)
+		if !ok {
+			continue
+		}
+		cause := errors.New(detail.Cause)
+		switch detail.Kind {
+		case proto.ErrorKind_USER_NOT_FOUND:
+			return oob.NewUserNotFoundError(detail.Name)
+		case proto.ErrorKind_IP_UNREACHABLE:
+			return oob.NewIPUnreachableError(detail.Ip, cause)
+		case proto.ErrorKind_USERNAME_PASSWORD:
+			return oob.NewUsernamePasswordError(cause)
+		case proto.ErrorKind_FRU_DEVICE_NOT_PRESENT:
+			return oob.NewFRUDeviceNotPresentError(detail.Id, cause)
+		case proto.ErrorKind_CHANNEL_NOT_FOUND:
+			return oob.ErrChannelNotFound
+		case proto.ErrorKind_UNKNOWN_HARDWARE:
+			return oob.ErrUnknownHardware
+		case proto.ErrorKind_OOB_IP_AND_SN_UNMATCHED:
+			return oob.ErrOOBIPAndSNUnmatched
+		case proto.ErrorKind_INVALID_OPTION:
+			return util.NewInvalidOptionError(detail.Name, detail.Value)
+		case proto.ErrorKind_NOT_SUPPORTED:
+			return collector.ErrNotSupported
+		default:
+			return cause
+		}
+	}
+	switch st.Code() {
+	case codes.DeadlineExceeded:
+		return context.DeadlineExceeded
+	case codes.Canceled:
+		return context.Canceled
+	}
+	return err
+}
+
+// causeOf 返回原始错误的描述
+func causeOf(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
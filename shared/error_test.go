@@ -0,0 +1,136 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeWorker 仅实现测试所需方法的oob.Worker
+type fakeWorker struct {
+	oob.Worker
+	err error
+}
+
+func (w *fakeWorker) FRUDevice() (*oob.FRUDevice, error) {
+	return nil, w.err
+}
+
+func (w *fakeWorker) EnableUser(username string) error {
+	return oob.NewUserNotFoundError(username)
+}
+
+func (w *fakeWorker) Channel() (int, error) {
+	return 0, oob.ErrChannelNotFound
+}
+
+// dialOobPlugin 启动内存中的gRPC服务并返回带外插件客户端
+func dialOobPlugin(impl OobService) (GRPCOobPluginClientWrapper, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	_ = GRPCOobPlugin{Impl: impl}.GRPCServer(nil, server)
+	go server.Serve(lis)
+
+	conn, _ := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	return GRPCOobPluginClientWrapper{client: proto.NewOobPluginClient(conn)}, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func TestErrorPropagation(t *testing.T) {
+	Convey("插件错误跨gRPC边界传递", t, func() {
+		w := &fakeWorker{}
+		client, stop := dialOobPlugin(w)
+		defer stop()
+
+		Convey("IP不可达", func() {
+			w.err = oob.NewIPUnreachableError("10.0.106.27", errors.New("hello world"))
+			_, err := client.FRUDevice()
+			So(oob.IsIPUnreachableError(err), ShouldBeTrue)
+			So(err.Error(), ShouldEqual, w.err.Error())
+		})
+
+		Convey("用户名密码不匹配", func() {
+			w.err = oob.NewUsernamePasswordError(errors.New("hello world"))
+			_, err := client.FRUDevice()
+			So(oob.IsUsernamePasswordError(err), ShouldBeTrue)
+		})
+
+		Convey("FRU设备不存在", func() {
+			w.err = oob.NewFRUDeviceNotPresentError("0", errors.New("hello world"))
+			_, err := client.FRUDevice()
+			So(oob.IsFRUDeviceNotPresentError(err), ShouldBeTrue)
+			So(err.Error(), ShouldEqual, w.err.Error())
+		})
+
+		Convey("用户不存在", func() {
+			err := client.EnableUser("voidint")
+			So(oob.IsUserNotFoundError(err), ShouldBeTrue)
+			So(err.Error(), ShouldEqual, oob.NewUserNotFoundError("voidint").Error())
+		})
+
+		Convey("预定义错误", func() {
+			_, err := client.Channel()
+			So(err, ShouldEqual, oob.ErrChannelNotFound)
+		})
+
+		Convey("普通错误", func() {
+			w.err = errors.New("exec error: ipmitool not found")
+			_, err := client.FRUDevice()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, w.err.Error())
+		})
+	})
+
+	Convey("错误映射为gRPC状态码", t, func() {
+		So(status.Code(toGRPCError(oob.NewIPUnreachableError("", errors.New("")))), ShouldEqual, codes.Unavailable)
+		So(status.Code(toGRPCError(oob.NewUsernamePasswordError(errors.New("")))), ShouldEqual, codes.Unauthenticated)
+		So(status.Code(toGRPCError(oob.NewUserNotFoundError("root"))), ShouldEqual, codes.NotFound)
+		So(status.Code(toGRPCError(errors.New("unknown"))), ShouldEqual, codes.Unknown)
+		So(toGRPCError(nil), ShouldBeNil)
+	})
+}
+
+// blockingWorker 阻塞直至context结束的oob.Worker
+type blockingWorker struct {
+	oob.Worker
+	ctx context.Context
+}
+
+func (w *blockingWorker) WithContext(ctx context.Context) oob.Worker {
+	return &blockingWorker{ctx: ctx}
+}
+
+func (w *blockingWorker) PowerStatus() (string, error) {
+	<-w.ctx.Done()
+	return "", w.ctx.Err()
+}
+
+func TestContextPropagation(t *testing.T) {
+	Convey("调用方超时传递到插件", t, func() {
+		client, stop := dialOobPlugin(&blockingWorker{ctx: context.Background()})
+		defer stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.WithContext(ctx).PowerStatus()
+		So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+		So(time.Since(start), ShouldBeLessThan, 5*time.Second)
+	})
+}
@@ -0,0 +1,240 @@
+package shared
+
+import (
+	"context"
+	"io"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/licairong/cloudboot-provider-framework/firmware"
+	"github.com/licairong/cloudboot-provider-framework/proto"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// uploadChunkSize 上传固件镜像时单个分块的大小
+const uploadChunkSize = 64 * 1024
+
+// FirmwareService 固件插件服务
+type FirmwareService interface {
+	// Components 返回各部件（BIOS、BMC、RAID控制器、网卡、背板等）当前的固件版本
+	Components() ([]*firmware.Component, error)
+	// Upload 上传固件镜像并返回镜像ID
+	Upload(name string, image io.Reader) (imageID string, err error)
+	// Apply 异步升级指定的固件镜像并返回任务ID
+	Apply(imageID string) (taskID string, err error)
+	// Progress 返回固件升级任务的进度
+	Progress(taskID string) (*firmware.Progress, error)
+	// PostCheck 固件配置实施后置检查
+	PostCheck(sett *firmware.Setting) []*util.CheckingItem
+}
+
+// ContextFirmwareService 支持context的固件插件服务。
+// 通过WithContext返回的实现执行操作时，若context被取消或超时，则终止正在执行的底层命令。
+type ContextFirmwareService interface {
+	FirmwareService
+	WithContext(ctx context.Context) FirmwareService
+}
+
+// GRPCFirmwarePlugin implement plugin.GRPCPlugin
+type GRPCFirmwarePlugin struct {
+	plugin.Plugin
+	Impl FirmwareService
+}
+
+func (p GRPCFirmwarePlugin) GRPCServer(broker *plugin.GRPCBroker, server *grpc.Server) error {
+	proto.RegisterFirmwarePluginServer(server, GRPCFirmwarePluginServerWrapper{impl: p.Impl})
+	return nil
+}
+
+func (p GRPCFirmwarePlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, conn *grpc.ClientConn) (interface{}, error) {
+	return GRPCFirmwarePluginClientWrapper{client: proto.NewFirmwarePluginClient(conn)}, nil
+}
+
+type GRPCFirmwarePluginServerWrapper struct {
+	impl FirmwareService
+	proto.UnimplementedFirmwarePluginServer
+}
+
+// with 若插件实现支持context，则返回绑定了请求context的实现，使调用方的超时及取消能够传递到插件。
+func (_this GRPCFirmwarePluginServerWrapper) with(ctx context.Context) FirmwareService {
+	if impl, ok := _this.impl.(ContextFirmwareService); ok {
+		return impl.WithContext(ctx)
+	}
+	return _this.impl
+}
+
+func (_this GRPCFirmwarePluginServerWrapper) Components(ctx context.Context, request *proto.Request) (*proto.FirmwareComponents, error) {
+	components, err := _this.with(ctx).Components()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return FirmwareComponentsToProto(components), nil
+}
+
+// Upload 接收客户端流式上传的固件镜像分块，并以io.Reader的形式交由插件实现读取。
+func (_this GRPCFirmwarePluginServerWrapper) Upload(stream proto.FirmwarePlugin_UploadServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		return status.Error(codes.InvalidArgument, "empty firmware image")
+	}
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		data := first.Data
+		for {
+			if _, err := pw.Write(data); err != nil {
+				return
+			}
+			chunk, err := stream.Recv()
+			if err == io.EOF {
+				_ = pw.Close()
+				return
+			}
+			if err != nil {
+				_ = pw.CloseWithError(err)
+				return
+			}
+			data = chunk.Data
+		}
+	}()
+
+	imageID, err := _this.with(stream.Context()).Upload(first.Name, pr)
+	_ = pr.Close()
+	if err != nil {
+		return toGRPCError(err)
+	}
+	return stream.SendAndClose(&proto.UploadResponse{ImageID: imageID})
+}
+
+func (_this GRPCFirmwarePluginServerWrapper) Apply(ctx context.Context, request *proto.ApplyRequest) (*proto.ApplyResponse, error) {
+	taskID, err := _this.with(ctx).Apply(request.ImageID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &proto.ApplyResponse{TaskID: taskID}, nil
+}
+
+func (_this GRPCFirmwarePluginServerWrapper) Progress(ctx context.Context, request *proto.ProgressRequest) (*proto.FirmwareProgress, error) {
+	progress, err := _this.with(ctx).Progress(request.TaskID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	if progress == nil {
+		progress = &firmware.Progress{TaskID: request.TaskID}
+	}
+	return FirmwareProgressToProto(progress), nil
+}
+
+func (_this GRPCFirmwarePluginServerWrapper) PostCheck(ctx context.Context, request *proto.FirmwareSetting) (*proto.CheckingItems, error) {
+	return CheckingItemsToProto(_this.with(ctx).PostCheck(FirmwareSettingFromProto(request))), nil
+}
+
+// GRPCFirmwarePluginClientWrapper 作为server 调用插件接口的包装器，
+type GRPCFirmwarePluginClientWrapper struct {
+	client proto.FirmwarePluginClient
+	ctx    context.Context
+}
+
+var _ ContextFirmwareService = GRPCFirmwarePluginClientWrapper{}
+
+// WithContext 返回绑定了context的包装器，context的超时及取消将传递到插件进程。
+func (_this GRPCFirmwarePluginClientWrapper) WithContext(ctx context.Context) FirmwareService {
+	_this.ctx = ctx
+	return _this
+}
+
+func (_this GRPCFirmwarePluginClientWrapper) context() context.Context {
+	if _this.ctx != nil {
+		return _this.ctx
+	}
+	return context.Background()
+}
+
+func (_this GRPCFirmwarePluginClientWrapper) Components() ([]*firmware.Component, error) {
+	resp, err := _this.client.Components(_this.context(), &proto.Request{})
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return FirmwareComponentsFromProto(resp), nil
+}
+
+// Upload 将固件镜像分块流式上传至插件。读取镜像出错时终止上传。
+func (_this GRPCFirmwarePluginClientWrapper) Upload(name string, image io.Reader) (string, error) {
+	ctx, cancel := context.WithCancel(_this.context())
+	defer cancel()
+
+	stream, err := _this.client.Upload(ctx)
+	if err != nil {
+		return "", fromGRPCError(err)
+	}
+
+	buf := make([]byte, uploadChunkSize)
+	chunk := proto.ImageChunk{Name: name}
+	for {
+		n, rerr := image.Read(buf)
+		if n > 0 || chunk.Name != "" {
+			chunk.Data = buf[:n]
+			// 服务端提前结束时Send返回io.EOF，真实错误由CloseAndRecv返回。
+			if err = stream.Send(&chunk); err == io.EOF {
+				break
+			} else if err != nil {
+				return "", fromGRPCError(err)
+			}
+			chunk.Name = ""
+		}
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			return "", rerr
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		return "", fromGRPCError(err)
+	}
+	return resp.ImageID, nil
+}
+
+func (_this GRPCFirmwarePluginClientWrapper) Apply(imageID string) (string, error) {
+	in := proto.ApplyRequest{ImageID: imageID}
+	resp, err := _this.client.Apply(_this.context(), &in)
+	if err != nil {
+		return "", fromGRPCError(err)
+	}
+	return resp.TaskID, nil
+}
+
+func (_this GRPCFirmwarePluginClientWrapper) Progress(taskID string) (*firmware.Progress, error) {
+	in := proto.ProgressRequest{TaskID: taskID}
+	resp, err := _this.client.Progress(_this.context(), &in)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return FirmwareProgressFromProto(resp), nil
+}
+
+// PostCheck 固件配置实施后置检查。调用插件失败时返回一条未知匹配结果的检查项。
+func (_this GRPCFirmwarePluginClientWrapper) PostCheck(sett *firmware.Setting) []*util.CheckingItem {
+	in := FirmwareSettingToProto(sett)
+	if in == nil {
+		in = &proto.FirmwareSetting{}
+	}
+	resp, err := _this.client.PostCheck(_this.context(), in)
+	if err != nil {
+		return []*util.CheckingItem{
+			{
+				Title:   "PostCheck",
+				Matched: util.MatchedUnknown,
+				Error:   fromGRPCError(err).Error(),
+			},
+		}
+	}
+	return CheckingItemsFromProto(resp)
+}
@@ -0,0 +1,99 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+
+	"github.com/licairong/cloudboot-provider-framework/firmware"
+	"github.com/licairong/cloudboot-provider-framework/proto"
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeFirmware 将上传的镜像保存在内存中的FirmwareService
+type fakeFirmware struct {
+	name  string
+	image []byte
+}
+
+func (f *fakeFirmware) Components() ([]*firmware.Component, error) {
+	return []*firmware.Component{{Type: firmware.BMC, Name: "iDRAC", Version: "2.81.81.81"}}, nil
+}
+
+func (f *fakeFirmware) Upload(name string, image io.Reader) (string, error) {
+	data, err := ioutil.ReadAll(image)
+	if err != nil {
+		return "", err
+	}
+	f.name, f.image = name, data
+	return "img-1", nil
+}
+
+func (f *fakeFirmware) Apply(imageID string) (string, error) {
+	return "task-" + imageID, nil
+}
+
+func (f *fakeFirmware) Progress(taskID string) (*firmware.Progress, error) {
+	return &firmware.Progress{TaskID: taskID, Status: firmware.StatusRunning, Percent: 40}, nil
+}
+
+func (f *fakeFirmware) PostCheck(sett *firmware.Setting) []*util.CheckingItem {
+	components, _ := f.Components()
+	return firmware.PostCheck(components, sett)
+}
+
+// dialFirmwarePlugin 启动内存中的gRPC服务并返回固件插件客户端
+func dialFirmwarePlugin(impl FirmwareService) (GRPCFirmwarePluginClientWrapper, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	_ = GRPCFirmwarePlugin{Impl: impl}.GRPCServer(nil, server)
+	go server.Serve(lis)
+
+	conn, _ := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	return GRPCFirmwarePluginClientWrapper{client: proto.NewFirmwarePluginClient(conn)}, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func TestFirmwarePlugin(t *testing.T) {
+	Convey("固件插件", t, func() {
+		impl := new(fakeFirmware)
+		client, stop := dialFirmwarePlugin(impl)
+		defer stop()
+
+		Convey("分块上传固件镜像", func() {
+			image := bytes.Repeat([]byte("firmware"), uploadChunkSize/3)
+			imageID, err := client.Upload("BMC.d9", bytes.NewReader(image))
+			So(err, ShouldBeNil)
+			So(imageID, ShouldEqual, "img-1")
+			So(impl.name, ShouldEqual, "BMC.d9")
+			So(impl.image, ShouldResemble, image)
+		})
+
+		Convey("升级固件并查询进度", func() {
+			taskID, err := client.Apply("img-1")
+			So(err, ShouldBeNil)
+			progress, err := client.Progress(taskID)
+			So(err, ShouldBeNil)
+			So(progress, ShouldResemble, &firmware.Progress{TaskID: "task-img-1", Status: firmware.StatusRunning, Percent: 40})
+		})
+
+		Convey("后置检查", func() {
+			items := client.PostCheck(&firmware.Setting{Items: []*firmware.SettingItem{
+				{Type: firmware.BMC, Version: "2.81.81.81"},
+			}})
+			So(len(items), ShouldEqual, 1)
+			So(items[0].Matched, ShouldEqual, util.MatchedYES)
+		})
+	})
+}
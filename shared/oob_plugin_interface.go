@@ -2,19 +2,36 @@ package shared
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/go-plugin"
+	"github.com/licairong/cloudboot-provider-framework/collector"
+	"github.com/licairong/cloudboot-provider-framework/oob"
 	"github.com/licairong/cloudboot-provider-framework/proto"
+	"github.com/licairong/cloudboot-provider-framework/util"
 	"google.golang.org/grpc"
+	"os"
 )
 
+// OobService 带外插件服务，覆盖oob.Worker的全部能力。
 type OobService interface {
-	OOB() (string, error)
-	PowerReset() (string, error)
+	oob.Worker
+}
+
+// ContextOobService 支持context的带外插件服务
+type ContextOobService interface {
+	OobService
+	oob.ContextWorker
+}
+
+// OOBCollector 汇总采集带外信息。插件客户端实现该接口，调用方据此断言，无需依赖具体的客户端类型。
+type OOBCollector interface {
+	OOB() (*collector.OOB, error)
 }
 
 type GRPCOobPlugin struct {
 	plugin.Plugin
 	Impl OobService
+	Log  util.Logger // 客户端记录调用错误的日志实例，为nil时输出至标准错误。
 }
 
 func (p GRPCOobPlugin) GRPCServer(broker *plugin.GRPCBroker, server *grpc.Server) error {
@@ -23,7 +40,7 @@ func (p GRPCOobPlugin) GRPCServer(broker *plugin.GRPCBroker, server *grpc.Server
 }
 
 func (p GRPCOobPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, conn *grpc.ClientConn) (interface{}, error) {
-	return GRPCOobPluginClientWrapper{client: proto.NewOobPluginClient(conn)}, nil
+	return GRPCOobPluginClientWrapper{client: proto.NewOobPluginClient(conn), log: p.Log}, nil
 }
 
 type GRPCOobPluginServerWrapper struct {
@@ -31,40 +48,439 @@ type GRPCOobPluginServerWrapper struct {
 	proto.UnimplementedOobPluginServer
 }
 
-func (_this GRPCOobPluginServerWrapper) OOB(ctx context.Context, request *proto.Request) (*proto.Response, error) {
-	r, _ := _this.impl.OOB()
+// with 若插件实现支持context，则返回绑定了请求context的实现，使调用方的超时及取消能够传递到插件。
+func (_this GRPCOobPluginServerWrapper) with(ctx context.Context) oob.Worker {
+	if impl, ok := _this.impl.(oob.ContextWorker); ok {
+		return impl.WithContext(ctx)
+	}
+	return _this.impl
+}
+
+// OOB 汇总带外网络、用户及BMC固件版本信息
+func (_this GRPCOobPluginServerWrapper) OOB(ctx context.Context, request *proto.Request) (*proto.OOB, error) {
+	var info collector.OOB
+	impl := _this.with(ctx)
+	network, err := impl.Network()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	info.Network = &collector.OOBNetwork{
+		IPSrc:   network.IPSrc,
+		IP:      network.IP,
+		MAC:     network.MAC,
+		Netmask: network.Netmask,
+		Gateway: network.Gateway,
+	}
+	users, err := impl.Users()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	for i := range users {
+		user := collector.OOBUser{
+			ID:   users[i].ID,
+			Name: users[i].Name,
+		}
+		if users[i].Access != nil {
+			user.PrivilegeLevel = users[i].Access.PrivilegeLevel
+		}
+		info.User = append(info.User, &user)
+	}
+	bmc, err := impl.BMC()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	if bmc != nil {
+		info.FirmwareVersion = bmc.FirmwareReversion
+	}
+	return OOBToProto(&info), nil
+}
+
+func (_this GRPCOobPluginServerWrapper) Name(ctx context.Context, request *proto.Request) (*proto.Response, error) {
 	return &proto.Response{
-		Result: r,
+		Result: _this.with(ctx).Name(),
 	}, nil
 }
 
-func (_this GRPCOobPluginServerWrapper) PowerReset(ctx context.Context, request *proto.Request) (*proto.Response, error) {
-	r, _ := _this.impl.PowerReset()
+func (_this GRPCOobPluginServerWrapper) FRUDevice(ctx context.Context, request *proto.Request) (*proto.FRUDevice, error) {
+	fd, err := _this.with(ctx).FRUDevice()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return FRUDeviceToProto(fd), nil
+}
+
+func (_this GRPCOobPluginServerWrapper) ValidateSN(ctx context.Context, request *proto.ValidateSNRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).ValidateSN(request.Sn))
+}
+
+func (_this GRPCOobPluginServerWrapper) PowerStatus(ctx context.Context, request *proto.Request) (*proto.Response, error) {
+	status, err := _this.with(ctx).PowerStatus()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
 	return &proto.Response{
-		Result: r,
+		Result: status,
+	}, nil
+}
+
+func (_this GRPCOobPluginServerWrapper) PowerOn(ctx context.Context, request *proto.Request) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).PowerOn())
+}
+
+func (_this GRPCOobPluginServerWrapper) PowerOff(ctx context.Context, request *proto.Request) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).PowerOff())
+}
+
+func (_this GRPCOobPluginServerWrapper) PowerReset(ctx context.Context, request *proto.Request) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).PowerReset())
+}
+
+func (_this GRPCOobPluginServerWrapper) PXEBoot(ctx context.Context, request *proto.PXEBootRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).PXEBoot(request.Uefi, request.Manufacturer))
+}
+
+func (_this GRPCOobPluginServerWrapper) Channel(ctx context.Context, request *proto.Request) (*proto.ChannelResponse, error) {
+	channel, err := _this.with(ctx).Channel()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &proto.ChannelResponse{
+		Channel: int32(channel),
+	}, nil
+}
+
+func (_this GRPCOobPluginServerWrapper) PostCheck(ctx context.Context, request *proto.OobSetting) (*proto.CheckingItems, error) {
+	return CheckingItemsToProto(_this.with(ctx).PostCheck(OobSettingFromProto(request))), nil
+}
+
+func (_this GRPCOobPluginServerWrapper) Raw(ctx context.Context, request *proto.RawRequest) (*proto.RawResponse, error) {
+	r, err := _this.with(ctx).Raw(request.Args)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &proto.RawResponse{
+		Response: r,
 	}, nil
 }
 
+func (_this GRPCOobPluginServerWrapper) SelClear(ctx context.Context, request *proto.Request) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).SelClear())
+}
+
+func (_this GRPCOobPluginServerWrapper) EventLogs(ctx context.Context, request *proto.EventLogsRequest) (*proto.EventLogs, error) {
+	items, err := _this.with(ctx).EventLogs(int(request.Since))
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return EventLogsToProto(items), nil
+}
+
+func (_this GRPCOobPluginServerWrapper) SensorList(ctx context.Context, request *proto.Request) (*proto.SensorDevices, error) {
+	items, err := _this.with(ctx).SensorList()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return SensorDevicesToProto(items), nil
+}
+
+func (_this GRPCOobPluginServerWrapper) SetSnmpTrap(ctx context.Context, request *proto.SnmpSet) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).SetSnmpTrap(SnmpSetFromProto(request)))
+}
+
+func (_this GRPCOobPluginServerWrapper) SetDHCP(ctx context.Context, request *proto.Request) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).SetDHCP())
+}
+
+func (_this GRPCOobPluginServerWrapper) SetStaticIP(ctx context.Context, request *proto.SetStaticIPRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).SetStaticIP(request.Ip, request.Netmask, request.Gateway))
+}
+
+func (_this GRPCOobPluginServerWrapper) Network(ctx context.Context, request *proto.Request) (*proto.Network, error) {
+	network, err := _this.with(ctx).Network()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return NetworkToProto(network), nil
+}
+
+func (_this GRPCOobPluginServerWrapper) ChangeUserPassword(ctx context.Context, request *proto.ChangeUserPasswordRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).ChangeUserPassword(request.Username, request.Password))
+}
+
+func (_this GRPCOobPluginServerWrapper) GenerateUser(ctx context.Context, request *proto.UserSettingItem) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).GenerateUser(UserSettingItemFromProto(request)))
+}
+
+func (_this GRPCOobPluginServerWrapper) EnableUser(ctx context.Context, request *proto.UserRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).EnableUser(request.Username))
+}
+
+func (_this GRPCOobPluginServerWrapper) DisableUser(ctx context.Context, request *proto.UserRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).DisableUser(request.Username))
+}
+
+func (_this GRPCOobPluginServerWrapper) Users(ctx context.Context, request *proto.Request) (*proto.Users, error) {
+	users, err := _this.with(ctx).Users()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return UsersToProto(users), nil
+}
+
+func (_this GRPCOobPluginServerWrapper) BMC(ctx context.Context, request *proto.Request) (*proto.BMC, error) {
+	bmc, err := _this.with(ctx).BMC()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return BMCToProto(bmc), nil
+}
+
+func (_this GRPCOobPluginServerWrapper) BMCColdReset(ctx context.Context, request *proto.Request) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).BMCColdReset())
+}
+
+// GRPCOobPluginClientWrapper 作为server 调用插件接口的包装器，
 type GRPCOobPluginClientWrapper struct {
 	client proto.OobPluginClient
+	ctx    context.Context
+	log    util.Logger
+}
+
+var _ ContextOobService = GRPCOobPluginClientWrapper{}
+
+// WithContext 返回绑定了context的包装器，context的超时及取消将传递到插件进程。
+func (_this GRPCOobPluginClientWrapper) WithContext(ctx context.Context) oob.Worker {
+	_this.ctx = ctx
+	return _this
+}
+
+func (_this GRPCOobPluginClientWrapper) context() context.Context {
+	if _this.ctx != nil {
+		return _this.ctx
+	}
+	return context.Background()
 }
 
-func (_this GRPCOobPluginClientWrapper) OOB() (string, error) {
+// OOB 返回带外汇总信息
+func (_this GRPCOobPluginClientWrapper) OOB() (*collector.OOB, error) {
 	in := proto.Request{}
-	resp, err := _this.client.OOB(context.Background(), &in)
+	resp, err := _this.client.OOB(_this.context(), &in)
 	if err != nil {
-		return "", err
+		return nil, fromGRPCError(err)
 	} else {
-		return resp.Result, nil
+		return OOBFromProto(resp), nil
 	}
 }
 
-func (_this GRPCOobPluginClientWrapper) PowerReset() (string, error) {
+func (_this GRPCOobPluginClientWrapper) Name() string {
 	in := proto.Request{}
-	resp, err := _this.client.PowerReset(context.Background(), &in)
+	resp, err := _this.client.Name(_this.context(), &in)
 	if err != nil {
-		return "", err
-	} else {
-		return resp.Result, nil
+		// Name无法返回error，记录错误以免插件进程异常被掩盖。
+		if _this.log != nil {
+			_this.log.Errorf("oob plugin: Name: %s", fromGRPCError(err))
+		} else {
+			fmt.Fprintf(os.Stderr, "oob plugin: Name: %s\n", fromGRPCError(err))
+		}
+		return ""
+	}
+	return resp.Result
+}
+
+func (_this GRPCOobPluginClientWrapper) FRUDevice() (*oob.FRUDevice, error) {
+	in := proto.Request{}
+	resp, err := _this.client.FRUDevice(_this.context(), &in)
+	if err != nil {
+		return nil, fromGRPCError(err)
 	}
+	return FRUDeviceFromProto(resp), nil
+}
+
+func (_this GRPCOobPluginClientWrapper) ValidateSN(sn string) error {
+	in := proto.ValidateSNRequest{Sn: sn}
+	_, err := _this.client.ValidateSN(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) PowerStatus() (string, error) {
+	in := proto.Request{}
+	resp, err := _this.client.PowerStatus(_this.context(), &in)
+	if err != nil {
+		return "", fromGRPCError(err)
+	}
+	return resp.Result, nil
+}
+
+func (_this GRPCOobPluginClientWrapper) PowerOn() error {
+	in := proto.Request{}
+	_, err := _this.client.PowerOn(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) PowerOff() error {
+	in := proto.Request{}
+	_, err := _this.client.PowerOff(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) PowerReset() error {
+	in := proto.Request{}
+	_, err := _this.client.PowerReset(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) PXEBoot(uefi bool, manufacturer string) error {
+	in := proto.PXEBootRequest{Uefi: uefi, Manufacturer: manufacturer}
+	_, err := _this.client.PXEBoot(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) Channel() (int, error) {
+	in := proto.Request{}
+	resp, err := _this.client.Channel(_this.context(), &in)
+	if err != nil {
+		return 0, fromGRPCError(err)
+	}
+	return int(resp.Channel), nil
+}
+
+// PostCheck OOB配置实施后置检查。调用插件失败时返回一条未知匹配结果的检查项。
+func (_this GRPCOobPluginClientWrapper) PostCheck(sett *oob.Setting) []*util.CheckingItem {
+	items, err := _this.postCheck(sett)
+	if err != nil {
+		return []*util.CheckingItem{
+			{
+				Title:   "PostCheck",
+				Matched: util.MatchedUnknown,
+				Error:   err.Error(),
+			},
+		}
+	}
+	return items
+}
+
+func (_this GRPCOobPluginClientWrapper) postCheck(sett *oob.Setting) ([]*util.CheckingItem, error) {
+	in := OobSettingToProto(sett)
+	if in == nil {
+		in = &proto.OobSetting{}
+	}
+	resp, err := _this.client.PostCheck(_this.context(), in)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return CheckingItemsFromProto(resp), nil
+}
+
+func (_this GRPCOobPluginClientWrapper) Raw(args string) ([]byte, error) {
+	in := proto.RawRequest{Args: args}
+	resp, err := _this.client.Raw(_this.context(), &in)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return resp.Response, nil
+}
+
+func (_this GRPCOobPluginClientWrapper) SelClear() error {
+	in := proto.Request{}
+	_, err := _this.client.SelClear(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) EventLogs(since int) ([]*oob.EventLog, error) {
+	in := proto.EventLogsRequest{Since: int32(since)}
+	resp, err := _this.client.EventLogs(_this.context(), &in)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return EventLogsFromProto(resp), nil
+}
+
+func (_this GRPCOobPluginClientWrapper) SensorList() ([]*oob.SensorDevice, error) {
+	in := proto.Request{}
+	resp, err := _this.client.SensorList(_this.context(), &in)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return SensorDevicesFromProto(resp), nil
+}
+
+func (_this GRPCOobPluginClientWrapper) SetSnmpTrap(set *oob.SnmpSet) error {
+	in := SnmpSetToProto(set)
+	if in == nil {
+		in = &proto.SnmpSet{}
+	}
+	_, err := _this.client.SetSnmpTrap(_this.context(), in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) SetDHCP() error {
+	in := proto.Request{}
+	_, err := _this.client.SetDHCP(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) SetStaticIP(ip, netmask, gateway string) error {
+	in := proto.SetStaticIPRequest{Ip: ip, Netmask: netmask, Gateway: gateway}
+	_, err := _this.client.SetStaticIP(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) Network() (*oob.Network, error) {
+	in := proto.Request{}
+	resp, err := _this.client.Network(_this.context(), &in)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return NetworkFromProto(resp), nil
+}
+
+func (_this GRPCOobPluginClientWrapper) ChangeUserPassword(username, password string) error {
+	in := proto.ChangeUserPasswordRequest{Username: username, Password: password}
+	_, err := _this.client.ChangeUserPassword(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) GenerateUser(sett *oob.UserSettingItem) error {
+	in := UserSettingItemToProto(sett)
+	if in == nil {
+		in = &proto.UserSettingItem{}
+	}
+	_, err := _this.client.GenerateUser(_this.context(), in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) EnableUser(username string) error {
+	in := proto.UserRequest{Username: username}
+	_, err := _this.client.EnableUser(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) DisableUser(username string) error {
+	in := proto.UserRequest{Username: username}
+	_, err := _this.client.DisableUser(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCOobPluginClientWrapper) Users() ([]*oob.User, error) {
+	in := proto.Request{}
+	resp, err := _this.client.Users(_this.context(), &in)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return UsersFromProto(resp), nil
+}
+
+func (_this GRPCOobPluginClientWrapper) BMC() (*oob.BMC, error) {
+	in := proto.Request{}
+	resp, err := _this.client.BMC(_this.context(), &in)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return BMCFromProto(resp), nil
+}
+
+func (_this GRPCOobPluginClientWrapper) BMCColdReset() error {
+	in := proto.Request{}
+	_, err := _this.client.BMCColdReset(_this.context(), &in)
+	return fromGRPCError(err)
 }
@@ -3,13 +3,41 @@ package shared
 import (
 	"context"
 	"github.com/hashicorp/go-plugin"
+	"github.com/licairong/cloudboot-provider-framework/collector"
 	"github.com/licairong/cloudboot-provider-framework/proto"
+	"github.com/licairong/cloudboot-provider-framework/raid"
+	"github.com/licairong/cloudboot-provider-framework/util"
 	"google.golang.org/grpc"
 )
 
 type RaidService interface {
-	RAID() (string, error)
-	Clear(ctrlID string) (string, error)
+	// RAID 返回当前设备的RAID信息
+	RAID() (*collector.RAID, error)
+	// Clear 清除指定RAID控制器的配置
+	Clear(ctrlID string) error
+	// Controllers 返回RAID控制器列表
+	Controllers() ([]*collector.RaidController, error)
+	// PhysicalDrives 返回指定RAID控制器下的物理驱动器列表
+	PhysicalDrives(ctrlID string) ([]*collector.PhysicalDrive, error)
+	// CreateLogicalDrive 在指定RAID控制器上创建逻辑驱动器（阵列）
+	CreateLogicalDrive(ctrlID string, ld *raid.LogicalDrive) error
+	// DeleteLogicalDrive 删除指定RAID控制器上的逻辑驱动器
+	DeleteLogicalDrive(ctrlID, ldID string) error
+	// SetGlobalHotspares 将物理驱动器设置为指定RAID控制器的全局热备盘
+	SetGlobalHotspares(ctrlID string, drives []string) error
+	// SetControllerMode 切换RAID控制器模式。可选值：RAID|JBOD
+	SetControllerMode(ctrlID, mode string) error
+	// InitDisk 初始化指定RAID控制器下的逻辑驱动器
+	InitDisk(ctrlID string) error
+	// PostCheck RAID配置实施后置检查
+	PostCheck(sett *raid.Setting) []*util.CheckingItem
+}
+
+// ContextRaidService 支持context的RAID插件服务。
+// 通过WithContext返回的实现执行操作时，若context被取消或超时，则终止正在执行的底层命令。
+type ContextRaidService interface {
+	RaidService
+	WithContext(ctx context.Context) RaidService
 }
 
 // GRPCHelloPlugin implement plugin.GRPCPlugin
@@ -32,41 +60,179 @@ type GRPCRaidPluginServerWrapper struct {
 	proto.UnimplementedRaidPluginServer
 }
 
-func (_this GRPCRaidPluginServerWrapper) RAID(ctx context.Context, request *proto.Request) (*proto.Response, error) {
-	r, _ := _this.impl.RAID()
-	return &proto.Response{
-		Result: r,
-	}, nil
+// with 若插件实现支持context，则返回绑定了请求context的实现，使调用方的超时及取消能够传递到插件。
+func (_this GRPCRaidPluginServerWrapper) with(ctx context.Context) RaidService {
+	if impl, ok := _this.impl.(ContextRaidService); ok {
+		return impl.WithContext(ctx)
+	}
+	return _this.impl
+}
+
+func (_this GRPCRaidPluginServerWrapper) RAID(ctx context.Context, request *proto.Request) (*proto.RAID, error) {
+	r, err := _this.with(ctx).RAID()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	if r == nil {
+		r = new(collector.RAID)
+	}
+	return RAIDToProto(r), nil
+}
+
+func (_this GRPCRaidPluginServerWrapper) Clear(ctx context.Context, request *proto.ClearRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).Clear(request.CtrlID))
+}
+
+func (_this GRPCRaidPluginServerWrapper) Controllers(ctx context.Context, request *proto.Request) (*proto.RAID, error) {
+	ctrls, err := _this.with(ctx).Controllers()
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return RAIDToProto(&collector.RAID{Items: ctrls}), nil
+}
+
+func (_this GRPCRaidPluginServerWrapper) PhysicalDrives(ctx context.Context, request *proto.ControllerRequest) (*proto.PhysicalDrives, error) {
+	pds, err := _this.with(ctx).PhysicalDrives(request.CtrlID)
+	if err != nil {
+		return nil, toGRPCError(err)
+	}
+	return PhysicalDrivesToProto(pds), nil
+}
+
+// CreateLogicalDrive 校验逻辑驱动器配置参数后交由插件实现创建
+func (_this GRPCRaidPluginServerWrapper) CreateLogicalDrive(ctx context.Context, request *proto.CreateLogicalDriveRequest) (*proto.Empty, error) {
+	ld := LogicalDriveFromProto(request.LogicalDrive)
+	if ld == nil {
+		return nil, toGRPCError(util.NewInvalidOptionError("logical_drive"))
+	}
+	if err := ld.Validate(); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).CreateLogicalDrive(request.CtrlID, ld))
 }
 
-func (_this GRPCRaidPluginServerWrapper) Clear(ctx context.Context, request *proto.ClearRequest) (*proto.Response, error) {
-	r, _ := _this.impl.Clear(request.CtrlID)
-	return &proto.Response{
-		Result: r,
-	}, nil
+func (_this GRPCRaidPluginServerWrapper) DeleteLogicalDrive(ctx context.Context, request *proto.DeleteLogicalDriveRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).DeleteLogicalDrive(request.CtrlID, request.LdID))
+}
+
+func (_this GRPCRaidPluginServerWrapper) SetGlobalHotspares(ctx context.Context, request *proto.HotsparesRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).SetGlobalHotspares(request.CtrlID, request.Drives))
+}
+
+func (_this GRPCRaidPluginServerWrapper) SetControllerMode(ctx context.Context, request *proto.ControllerModeRequest) (*proto.Empty, error) {
+	if err := raid.ValidateMode(request.Mode); err != nil {
+		return nil, toGRPCError(err)
+	}
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).SetControllerMode(request.CtrlID, request.Mode))
+}
+
+func (_this GRPCRaidPluginServerWrapper) InitDisk(ctx context.Context, request *proto.ControllerRequest) (*proto.Empty, error) {
+	return &proto.Empty{}, toGRPCError(_this.with(ctx).InitDisk(request.CtrlID))
+}
+
+func (_this GRPCRaidPluginServerWrapper) PostCheck(ctx context.Context, request *proto.RaidSetting) (*proto.CheckingItems, error) {
+	return CheckingItemsToProto(_this.with(ctx).PostCheck(RaidSettingFromProto(request))), nil
 }
 
 // GRPCRaidPluginClientWrapper 作为server 调用插件接口的包装器，
 type GRPCRaidPluginClientWrapper struct {
 	client proto.RaidPluginClient
+	ctx    context.Context
+}
+
+var _ ContextRaidService = GRPCRaidPluginClientWrapper{}
+
+// WithContext 返回绑定了context的包装器，context的超时及取消将传递到插件进程。
+func (_this GRPCRaidPluginClientWrapper) WithContext(ctx context.Context) RaidService {
+	_this.ctx = ctx
+	return _this
 }
 
-func (_this GRPCRaidPluginClientWrapper) RAID() (string, error) {
+func (_this GRPCRaidPluginClientWrapper) context() context.Context {
+	if _this.ctx != nil {
+		return _this.ctx
+	}
+	return context.Background()
+}
+
+func (_this GRPCRaidPluginClientWrapper) RAID() (*collector.RAID, error) {
 	in := proto.Request{}
-	resp, err := _this.client.RAID(context.Background(), &in)
+	resp, err := _this.client.RAID(_this.context(), &in)
 	if err != nil {
-		return "", err
+		return nil, fromGRPCError(err)
 	} else {
-		return resp.Result, nil
+		return RAIDFromProto(resp), nil
 	}
 }
 
-func (_this GRPCRaidPluginClientWrapper) Clear(ctrlID string) (string, error) {
+func (_this GRPCRaidPluginClientWrapper) Clear(ctrlID string) error {
 	in := proto.ClearRequest{CtrlID: ctrlID}
-	resp, err := _this.client.Clear(context.Background(), &in)
+	_, err := _this.client.Clear(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCRaidPluginClientWrapper) Controllers() ([]*collector.RaidController, error) {
+	resp, err := _this.client.Controllers(_this.context(), &proto.Request{})
 	if err != nil {
-		return "", err
-	} else {
-		return resp.Result, nil
+		return nil, fromGRPCError(err)
+	}
+	return RAIDFromProto(resp).Items, nil
+}
+
+func (_this GRPCRaidPluginClientWrapper) PhysicalDrives(ctrlID string) ([]*collector.PhysicalDrive, error) {
+	in := proto.ControllerRequest{CtrlID: ctrlID}
+	resp, err := _this.client.PhysicalDrives(_this.context(), &in)
+	if err != nil {
+		return nil, fromGRPCError(err)
+	}
+	return PhysicalDrivesFromProto(resp), nil
+}
+
+func (_this GRPCRaidPluginClientWrapper) CreateLogicalDrive(ctrlID string, ld *raid.LogicalDrive) error {
+	in := proto.CreateLogicalDriveRequest{CtrlID: ctrlID, LogicalDrive: LogicalDriveToProto(ld)}
+	_, err := _this.client.CreateLogicalDrive(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCRaidPluginClientWrapper) DeleteLogicalDrive(ctrlID, ldID string) error {
+	in := proto.DeleteLogicalDriveRequest{CtrlID: ctrlID, LdID: ldID}
+	_, err := _this.client.DeleteLogicalDrive(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCRaidPluginClientWrapper) SetGlobalHotspares(ctrlID string, drives []string) error {
+	in := proto.HotsparesRequest{CtrlID: ctrlID, Drives: drives}
+	_, err := _this.client.SetGlobalHotspares(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCRaidPluginClientWrapper) SetControllerMode(ctrlID, mode string) error {
+	in := proto.ControllerModeRequest{CtrlID: ctrlID, Mode: mode}
+	_, err := _this.client.SetControllerMode(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+func (_this GRPCRaidPluginClientWrapper) InitDisk(ctrlID string) error {
+	in := proto.ControllerRequest{CtrlID: ctrlID}
+	_, err := _this.client.InitDisk(_this.context(), &in)
+	return fromGRPCError(err)
+}
+
+// PostCheck RAID配置实施后置检查。调用插件失败时返回一条未知匹配结果的检查项。
+func (_this GRPCRaidPluginClientWrapper) PostCheck(sett *raid.Setting) []*util.CheckingItem {
+	in := RaidSettingToProto(sett)
+	if in == nil {
+		in = &proto.RaidSetting{}
+	}
+	resp, err := _this.client.PostCheck(_this.context(), in)
+	if err != nil {
+		return []*util.CheckingItem{
+			{
+				Title:   "PostCheck",
+				Matched: util.MatchedUnknown,
+				Error:   fromGRPCError(err).Error(),
+			},
+		}
 	}
+	return CheckingItemsFromProto(resp)
 }
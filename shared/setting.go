@@ -0,0 +1,17 @@
+package shared
+
+import (
+	"github.com/licairong/cloudboot-provider-framework/bios"
+	"github.com/licairong/cloudboot-provider-framework/firmware"
+	"github.com/licairong/cloudboot-provider-framework/oob"
+	"github.com/licairong/cloudboot-provider-framework/raid"
+)
+
+// HardwareSetting 硬件配置参数（期望状态）。
+// 各部分配置分别由对应的插件服务实施，为nil的部分不做任何处理。
+type HardwareSetting struct {
+	RAID     *raid.Setting     `json:"raid,omitempty"`
+	OOB      *oob.Setting      `json:"oob,omitempty"`
+	BIOS     *bios.Setting     `json:"bios,omitempty"`
+	Firmware *firmware.Setting `json:"firmware,omitempty"`
+}
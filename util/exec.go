@@ -1,6 +1,8 @@
 package util
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -22,7 +24,8 @@ type ExecutionOptions struct {
 	Env     []string
 	Shadows []string
 	Stdin   []string
-	Timeout int
+	Timeout int             // 超时时间（单位秒）。超时后终止命令及其子进程。
+	Context context.Context // 若context被取消或超时，则终止命令及其子进程。
 }
 
 // PingOptions ping可选参数
@@ -121,14 +124,16 @@ func (bash *Bash) Exec(opts *ExecutionOptions, cmd string, args ...string) (outp
 
 	command := exec.Command(shell, scriptFile)
 	command.Env = env
-	output, err = command.CombinedOutput()
+	output, err = bash.run(opts, command)
 	if output != nil && bash.log != nil {
 		bash.log.Debugf("\n--------------------stdout/stderr begin--------------------\n%s\n--------------------stdout/stderr end--------------------", string(output))
 	}
 
 	if err != nil && bash.log != nil {
 		bash.log.Debugf(err.Error())
-		err = fmt.Errorf("exec error: %s", string(output))
+		if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+			err = fmt.Errorf("exec error: %s", string(output))
+		}
 	}
 	return output, err
 }
@@ -162,7 +167,7 @@ func (bash *Bash) execWithStdin(opts *ExecutionOptions, cmd string, args ...stri
 		}
 	}()
 
-	output, err = command.CombinedOutput()
+	output, err = bash.run(opts, command)
 	if output != nil && bash.log != nil {
 		bash.log.Debugf("\n--------------------stdout begin--------------------\n%s\n--------------------stdout end--------------------", string(output))
 	}
@@ -173,6 +178,78 @@ func (bash *Bash) execWithStdin(opts *ExecutionOptions, cmd string, args ...stri
 	return output, err
 }
 
+// run 运行命令并返回stdout、stderr合并后的内容。
+// 命令运行在独立的进程组中，context被取消或超时后将终止整个进程树。
+func (bash *Bash) run(opts *ExecutionOptions, command *exec.Cmd) (output []byte, err error) {
+	ctx := context.Background()
+	if opts != nil && opts.Context != nil {
+		ctx = opts.Context
+	}
+	if opts != nil && opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(opts.Timeout)*time.Second)
+		defer cancel()
+	}
+
+	var buf bytes.Buffer
+	command.Stdout = &buf
+	command.Stderr = &buf
+	setProcessGroup(command)
+
+	if err = command.Start(); err != nil {
+		return nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			killProcessGroup(command)
+		case <-done:
+		}
+	}()
+	err = command.Wait()
+	close(done)
+
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return buf.Bytes(), fmt.Errorf("exec error: %w", ctxErr)
+	}
+	return buf.Bytes(), err
+}
+
+// WithContext 返回绑定了context的执行器。
+// 通过该执行器执行的命令在context被取消或超时后将被终止。
+func WithContext(executor Executor, ctx context.Context) Executor {
+	if executor == nil || ctx == nil {
+		return executor
+	}
+	return &contextExecutor{
+		Executor: executor,
+		ctx:      ctx,
+	}
+}
+
+// contextExecutor 绑定了context的执行器
+type contextExecutor struct {
+	Executor
+	ctx context.Context
+}
+
+// Exec 执行指定命令
+func (e *contextExecutor) Exec(opts *ExecutionOptions, cmd string, args ...string) (output []byte, err error) {
+	var o ExecutionOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Context == nil {
+		o.Context = e.ctx
+	}
+	if err = e.ctx.Err(); err != nil {
+		return nil, err
+	}
+	return e.Executor.Exec(&o, cmd, args...)
+}
+
 // genTempScript 在系统临时目录生成可执行脚本文件
 func (bash *Bash) genTempScript(content []byte) (scriptFile string, err error) {
 	scriptFile = filepath.Join(os.TempDir(), fmt.Sprintf("%d.sh", time.Now().UnixNano())) // TODO 并发bug
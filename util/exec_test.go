@@ -0,0 +1,44 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBashExecContext(t *testing.T) {
+	Convey("命令执行超时及取消", t, func() {
+		bash := NewBash()
+
+		Convey("正常执行", func() {
+			output, err := bash.Exec(nil, "echo", "hello")
+			So(err, ShouldBeNil)
+			So(string(output), ShouldEqual, "hello\n")
+		})
+
+		Convey("context超时后终止整个进程树", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			_, err := WithContext(bash, ctx).Exec(nil, "sleep 10 & sleep 10; wait")
+			So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+			So(time.Since(start), ShouldBeLessThan, 5*time.Second)
+		})
+
+		Convey("context已取消则不再执行", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := WithContext(bash, ctx).Exec(nil, "echo", "hello")
+			So(errors.Is(err, context.Canceled), ShouldBeTrue)
+		})
+
+		Convey("超时选项", func() {
+			_, err := bash.Exec(&ExecutionOptions{Timeout: 1}, "sleep", "10")
+			So(errors.Is(err, context.DeadlineExceeded), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,19 @@
+//go:build windows
+// +build windows
+
+package util
+
+import (
+	"os/exec"
+)
+
+// setProcessGroup windows下无进程组，不做处理
+func setProcessGroup(command *exec.Cmd) {}
+
+// killProcessGroup 终止命令进程
+func killProcessGroup(command *exec.Cmd) {
+	if command.Process == nil {
+		return
+	}
+	_ = command.Process.Kill()
+}
@@ -29,6 +29,21 @@ type CheckingResult struct {
 	BIOSItems []*CheckingItem `json:"bios"`
 	FWItems   []*CheckingItem `json:"firmware"`
 	//...继续扩展
+
+	// RebootRequired 已实施的配置需重启设备后方可生效
+	RebootRequired bool `json:"reboot_required,omitempty"`
+}
+
+// Failed 返回未通过检查的检查项，包括实际值与预期值不符及检查出错的检查项。
+func (r *CheckingResult) Failed() (items []*CheckingItem) {
+	for _, list := range [][]*CheckingItem{r.RAIDItems, r.OOBItems, r.BIOSItems, r.FWItems} {
+		for _, item := range list {
+			if item != nil && item.Matched != MatchedYES {
+				items = append(items, item)
+			}
+		}
+	}
+	return items
 }
 
 // MatchFunc 对比预期值与实际值是否匹配的匹配器
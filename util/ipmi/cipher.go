@@ -0,0 +1,108 @@
+package ipmi
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+// RMCP+算法标识
+const (
+	authNone           = 0x00
+	authRAKPHMACSHA1   = 0x01
+	authRAKPHMACSHA256 = 0x03
+
+	integrityNone          = 0x00
+	integrityHMACSHA196    = 0x01
+	integrityHMACSHA256128 = 0x04
+
+	confidentialityNone      = 0x00
+	confidentialityAESCBC128 = 0x01
+)
+
+// DefaultCipherSuite 默认的加密套件（RAKP-HMAC-SHA1、HMAC-SHA1-96、AES-CBC-128）
+const DefaultCipherSuite = 3
+
+// cipherSuite 会话所使用的认证、完整性及加密算法组合
+type cipherSuite struct {
+	auth            uint8
+	integrity       uint8
+	confidentiality uint8
+	hash            func() hash.Hash // RAKP及完整性校验使用的散列算法
+	icvLen          int              // RAKP4完整性校验值长度
+	authCodeLen     int              // 会话消息完整性校验值长度
+}
+
+// cipherSuites 支持的加密套件
+var cipherSuites = map[int]*cipherSuite{
+	1:  {auth: authRAKPHMACSHA1, hash: sha1.New, icvLen: 12},
+	2:  {auth: authRAKPHMACSHA1, integrity: integrityHMACSHA196, hash: sha1.New, icvLen: 12, authCodeLen: 12},
+	3:  {auth: authRAKPHMACSHA1, integrity: integrityHMACSHA196, confidentiality: confidentialityAESCBC128, hash: sha1.New, icvLen: 12, authCodeLen: 12},
+	17: {auth: authRAKPHMACSHA256, integrity: integrityHMACSHA256128, confidentiality: confidentialityAESCBC128, hash: sha256.New, icvLen: 16, authCodeLen: 16},
+}
+
+func lookupCipherSuite(id int) (*cipherSuite, error) {
+	if suite, ok := cipherSuites[id]; ok {
+		return suite, nil
+	}
+	return nil, fmt.Errorf("ipmi: unsupported cipher suite %d", id)
+}
+
+// mac 返回以key为密钥对data计算的HMAC值
+func (s *cipherSuite) mac(key []byte, data ...[]byte) []byte {
+	h := hmac.New(s.hash, key)
+	for i := range data {
+		h.Write(data[i])
+	}
+	return h.Sum(nil)
+}
+
+// keys 根据会话完整性密钥（SIK）生成K1（完整性）及K2（加密）
+func (s *cipherSuite) keys(sik []byte) (k1, k2 []byte) {
+	return s.mac(sik, bytes.Repeat([]byte{0x01}, 20)), s.mac(sik, bytes.Repeat([]byte{0x02}, 20))
+}
+
+// encrypt 以AES-CBC-128加密载荷，返回IV及密文。
+func encrypt(k2, plain []byte) ([]byte, error) {
+	block, err := aes.NewCipher(k2[:aes.BlockSize])
+	if err != nil {
+		return nil, err
+	}
+	pad := (aes.BlockSize - (len(plain)+1)%aes.BlockSize) % aes.BlockSize
+	buf := make([]byte, aes.BlockSize, aes.BlockSize+len(plain)+pad+1)
+	if _, err = rand.Read(buf); err != nil {
+		return nil, err
+	}
+	buf = append(buf, plain...)
+	for i := 1; i <= pad; i++ {
+		buf = append(buf, uint8(i))
+	}
+	buf = append(buf, uint8(pad))
+	cipher.NewCBCEncrypter(block, buf[:aes.BlockSize]).CryptBlocks(buf[aes.BlockSize:], buf[aes.BlockSize:])
+	return buf, nil
+}
+
+// decrypt 解密以AES-CBC-128加密的载荷
+func decrypt(k2, payload []byte) ([]byte, error) {
+	if len(payload) < 2*aes.BlockSize || len(payload)%aes.BlockSize != 0 {
+		return nil, errors.New("ipmi: invalid encrypted payload length")
+	}
+	block, err := aes.NewCipher(k2[:aes.BlockSize])
+	if err != nil {
+		return nil, err
+	}
+	plain := make([]byte, len(payload)-aes.BlockSize)
+	cipher.NewCBCDecrypter(block, payload[:aes.BlockSize]).CryptBlocks(plain, payload[aes.BlockSize:])
+	pad := int(plain[len(plain)-1])
+	if pad >= aes.BlockSize || pad+1 > len(plain) {
+		return nil, errors.New("ipmi: invalid confidentiality pad")
+	}
+	return plain[:len(plain)-pad-1], nil
+}
@@ -0,0 +1,353 @@
+package ipmi
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+)
+
+// DeviceID Get Device ID命令响应
+type DeviceID struct {
+	DeviceID       uint8
+	DeviceRevision uint8
+	FirmwareMajor  uint8
+	FirmwareMinor  uint8 // BCD编码
+	IPMIVersion    uint8 // BCD编码，低4位为主版本号。
+	ManufacturerID uint32
+	ProductID      uint16
+}
+
+// FirmwareRevision 返回固件版本，格式与ipmitool一致，如'2.81'。
+func (d *DeviceID) FirmwareRevision() string {
+	return fmt.Sprintf("%d.%02x", d.FirmwareMajor, d.FirmwareMinor)
+}
+
+// Version 返回IPMI版本，如'2.0'。
+func (d *DeviceID) Version() string {
+	return fmt.Sprintf("%x.%x", d.IPMIVersion&0x0F, d.IPMIVersion>>4)
+}
+
+// GetDeviceID 返回BMC设备信息
+func (c *Client) GetDeviceID() (*DeviceID, error) {
+	data, err := c.Send(NetFnApp, CmdGetDeviceID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 11 {
+		return nil, errShortResponse(CmdGetDeviceID)
+	}
+	return &DeviceID{
+		DeviceID:       data[0],
+		DeviceRevision: data[1] & 0x0F,
+		FirmwareMajor:  data[2] & 0x7F,
+		FirmwareMinor:  data[3],
+		IPMIVersion:    data[4],
+		ManufacturerID: uint32(data[6]) | uint32(data[7])<<8 | uint32(data[8]&0x0F)<<16,
+		ProductID:      binary.LittleEndian.Uint16(data[9:11]),
+	}, nil
+}
+
+// ColdReset 冷重启BMC
+func (c *Client) ColdReset() error {
+	_, err := c.Send(NetFnApp, CmdColdReset, nil)
+	return err
+}
+
+// ChassisControl 机箱控制动作
+type ChassisControl uint8
+
+const (
+	// ControlPowerDown 下电
+	ControlPowerDown ChassisControl = 0x00
+	// ControlPowerUp 上电
+	ControlPowerUp ChassisControl = 0x01
+	// ControlPowerCycle 下电后重新上电
+	ControlPowerCycle ChassisControl = 0x02
+	// ControlHardReset 硬重启
+	ControlHardReset ChassisControl = 0x03
+	// ControlSoftShutdown 通过ACPI软关机
+	ControlSoftShutdown ChassisControl = 0x05
+)
+
+// PowerOn 返回机箱是否处于上电状态
+func (c *Client) PowerOn() (bool, error) {
+	data, err := c.Send(NetFnChassis, CmdGetChassisStatus, nil)
+	if err != nil {
+		return false, err
+	}
+	if len(data) < 3 {
+		return false, errShortResponse(CmdGetChassisStatus)
+	}
+	return data[0]&0x01 != 0, nil
+}
+
+// ChassisControl 执行机箱控制动作
+func (c *Client) ChassisControl(ctrl ChassisControl) error {
+	_, err := c.Send(NetFnChassis, CmdChassisControl, []byte{uint8(ctrl)})
+	return err
+}
+
+// BootDevice 引导设备
+type BootDevice uint8
+
+const (
+	// BootDeviceNone 不修改引导设备
+	BootDeviceNone BootDevice = 0x00
+	// BootDevicePXE 网络引导
+	BootDevicePXE BootDevice = 0x04
+	// BootDeviceDisk 硬盘引导
+	BootDeviceDisk BootDevice = 0x08
+	// BootDeviceCDROM 光驱引导
+	BootDeviceCDROM BootDevice = 0x14
+	// BootDeviceBIOS 进入BIOS设置
+	BootDeviceBIOS BootDevice = 0x18
+)
+
+// bootParamFlags 系统引导参数-引导标志
+const bootParamFlags = 0x05
+
+// SetBootDevice 设置引导设备。persistent为false时仅下次引导生效。
+func (c *Client) SetBootDevice(dev BootDevice, uefi, persistent bool) error {
+	flags := uint8(0x80) // 引导标志有效
+	if persistent {
+		flags |= 0x40
+	}
+	if uefi {
+		flags |= 0x20
+	}
+	_, err := c.Send(NetFnChassis, CmdSetSystemBootOptions, []byte{bootParamFlags, flags, uint8(dev), 0, 0, 0})
+	return err
+}
+
+// LAN配置参数
+const (
+	LANParamIPAddress      = 0x03
+	LANParamIPSource       = 0x04
+	LANParamMACAddress     = 0x05
+	LANParamSubnetMask     = 0x06
+	LANParamDefaultGateway = 0x0C
+)
+
+// IP来源
+const (
+	IPSourceStatic = 0x01
+	IPSourceDHCP   = 0x02
+	IPSourceBIOS   = 0x03
+	IPSourceOther  = 0x04
+)
+
+// GetLANConfig 返回通道的LAN配置参数（不含参数版本号）
+func (c *Client) GetLANConfig(channel, param uint8) ([]byte, error) {
+	data, err := c.Send(NetFnTransport, CmdGetLANConfigParameters, []byte{channel & 0x0F, param, 0, 0})
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 1 {
+		return nil, errShortResponse(CmdGetLANConfigParameters)
+	}
+	return data[1:], nil
+}
+
+// SetLANConfig 设置通道的LAN配置参数
+func (c *Client) SetLANConfig(channel, param uint8, value []byte) error {
+	_, err := c.Send(NetFnTransport, CmdSetLANConfigParameters, append([]byte{channel & 0x0F, param}, value...))
+	return err
+}
+
+// LANConfig 通道的LAN配置
+type LANConfig struct {
+	IPSource uint8
+	IP       net.IP
+	Netmask  net.IP
+	Gateway  net.IP
+	MAC      net.HardwareAddr
+}
+
+// IPSourceName 返回IP来源的名称，与ipmitool一致，如'DHCP Address'。
+func (cfg *LANConfig) IPSourceName() string {
+	switch cfg.IPSource & 0x0F {
+	case IPSourceStatic:
+		return "Static Address"
+	case IPSourceDHCP:
+		return "DHCP Address"
+	case IPSourceBIOS:
+		return "BIOS Assigned Address"
+	case IPSourceOther:
+		return "Other"
+	}
+	return "Unspecified"
+}
+
+// LANConfig 返回通道的LAN配置
+func (c *Client) LANConfig(channel uint8) (*LANConfig, error) {
+	var cfg LANConfig
+	for _, p := range []struct {
+		param uint8
+		size  int
+		set   func([]byte)
+	}{
+		{LANParamIPSource, 1, func(b []byte) { cfg.IPSource = b[0] }},
+		{LANParamIPAddress, 4, func(b []byte) { cfg.IP = net.IP(b[:4]) }},
+		{LANParamSubnetMask, 4, func(b []byte) { cfg.Netmask = net.IP(b[:4]) }},
+		{LANParamDefaultGateway, 4, func(b []byte) { cfg.Gateway = net.IP(b[:4]) }},
+		{LANParamMACAddress, 6, func(b []byte) { cfg.MAC = net.HardwareAddr(b[:6]) }},
+	} {
+		data, err := c.GetLANConfig(channel, p.param)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) < p.size {
+			return nil, errShortResponse(CmdGetLANConfigParameters)
+		}
+		p.set(data)
+	}
+	return &cfg, nil
+}
+
+// MediumLAN 802.3 LAN通道介质类型
+const MediumLAN = 0x04
+
+// ChannelInfo 通道信息
+type ChannelInfo struct {
+	Number   uint8
+	Medium   uint8
+	Protocol uint8
+}
+
+// GetChannelInfo 返回通道信息
+func (c *Client) GetChannelInfo(channel uint8) (*ChannelInfo, error) {
+	data, err := c.Send(NetFnApp, CmdGetChannelInfo, []byte{channel & 0x0F})
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 3 {
+		return nil, errShortResponse(CmdGetChannelInfo)
+	}
+	return &ChannelInfo{
+		Number:   data[0] & 0x0F,
+		Medium:   data[1] & 0x7F,
+		Protocol: data[2] & 0x1F,
+	}, nil
+}
+
+// UserAccess 用户在通道上的访问权限
+type UserAccess struct {
+	MaxUsers      uint8 // 支持的最大用户数
+	EnabledUsers  uint8 // 已启用的用户数
+	FixedNames    uint8 // 用户名不可修改的用户数
+	Callin        bool  // 是否允许呼入（未限定为仅回调）
+	LinkAuth      bool  // 是否启用链路认证
+	IPMIMessaging bool  // 是否启用IPMI消息
+	Privilege     uint8 // 权限级别上限
+}
+
+// GetUserAccess 返回用户在通道上的访问权限
+func (c *Client) GetUserAccess(channel, userID uint8) (*UserAccess, error) {
+	data, err := c.Send(NetFnApp, CmdGetUserAccess, []byte{channel & 0x0F, userID & 0x3F})
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 4 {
+		return nil, errShortResponse(CmdGetUserAccess)
+	}
+	return &UserAccess{
+		MaxUsers:      data[0] & 0x3F,
+		EnabledUsers:  data[1] & 0x3F,
+		FixedNames:    data[2] & 0x3F,
+		Callin:        data[3]&0x40 == 0,
+		LinkAuth:      data[3]&0x20 != 0,
+		IPMIMessaging: data[3]&0x10 != 0,
+		Privilege:     data[3] & 0x0F,
+	}, nil
+}
+
+// SetUserAccess 设置用户在通道上的访问权限
+func (c *Client) SetUserAccess(channel, userID uint8, access *UserAccess) error {
+	b := 0x80 | channel&0x0F // 修改呼入、链路认证及IPMI消息设置
+	if !access.Callin {
+		b |= 0x40
+	}
+	if access.LinkAuth {
+		b |= 0x20
+	}
+	if access.IPMIMessaging {
+		b |= 0x10
+	}
+	_, err := c.Send(NetFnApp, CmdSetUserAccess, []byte{b, userID & 0x3F, access.Privilege & 0x0F, 0})
+	return err
+}
+
+// GetUserName 返回用户名。未设置用户名时返回空字符串。
+func (c *Client) GetUserName(userID uint8) (string, error) {
+	data, err := c.Send(NetFnApp, CmdGetUserName, []byte{userID & 0x3F})
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\x00"), nil
+}
+
+// SetUserName 设置用户名
+func (c *Client) SetUserName(userID uint8, name string) error {
+	if len(name) > 16 {
+		return errors.New("ipmi: user name exceeds 16 bytes")
+	}
+	data := make([]byte, 17)
+	data[0] = userID & 0x3F
+	copy(data[1:], name)
+	_, err := c.Send(NetFnApp, CmdSetUserName, data)
+	return err
+}
+
+// Set User Password命令的操作
+const (
+	passwordDisableUser = 0x00
+	passwordEnableUser  = 0x01
+	passwordSet         = 0x02
+)
+
+// SetUserPassword 设置用户密码。超过16字节的密码以IPMI v2.0的20字节格式存储。
+func (c *Client) SetUserPassword(userID uint8, password string) error {
+	if len(password) > 20 {
+		return errors.New("ipmi: password exceeds 20 bytes")
+	}
+	size, id := 16, userID&0x3F
+	if len(password) > 16 {
+		size, id = 20, id|0x80
+	}
+	data := make([]byte, 2+size)
+	data[0], data[1] = id, passwordSet
+	copy(data[2:], password)
+	_, err := c.Send(NetFnApp, CmdSetUserPassword, data)
+	return err
+}
+
+// EnableUser 启用用户
+func (c *Client) EnableUser(userID uint8) error {
+	_, err := c.Send(NetFnApp, CmdSetUserPassword, []byte{userID & 0x3F, passwordEnableUser})
+	return err
+}
+
+// DisableUser 禁用用户
+func (c *Client) DisableUser(userID uint8) error {
+	_, err := c.Send(NetFnApp, CmdSetUserPassword, []byte{userID & 0x3F, passwordDisableUser})
+	return err
+}
+
+// ClearSEL 清除SEL日志
+func (c *Client) ClearSEL() error {
+	data, err := c.Send(NetFnStorage, CmdReserveSEL, nil)
+	if err != nil {
+		return err
+	}
+	if len(data) < 2 {
+		return errShortResponse(CmdReserveSEL)
+	}
+	_, err = c.Send(NetFnStorage, CmdClearSEL, []byte{data[0], data[1], 'C', 'L', 'R', 0xAA})
+	return err
+}
+
+func errShortResponse(cmd uint8) error {
+	return fmt.Errorf("ipmi: response to cmd 0x%02x too short", cmd)
+}
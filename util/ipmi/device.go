@@ -0,0 +1,84 @@
+package ipmi
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// Transport IPMI请求的传输方式
+type Transport interface {
+	// Exchange 发送请求并返回完成码及响应数据
+	Exchange(netfn NetFn, cmd uint8, data []byte) (code uint8, resp []byte, err error)
+	// Close 释放传输方式占用的资源
+	Close() error
+}
+
+// NewLocalClient 返回经由指定传输方式（通常为本机IPMI设备）发送请求的客户端。
+func NewLocalClient(t Transport) *Client {
+	return &Client{Transport: t}
+}
+
+// DefaultDevices 本机OpenIPMI设备的常见路径
+var DefaultDevices = []string{"/dev/ipmi0", "/dev/ipmi/0", "/dev/ipmidev/0"}
+
+// Device 本机OpenIPMI字符设备，通过ioctl与BMC的系统接口（KCS/SSIF等）通信，实现Transport。
+// 首次发送请求时打开设备，此后的请求复用该设备。
+type Device struct {
+	Path    string        // 设备路径，为空时依次尝试DefaultDevices。
+	Timeout time.Duration // 单次请求等待响应的超时时间
+
+	mux   sync.Mutex
+	file  *os.File
+	msgID int
+}
+
+// NewDevice 返回指定路径的本机IPMI设备，path为空时自动查找。
+func NewDevice(path string) *Device {
+	return &Device{
+		Path:    path,
+		Timeout: DefaultTimeout,
+	}
+}
+
+// Exchange 发送请求并返回完成码及响应数据
+func (d *Device) Exchange(netfn NetFn, cmd uint8, data []byte) (uint8, []byte, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if err := d.open(); err != nil {
+		return 0, nil, err
+	}
+	d.msgID++
+	return d.exchange(netfn, cmd, data)
+}
+
+// Close 关闭设备
+func (d *Device) Close() error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.file == nil {
+		return nil
+	}
+	err := d.file.Close()
+	d.file = nil
+	return err
+}
+
+func (d *Device) open() (err error) {
+	if d.file != nil {
+		return nil
+	}
+	paths := DefaultDevices
+	if d.Path != "" {
+		paths = []string{d.Path}
+	}
+	for _, path := range paths {
+		if d.file, err = os.OpenFile(path, os.O_RDWR, 0); err == nil {
+			return nil
+		}
+		if d.Path != "" || !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return ErrNoDevice
+}
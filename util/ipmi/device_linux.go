@@ -0,0 +1,133 @@
+package ipmi
+
+import (
+	"errors"
+	"os"
+	"runtime"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// OpenIPMI驱动（linux/ipmi.h）的地址类型、消息类型及ioctl命令
+const (
+	systemInterfaceAddrType = 0x0C
+	bmcChannel              = 0x0F
+	responseRecvType        = 1
+	maxMsgLength            = 272
+)
+
+var (
+	ioctlSendCommand     = ioc(iocRead, 13, unsafe.Sizeof(deviceReq{}))
+	ioctlReceiveMsgTrunc = ioc(iocRead|iocWrite, 11, unsafe.Sizeof(deviceRecv{}))
+)
+
+const (
+	iocWrite = 1
+	iocRead  = 2
+)
+
+// ioc 按asm-generic/ioctl.h的规则生成ioctl命令
+func ioc(dir, nr, size uintptr) uintptr {
+	return dir<<30 | size<<16 | 'i'<<8 | nr
+}
+
+// systemInterfaceAddr 对应struct ipmi_system_interface_addr
+type systemInterfaceAddr struct {
+	addrType int32
+	channel  int16
+	lun      uint8
+}
+
+// deviceMsg 对应struct ipmi_msg
+type deviceMsg struct {
+	netfn   uint8
+	cmd     uint8
+	dataLen uint16
+	data    *byte
+}
+
+// deviceReq 对应struct ipmi_req
+type deviceReq struct {
+	addr    *systemInterfaceAddr
+	addrLen uint32
+	msgID   int // C long
+	msg     deviceMsg
+}
+
+// deviceRecv 对应struct ipmi_recv
+type deviceRecv struct {
+	recvType int32
+	addr     *systemInterfaceAddr
+	addrLen  uint32
+	msgID    int // C long
+	msg      deviceMsg
+}
+
+func (d *Device) exchange(netfn NetFn, cmd uint8, data []byte) (uint8, []byte, error) {
+	conn, err := d.file.SyscallConn()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	addr := systemInterfaceAddr{addrType: systemInterfaceAddrType, channel: bmcChannel}
+	req := deviceReq{
+		addr:    &addr,
+		addrLen: uint32(unsafe.Sizeof(addr)),
+		msgID:   d.msgID,
+		msg:     deviceMsg{netfn: uint8(netfn), cmd: cmd, dataLen: uint16(len(data))},
+	}
+	if len(data) > 0 {
+		req.msg.data = &data[0]
+	}
+	var errno syscall.Errno
+	if err = conn.Control(func(fd uintptr) {
+		errno = ioctl(fd, ioctlSendCommand, unsafe.Pointer(&req))
+	}); err != nil {
+		return 0, nil, err
+	}
+	runtime.KeepAlive(data)
+	if errno != 0 {
+		return 0, nil, os.NewSyscallError("ioctl", errno)
+	}
+
+	if err = d.file.SetReadDeadline(time.Now().Add(d.Timeout)); err != nil {
+		return 0, nil, err
+	}
+	buf := make([]byte, maxMsgLength)
+	for {
+		var raddr systemInterfaceAddr
+		recv := deviceRecv{
+			addr:    &raddr,
+			addrLen: uint32(unsafe.Sizeof(raddr)),
+			msg:     deviceMsg{dataLen: uint16(len(buf)), data: &buf[0]},
+		}
+		err = conn.Read(func(fd uintptr) bool {
+			errno = ioctl(fd, ioctlReceiveMsgTrunc, unsafe.Pointer(&recv))
+			return errno != syscall.EAGAIN
+		})
+		if errors.Is(err, os.ErrDeadlineExceeded) {
+			return 0, nil, ErrTimeout
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		if errno != 0 && errno != syscall.EMSGSIZE {
+			return 0, nil, os.NewSyscallError("ioctl", errno)
+		}
+		if recv.recvType != responseRecvType || recv.msgID != d.msgID {
+			continue // 丢弃事件及此前已超时请求的响应
+		}
+		if recv.msg.dataLen == 0 {
+			return 0, nil, errShortResponse(cmd)
+		}
+		resp := make([]byte, recv.msg.dataLen-1)
+		copy(resp, buf[1:recv.msg.dataLen])
+		return buf[0], resp, nil
+	}
+}
+
+func ioctl(fd, req uintptr, arg unsafe.Pointer) syscall.Errno {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, fd, req, uintptr(arg))
+	return errno
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package ipmi
+
+import "errors"
+
+// exchange 非linux平台无OpenIPMI驱动，不支持本机IPMI设备。
+func (d *Device) exchange(netfn NetFn, cmd uint8, data []byte) (uint8, []byte, error) {
+	return 0, nil, errors.New("ipmi: local ipmi device is only supported on linux")
+}
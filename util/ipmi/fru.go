@@ -0,0 +1,171 @@
+package ipmi
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// fruChunkSize 单次读取FRU数据的最大字节数
+const fruChunkSize = 32
+
+// FRU FRU设备的机箱、主板及产品信息
+type FRU struct {
+	ChassisType         uint8
+	ChassisPartNumber   string
+	ChassisSerial       string
+	BoardManufacturer   string
+	BoardProductName    string
+	BoardSerial         string
+	BoardPartNumber     string
+	ProductManufacturer string
+	ProductName         string
+	ProductPartNumber   string
+	ProductVersion      string
+	ProductSerial       string
+	ProductAssetTag     string
+}
+
+// FRU 读取并解析FRU设备信息
+func (c *Client) FRU(id uint8) (*FRU, error) {
+	data, err := c.ReadFRU(id)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFRU(data)
+}
+
+// ReadFRU 读取FRU设备的全部数据。BMC不接受单次读取的长度时，减半后重试。
+func (c *Client) ReadFRU(id uint8) ([]byte, error) {
+	info, err := c.Send(NetFnStorage, CmdGetFRUInventoryAreaInfo, []byte{id})
+	if err != nil {
+		return nil, err
+	}
+	if len(info) < 3 {
+		return nil, errShortResponse(CmdGetFRUInventoryAreaInfo)
+	}
+	size := int(info[0]) | int(info[1])<<8
+	unit := 1
+	if info[2]&0x01 != 0 { // 按字访问
+		unit = 2
+	}
+
+	data := make([]byte, 0, size)
+	chunk := fruChunkSize
+	for len(data) < size {
+		n := chunk
+		if size-len(data) < n {
+			n = size - len(data)
+		}
+		off := len(data) / unit
+		resp, err := c.Send(NetFnStorage, CmdReadFRUData, []byte{id, uint8(off), uint8(off >> 8), uint8(n / unit)})
+		if err != nil {
+			if chunk > 8 && (IsCompletion(err, CompletionRequestTooLong) || IsCompletion(err, CompletionCannotReturnBytes) || IsCompletion(err, CompletionRequestLength)) {
+				chunk /= 2
+				continue
+			}
+			return nil, err
+		}
+		if len(resp) < 1 || resp[0] == 0 || len(resp) < 1+int(resp[0])*unit {
+			return nil, errShortResponse(CmdReadFRUData)
+		}
+		data = append(data, resp[1:1+int(resp[0])*unit]...)
+	}
+	return data[:size], nil
+}
+
+// ParseFRU 解析IPMI平台管理FRU信息存储格式的数据
+func ParseFRU(data []byte) (*FRU, error) {
+	if len(data) < 8 || data[0]&0x0F != 0x01 {
+		return nil, errors.New("ipmi: unsupported FRU format")
+	}
+	if checksum(data[:7]) != data[7] {
+		return nil, errors.New("ipmi: FRU common header checksum mismatch")
+	}
+
+	var fru FRU
+	if off := int(data[2]) * 8; off > 0 {
+		fields, err := areaFields(data, off, 3)
+		if err != nil {
+			return nil, fmt.Errorf("ipmi: chassis info area: %s", err)
+		}
+		fru.ChassisType = data[off+2]
+		assign(fields, &fru.ChassisPartNumber, &fru.ChassisSerial)
+	}
+	if off := int(data[3]) * 8; off > 0 {
+		fields, err := areaFields(data, off, 6)
+		if err != nil {
+			return nil, fmt.Errorf("ipmi: board info area: %s", err)
+		}
+		assign(fields, &fru.BoardManufacturer, &fru.BoardProductName, &fru.BoardSerial, &fru.BoardPartNumber)
+	}
+	if off := int(data[4]) * 8; off > 0 {
+		fields, err := areaFields(data, off, 3)
+		if err != nil {
+			return nil, fmt.Errorf("ipmi: product info area: %s", err)
+		}
+		assign(fields, &fru.ProductManufacturer, &fru.ProductName, &fru.ProductPartNumber,
+			&fru.ProductVersion, &fru.ProductSerial, &fru.ProductAssetTag)
+	}
+	return &fru, nil
+}
+
+// areaFields 返回信息区域中从start（相对区域起始位置）开始的各个字段
+func areaFields(data []byte, off, start int) ([]string, error) {
+	if off+start > len(data) {
+		return nil, errors.New("offset out of range")
+	}
+	end := off + int(data[off+1])*8
+	if end <= off || end > len(data) {
+		return nil, errors.New("invalid area length")
+	}
+	var fields []string
+	for i := off + start; i < end; {
+		tl := data[i]
+		if tl == 0xC1 { // 结束标记
+			break
+		}
+		n := int(tl & 0x3F)
+		if i+1+n > end {
+			return nil, errors.New("field exceeds area")
+		}
+		fields = append(fields, decodeField(tl>>6, data[i+1:i+1+n]))
+		i += 1 + n
+	}
+	return fields, nil
+}
+
+// decodeField 按类型解码字段值
+func decodeField(typ uint8, b []byte) string {
+	switch typ {
+	case 0x00: // 二进制
+		return hex.EncodeToString(b)
+	case 0x01: // BCD plus
+		const digits = "0123456789 -.???"
+		var sb strings.Builder
+		for _, v := range b {
+			sb.WriteByte(digits[v>>4])
+			sb.WriteByte(digits[v&0x0F])
+		}
+		return strings.TrimSpace(sb.String())
+	case 0x02: // 6位压缩ASCII
+		var sb strings.Builder
+		for i := 0; i+2 < len(b); i += 3 {
+			v := uint32(b[i]) | uint32(b[i+1])<<8 | uint32(b[i+2])<<16
+			for j := 0; j < 4; j++ {
+				sb.WriteByte(byte(v>>(6*j)&0x3F) + 0x20)
+			}
+		}
+		return strings.TrimSpace(sb.String())
+	}
+	return strings.TrimSpace(strings.TrimRight(string(b), "\x00"))
+}
+
+func assign(fields []string, dst ...*string) {
+	for i := range dst {
+		if i < len(fields) {
+			*dst[i] = fields[i]
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package ipmi
+
+import "fmt"
+
+// manufacturers 常见服务器厂商的IANA企业编号，名称与ipmitool一致。
+var manufacturers = map[uint32]string{
+	2:     "IBM",
+	11:    "Hewlett-Packard",
+	42:    "Sun Microsystems",
+	343:   "Intel Corporation",
+	674:   "DELL Inc",
+	2011:  "Huawei Technologies Co., Ltd.",
+	10876: "Super Micro Computer Inc.",
+	19046: "Lenovo",
+	37945: "Inspur",
+}
+
+// ManufacturerName 返回IANA企业编号对应的厂商名称
+func ManufacturerName(id uint32) string {
+	if name, ok := manufacturers[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown (0x%X)", id)
+}
@@ -0,0 +1,238 @@
+// Package ipmi 纯Go实现的IPMI v2.0客户端。
+// 通过RMCP+（lanplus）与BMC建立会话，支持RAKP认证、完整性校验及AES加密，会话在多次请求间复用；
+// 也可经由本机OpenIPMI设备（/dev/ipmi0）进行带内通信。
+package ipmi
+
+import (
+	"errors"
+	"fmt"
+)
+
+// NetFn IPMI网络功能码
+type NetFn uint8
+
+const (
+	// NetFnChassis 机箱
+	NetFnChassis NetFn = 0x00
+	// NetFnSensorEvent 传感器及事件
+	NetFnSensorEvent NetFn = 0x04
+	// NetFnApp 应用
+	NetFnApp NetFn = 0x06
+	// NetFnStorage 存储（FRU、SEL、SDR）
+	NetFnStorage NetFn = 0x0A
+	// NetFnTransport 传输（LAN配置）
+	NetFnTransport NetFn = 0x0C
+)
+
+// NetFnChassis命令
+const (
+	CmdGetChassisStatus     = 0x01
+	CmdChassisControl       = 0x02
+	CmdSetSystemBootOptions = 0x08
+)
+
+// NetFnSensorEvent命令
+const (
+	CmdGetSensorReading = 0x2D
+)
+
+// NetFnApp命令
+const (
+	CmdGetDeviceID                = 0x01
+	CmdColdReset                  = 0x02
+	CmdGetChannelAuthCapabilities = 0x38
+	CmdSetSessionPrivilegeLevel   = 0x3B
+	CmdCloseSession               = 0x3C
+	CmdGetChannelInfo             = 0x42
+	CmdSetUserAccess              = 0x43
+	CmdGetUserAccess              = 0x44
+	CmdSetUserName                = 0x45
+	CmdGetUserName                = 0x46
+	CmdSetUserPassword            = 0x47
+)
+
+// NetFnStorage命令
+const (
+	CmdGetFRUInventoryAreaInfo = 0x10
+	CmdReadFRUData             = 0x11
+	CmdGetSDRRepositoryInfo    = 0x20
+	CmdReserveSDRRepository    = 0x22
+	CmdGetSDR                  = 0x23
+	CmdGetSELInfo              = 0x40
+	CmdReserveSEL              = 0x42
+	CmdGetSELEntry             = 0x43
+	CmdClearSEL                = 0x47
+)
+
+// NetFnTransport命令
+const (
+	CmdSetLANConfigParameters = 0x01
+	CmdGetLANConfigParameters = 0x02
+)
+
+// 用户及会话权限级别
+const (
+	PrivilegeCallback      = 0x01
+	PrivilegeUser          = 0x02
+	PrivilegeOperator      = 0x03
+	PrivilegeAdministrator = 0x04
+	PrivilegeOEM           = 0x05
+	PrivilegeNoAccess      = 0x0F
+)
+
+// 完成码
+const (
+	CompletionOK                  = 0x00
+	CompletionNodeBusy            = 0xC0
+	CompletionInvalidCommand      = 0xC1
+	CompletionTimeout             = 0xC3
+	CompletionReservationCanceled = 0xC5
+	CompletionRequestTruncated    = 0xC6
+	CompletionRequestLength       = 0xC7
+	CompletionRequestTooLong      = 0xC8
+	CompletionParameterOutOfRange = 0xC9
+	CompletionCannotReturnBytes   = 0xCA
+	CompletionNotPresent          = 0xCB
+	CompletionInvalidDataField    = 0xCC
+	CompletionInsufficientPriv    = 0xD4
+	CompletionUnspecified         = 0xFF
+)
+
+var completionText = map[uint8]string{
+	CompletionNodeBusy:            "node busy",
+	CompletionInvalidCommand:      "invalid command",
+	CompletionTimeout:             "timeout while processing command",
+	CompletionReservationCanceled: "reservation canceled or invalid",
+	CompletionRequestTruncated:    "request data truncated",
+	CompletionRequestLength:       "request data length invalid",
+	CompletionRequestTooLong:      "request data field length limit exceeded",
+	CompletionParameterOutOfRange: "parameter out of range",
+	CompletionCannotReturnBytes:   "cannot return number of requested data bytes",
+	CompletionNotPresent:          "requested sensor, data, or record not present",
+	CompletionInvalidDataField:    "invalid data field in request",
+	CompletionInsufficientPriv:    "insufficient privilege level",
+	CompletionUnspecified:         "unspecified error",
+}
+
+// CompletionError 命令完成码非0时返回的错误
+type CompletionError struct {
+	NetFn NetFn
+	Cmd   uint8
+	Code  uint8
+}
+
+func (e *CompletionError) Error() string {
+	text, ok := completionText[e.Code]
+	if !ok {
+		text = "unknown completion code"
+	}
+	return fmt.Sprintf("ipmi: netfn 0x%02x cmd 0x%02x: %s (0x%02x)", uint8(e.NetFn), e.Cmd, text, e.Code)
+}
+
+// IsCompletion 返回错误是否是指定完成码的CompletionError
+func IsCompletion(err error, code uint8) bool {
+	var e *CompletionError
+	return errors.As(err, &e) && e.Code == code
+}
+
+var (
+	// ErrTimeout 等待BMC响应超时
+	ErrTimeout = errors.New("ipmi: timed out waiting for response")
+	// ErrNoHostname 未指定BMC地址
+	ErrNoHostname = errors.New("ipmi: hostname is required")
+	// ErrNoDevice 未找到本机IPMI设备
+	ErrNoDevice = errors.New("ipmi: no local ipmi device found")
+)
+
+var rakpStatusText = map[uint8]string{
+	0x01: "insufficient resources to create a session",
+	0x02: "invalid session ID",
+	0x03: "invalid payload type",
+	0x04: "invalid authentication algorithm",
+	0x05: "invalid integrity algorithm",
+	0x06: "no matching authentication payload",
+	0x07: "no matching integrity payload",
+	0x08: "inactive session ID",
+	0x09: "invalid role",
+	0x0A: "unauthorized role or privilege level requested",
+	0x0B: "insufficient resources to create a session at the requested role",
+	0x0C: "invalid name length",
+	0x0D: "unauthorized name",
+	0x0E: "unauthorized GUID",
+	0x0F: "invalid integrity check value",
+	0x10: "invalid confidentiality algorithm",
+	0x11: "no cipher suite match with proposed security algorithms",
+	0x12: "illegal or unrecognized parameter",
+}
+
+// AuthError 会话建立失败。通常由用户名、密码或所请求的权限级别不匹配导致。
+type AuthError struct {
+	Stage  string // 失败的阶段，如'RAKP2'
+	Status uint8  // RMCP+状态码
+}
+
+func (e *AuthError) Error() string {
+	text, ok := rakpStatusText[e.Status]
+	if !ok {
+		text = "unknown status"
+	}
+	return fmt.Sprintf("ipmi: %s: %s (0x%02x)", e.Stage, text, e.Status)
+}
+
+// IsAuthError 返回错误是否是AuthError
+func IsAuthError(err error) bool {
+	var e *AuthError
+	return errors.As(err, &e)
+}
+
+const (
+	// bmcAddr BMC的从地址
+	bmcAddr = 0x20
+	// consoleAddr 远程控制台的软件ID
+	consoleAddr = 0x81
+)
+
+// checksum 返回IPMI消息校验和（二进制补码）
+func checksum(b []byte) uint8 {
+	var c uint8
+	for _, v := range b {
+		c += v
+	}
+	return -c
+}
+
+// encodeRequest 按IPMB格式编码请求消息
+func encodeRequest(netfn NetFn, cmd, seq uint8, data []byte) []byte {
+	msg := make([]byte, 0, 7+len(data))
+	msg = append(msg, bmcAddr, uint8(netfn)<<2)
+	msg = append(msg, checksum(msg))
+	msg = append(msg, consoleAddr, seq<<2, cmd)
+	msg = append(msg, data...)
+	return append(msg, checksum(msg[3:]))
+}
+
+// response IPMI响应消息
+type response struct {
+	netfn NetFn
+	seq   uint8
+	cmd   uint8
+	code  uint8
+	data  []byte
+}
+
+// decodeResponse 解码IPMB格式的响应消息
+func decodeResponse(msg []byte) (*response, error) {
+	if len(msg) < 8 {
+		return nil, fmt.Errorf("ipmi: response message too short (%d bytes)", len(msg))
+	}
+	if checksum(msg[:2]) != msg[2] || checksum(msg[3:len(msg)-1]) != msg[len(msg)-1] {
+		return nil, errors.New("ipmi: response message checksum mismatch")
+	}
+	return &response{
+		netfn: NetFn(msg[1] >> 2),
+		seq:   msg[4] >> 2,
+		cmd:   msg[5],
+		code:  msg[6],
+		data:  msg[7 : len(msg)-1],
+	}, nil
+}
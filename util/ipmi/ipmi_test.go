@@ -0,0 +1,354 @@
+package ipmi_test
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/util/ipmi"
+	"github.com/licairong/cloudboot-provider-framework/util/ipmi/ipmitest"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newClient(srv *ipmitest.Server, username, password string) *ipmi.Client {
+	c := ipmi.NewClient(srv.Addr(), username, password)
+	c.Timeout = 200 * time.Millisecond
+	c.Retries = 0
+	return c
+}
+
+func TestSession(t *testing.T) {
+	Convey("RMCP+会话", t, func() {
+		srv := ipmitest.NewServer(nil)
+		defer srv.Close()
+
+		for _, suite := range []int{1, 2, 3, 17} {
+			c := newClient(srv, "root", "calvin")
+			c.CipherSuite = suite
+			id, err := c.GetDeviceID()
+			So(err, ShouldBeNil)
+			So(id.FirmwareRevision(), ShouldEqual, "2.81")
+			So(id.Version(), ShouldEqual, "2.0")
+			So(id.ManufacturerID, ShouldEqual, 674)
+			So(c.Close(), ShouldBeNil)
+		}
+		So(srv.Opened(), ShouldEqual, 4)
+		So(srv.Active(), ShouldEqual, 0)
+
+		Convey("会话复用及失效后重建", func() {
+			c := newClient(srv, "root", "calvin")
+			defer c.Close()
+			for i := 0; i < 3; i++ {
+				_, err := c.PowerOn()
+				So(err, ShouldBeNil)
+			}
+			So(srv.Opened(), ShouldEqual, 5)
+
+			srv.ExpireSessions()
+			_, err := c.PowerOn()
+			So(err, ShouldBeNil)
+			So(srv.Opened(), ShouldEqual, 6)
+		})
+
+		Convey("认证失败", func() {
+			err := newClient(srv, "root", "wrong").Open()
+			So(ipmi.IsAuthError(err), ShouldBeTrue)
+			So(err.Error(), ShouldContainSubstring, "RAKP2")
+
+			err = newClient(srv, "nobody", "calvin").Open()
+			So(err, ShouldResemble, &ipmi.AuthError{Stage: "RAKP2", Status: 0x0D})
+
+			c := newClient(srv, "root", "calvin")
+			c.CipherSuite = 4
+			So(c.Open(), ShouldNotBeNil)
+		})
+
+		Convey("权限不足", func() {
+			srv.Lock()
+			srv.Users[1].Privilege = ipmi.PrivilegeUser
+			srv.Unlock()
+			err := newClient(srv, "root", "calvin").Open()
+			So(err, ShouldResemble, &ipmi.AuthError{Stage: "RAKP2", Status: 0x0A})
+
+			c := newClient(srv, "root", "calvin")
+			c.Privilege = ipmi.PrivilegeUser
+			So(c.Open(), ShouldBeNil)
+			So(c.Close(), ShouldBeNil)
+		})
+
+		Convey("BMC不可达", func() {
+			addr := srv.Addr()
+			srv.Close()
+			c := ipmi.NewClient(addr, "root", "calvin")
+			c.Timeout = 200 * time.Millisecond
+			err := c.Open()
+			So(err, ShouldNotBeNil)
+			So(ipmi.IsAuthError(err), ShouldBeFalse)
+		})
+
+		Convey("未指定BMC地址", func() {
+			So(ipmi.NewClient("", "root", "calvin").Open(), ShouldEqual, ipmi.ErrNoHostname)
+		})
+	})
+}
+
+func TestCommands(t *testing.T) {
+	Convey("IPMI命令", t, func() {
+		srv := ipmitest.NewServer(nil)
+		defer srv.Close()
+		c := newClient(srv, "root", "calvin")
+		defer c.Close()
+
+		Convey("完成码", func() {
+			_, err := c.Send(0x30, 0x01, nil)
+			So(ipmi.IsCompletion(err, ipmi.CompletionInvalidCommand), ShouldBeTrue)
+			So(err.Error(), ShouldEqual, "ipmi: netfn 0x30 cmd 0x01: invalid command (0xc1)")
+		})
+
+		Convey("电源及引导", func() {
+			on, err := c.PowerOn()
+			So(err, ShouldBeNil)
+			So(on, ShouldBeFalse)
+			So(c.ChassisControl(ipmi.ControlPowerUp), ShouldBeNil)
+			on, _ = c.PowerOn()
+			So(on, ShouldBeTrue)
+
+			So(c.SetBootDevice(ipmi.BootDevicePXE, true, false), ShouldBeNil)
+			So(srv.CurrentBootFlags(), ShouldResemble, []byte{0xA0, 0x04, 0, 0, 0})
+		})
+
+		Convey("LAN配置", func() {
+			cfg, err := c.LANConfig(1)
+			So(err, ShouldBeNil)
+			So(cfg.IPSourceName(), ShouldEqual, "DHCP Address")
+			So(cfg.IP.String(), ShouldEqual, "192.168.0.120")
+			So(cfg.Netmask.String(), ShouldEqual, "255.255.255.0")
+			So(cfg.Gateway.String(), ShouldEqual, "192.168.0.1")
+			So(cfg.MAC.String(), ShouldEqual, "18:66:da:5f:2e:10")
+
+			So(c.SetLANConfig(1, ipmi.LANParamIPSource, []byte{ipmi.IPSourceStatic}), ShouldBeNil)
+			So(c.SetLANConfig(1, ipmi.LANParamIPAddress, net.ParseIP("10.0.0.10").To4()), ShouldBeNil)
+			cfg, _ = c.LANConfig(1)
+			So(cfg.IPSourceName(), ShouldEqual, "Static Address")
+			So(cfg.IP.String(), ShouldEqual, "10.0.0.10")
+
+			info, err := c.GetChannelInfo(1)
+			So(err, ShouldBeNil)
+			So(info.Medium, ShouldEqual, ipmi.MediumLAN)
+			_, err = c.GetChannelInfo(2)
+			So(ipmi.IsCompletion(err, ipmi.CompletionInvalidDataField), ShouldBeTrue)
+		})
+
+		Convey("用户", func() {
+			name, err := c.GetUserName(2)
+			So(err, ShouldBeNil)
+			So(name, ShouldEqual, "root")
+
+			So(c.SetUserName(3, "admin"), ShouldBeNil)
+			So(c.SetUserPassword(3, "a-password-of-20-ch"), ShouldBeNil)
+			So(c.EnableUser(3), ShouldBeNil)
+			So(c.SetUserAccess(1, 3, &ipmi.UserAccess{
+				Callin:        true,
+				LinkAuth:      true,
+				IPMIMessaging: true,
+				Privilege:     ipmi.PrivilegeOperator,
+			}), ShouldBeNil)
+			So(srv.UserByID(3), ShouldResemble, &ipmitest.User{
+				Name:          "admin",
+				Password:      "a-password-of-20-ch",
+				Enabled:       true,
+				Privilege:     ipmi.PrivilegeOperator,
+				Callin:        true,
+				LinkAuth:      true,
+				IPMIMessaging: true,
+			})
+
+			access, err := c.GetUserAccess(1, 3)
+			So(err, ShouldBeNil)
+			So(access.MaxUsers, ShouldEqual, 16)
+			So(access.EnabledUsers, ShouldEqual, 2)
+			So(access.Privilege, ShouldEqual, ipmi.PrivilegeOperator)
+			So(access.IPMIMessaging, ShouldBeTrue)
+
+			So(c.DisableUser(3), ShouldBeNil)
+			So(srv.UserByID(3).Enabled, ShouldBeFalse)
+		})
+
+		Convey("FRU", func() {
+			fru, err := c.FRU(0)
+			So(err, ShouldBeNil)
+			So(fru, ShouldResemble, &ipmi.FRU{
+				ChassisType:         0x17,
+				ChassisSerial:       "FK2F47K",
+				BoardManufacturer:   "DELL",
+				BoardProductName:    "072T6D",
+				BoardSerial:         "CN7475164K0187",
+				ProductManufacturer: "DELL",
+				ProductName:         "PowerEdge R730",
+				ProductSerial:       "FK2F47K",
+			})
+			_, err = c.FRU(1)
+			So(ipmi.IsCompletion(err, ipmi.CompletionNotPresent), ShouldBeTrue)
+		})
+
+		Convey("SDR及传感器读数", func() {
+			records, err := c.SensorRecords()
+			So(err, ShouldBeNil)
+			So(len(records), ShouldEqual, 2)
+			So(records[0].Name, ShouldEqual, "Inlet Temp")
+			So(records[0].Units(), ShouldEqual, "degrees C")
+			v, ok := records[0].Threshold(ipmi.UpperCritical)
+			So(ok, ShouldBeTrue)
+			So(v, ShouldEqual, 47)
+			_, ok = records[0].Threshold(ipmi.UpperNonRecoverable)
+			So(ok, ShouldBeFalse)
+
+			So(records[1].Units(), ShouldEqual, "RPM")
+			reading, err := c.GetSensorReading(records[1].Number)
+			So(err, ShouldBeNil)
+			So(reading.Available, ShouldBeTrue)
+			So(records[1].Convert(reading.Raw), ShouldEqual, 5880)
+
+			srv.Lock()
+			srv.Sensors[0].Reading = 45
+			srv.Unlock()
+			reading, _ = c.GetSensorReading(records[0].Number)
+			So(reading.Crossed(ipmi.UpperNonCritical), ShouldBeTrue)
+			So(reading.Crossed(ipmi.UpperCritical), ShouldBeFalse)
+		})
+
+		Convey("SEL读取", func() {
+			records, err := c.SELRecords(0)
+			So(err, ShouldBeNil)
+			So(records, ShouldBeEmpty)
+
+			srv.SetSEL([][]byte{
+				{0x01, 0x00, 0x02, 0x69, 0x38, 0xa6, 0x60, 0x20, 0x00, 0x04, 0x01, 0x30, 0x01, 0x59, 0x30, 0x2f},
+				{0x05, 0x00, 0x02, 0x10, 0x00, 0x00, 0x00, 0x20, 0x00, 0x04, 0x0c, 0x08, 0xef, 0x01, 0xff, 0xff},
+				{0x09, 0x00, 0xc1, 0x6a, 0x38, 0xa6, 0x60, 0xa2, 0x02, 0x00, 0xde, 0xad, 0xbe, 0xef, 0x00, 0x01},
+			})
+			records, err = c.SELRecords(0)
+			So(err, ShouldBeNil)
+			So(len(records), ShouldEqual, 3)
+
+			So(records[0].ID, ShouldEqual, 1)
+			So(records[0].Timestamp, ShouldResemble, time.Date(2021, 5, 20, 10, 22, 33, 0, time.UTC))
+			So(records[0].SensorTypeName(), ShouldEqual, "Temperature")
+			So(records[0].Event(), ShouldEqual, "Upper Critical going high")
+			So(records[0].Severity(), ShouldEqual, ipmi.SeverityCritical)
+			So(records[0].Message(), ShouldEqual, "Temperature #0x30 | Upper Critical going high | Asserted")
+
+			So(records[1].Timestamp.IsZero(), ShouldBeTrue)
+			So(records[1].Deassertion, ShouldBeTrue)
+			So(records[1].Severity(), ShouldEqual, ipmi.SeverityInformation)
+			So(records[1].Message(), ShouldEqual, "Memory #0x08 | Uncorrectable ECC | Deasserted")
+
+			So(records[2].OEM(), ShouldBeTrue)
+			So(records[2].ManufacturerID, ShouldEqual, 674)
+			So(records[2].Severity(), ShouldEqual, ipmi.SeverityUnknown)
+			So(records[2].Message(), ShouldEqual, "OEM record c1 | 0002a2 | deadbeef0001")
+
+			records, err = c.SELRecords(5)
+			So(err, ShouldBeNil)
+			So(len(records), ShouldEqual, 1)
+			So(records[0].ID, ShouldEqual, 9)
+
+			records, err = c.SELRecords(3) // 记录已不存在
+			So(err, ShouldBeNil)
+			So(len(records), ShouldEqual, 2)
+
+			records, err = c.SELRecords(9)
+			So(err, ShouldBeNil)
+			So(records, ShouldBeEmpty)
+
+			_, err = ipmi.ParseSELRecord(make([]byte, 8))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("SEL清除及BMC冷重启", func() {
+			srv.SetSEL([][]byte{make([]byte, 16)})
+			So(c.ClearSEL(), ShouldBeNil)
+			So(srv.SELLen(), ShouldEqual, 0)
+			So(c.ColdReset(), ShouldBeNil)
+			So(srv.ColdResetCount(), ShouldEqual, 1)
+		})
+	})
+}
+
+func TestSDRCache(t *testing.T) {
+	Convey("SDR缓存", t, func() {
+		dev := ipmitest.NewDevice(nil)
+		c := ipmi.NewLocalClient(dev)
+		c.SDRCache = filepath.Join(t.TempDir(), "sdr.cache")
+
+		records, err := c.SensorRecords()
+		So(err, ShouldBeNil)
+		So(len(records), ShouldEqual, 2)
+		cached, err := ipmi.ReadSDRCache(c.SDRCache)
+		So(err, ShouldBeNil)
+		So(len(cached), ShouldEqual, 2)
+
+		requests := dev.Requests()
+		records, err = c.SensorRecords()
+		So(err, ShouldBeNil)
+		So(len(records), ShouldEqual, 2)
+		So(dev.Requests()-requests, ShouldEqual, 1) // 仅查询SDR仓库信息
+
+		Convey("记录数变化后刷新缓存", func() {
+			dev.Lock()
+			dev.Sensors = dev.Sensors[:1]
+			dev.Unlock()
+			records, err := c.SensorRecords()
+			So(err, ShouldBeNil)
+			So(len(records), ShouldEqual, 1)
+			cached, _ := ipmi.ReadSDRCache(c.SDRCache)
+			So(len(cached), ShouldEqual, 1)
+		})
+
+		Convey("缓存文件不完整", func() {
+			So(ioutil.WriteFile(c.SDRCache, []byte{0x01, 0x00, 0x51, 0x01, 0x30, 0x00}, 0644), ShouldBeNil)
+			_, err := ipmi.ReadSDRCache(c.SDRCache)
+			So(err, ShouldNotBeNil)
+			records, err := c.SensorRecords() // 重新读取并覆盖
+			So(err, ShouldBeNil)
+			So(len(records), ShouldEqual, 2)
+		})
+	})
+}
+
+func TestLocalClient(t *testing.T) {
+	Convey("本机IPMI设备", t, func() {
+		dev := ipmitest.NewDevice(nil)
+		c := ipmi.NewLocalClient(dev)
+		So(c.Open(), ShouldBeNil)
+
+		id, err := c.GetDeviceID()
+		So(err, ShouldBeNil)
+		So(id.ManufacturerID, ShouldEqual, 674)
+
+		cfg, err := c.LANConfig(1)
+		So(err, ShouldBeNil)
+		So(cfg.IP.String(), ShouldEqual, "192.168.0.120")
+
+		fru, err := c.FRU(0)
+		So(err, ShouldBeNil)
+		So(fru.ProductSerial, ShouldEqual, "FK2F47K")
+
+		_, err = c.Send(0x30, 0x01, nil)
+		So(ipmi.IsCompletion(err, ipmi.CompletionInvalidCommand), ShouldBeTrue)
+		So(dev.Requests(), ShouldBeGreaterThan, 3)
+
+		So(c.Close(), ShouldBeNil)
+		So(dev.Closes(), ShouldEqual, 1)
+
+		Convey("设备不存在", func() {
+			c := ipmi.NewLocalClient(ipmi.NewDevice("/dev/ipmi-not-exist"))
+			_, err := c.GetDeviceID()
+			So(os.IsNotExist(err), ShouldBeTrue)
+			So(c.Close(), ShouldBeNil)
+		})
+	})
+}
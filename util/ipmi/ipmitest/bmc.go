@@ -0,0 +1,597 @@
+// Package ipmitest 提供用于测试的模拟BMC。
+// BMC维护电源、引导、LAN、用户、FRU、SEL及传感器等状态并响应常用的IPMI命令，Server以RMCP+协议在本地UDP端口上提供服务，Device则模拟本机IPMI设备。
+package ipmitest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"sync"
+
+	"github.com/licairong/cloudboot-provider-framework/util/ipmi"
+)
+
+// HandlerFunc IPMI命令处理函数，返回完成码及响应数据。
+type HandlerFunc func(req []byte) (code uint8, resp []byte)
+
+// User 模拟BMC的用户
+type User struct {
+	Name          string
+	Password      string
+	Enabled       bool
+	Privilege     uint8
+	Callin        bool
+	LinkAuth      bool
+	IPMIMessaging bool
+}
+
+// Sensor 模拟BMC基于阈值的全量传感器
+type Sensor struct {
+	Number      uint8
+	Name        string
+	Type        uint8
+	UnitsFlags  uint8
+	BaseUnit    uint8
+	M           int16
+	B           int16
+	BExp        int8
+	RExp        int8
+	Readable    uint8    // 可读阈值掩码
+	Thresholds  [6]uint8 // 原始阈值，按ipmi.Threshold索引。
+	Reading     uint8
+	Unavailable bool
+}
+
+const (
+	// maxFRURead 单次可读取的FRU数据最大字节数
+	maxFRURead = 24
+	// lanParamRevision LAN配置参数版本
+	lanParamRevision = 0x11
+)
+
+// BMC 模拟BMC。可直接读写导出字段，并发访问时需先加锁或使用相应的方法。
+type BMC struct {
+	sync.Mutex
+	DeviceID   ipmi.DeviceID
+	GUID       [16]byte
+	PowerOn    bool
+	BootFlags  []byte           // 系统引导参数-引导标志
+	Channel    uint8            // LAN通道号
+	LAN        map[uint8][]byte // LAN配置参数
+	Users      []*User          // Users[i]的用户ID为i+1
+	FRU        []byte
+	SEL        [][]byte // 16字节的SEL记录，按记录ID升序排列。
+	Sensors    []*Sensor
+	ColdResets int
+
+	resv     uint16
+	handlers map[uint16]HandlerFunc
+}
+
+// NewBMC 返回具有默认状态的模拟BMC。默认管理员用户root的ID为2、密码为calvin。
+func NewBMC() *BMC {
+	b := BMC{
+		DeviceID: ipmi.DeviceID{
+			DeviceID:       0x20,
+			DeviceRevision: 0x01,
+			FirmwareMajor:  2,
+			FirmwareMinor:  0x81,
+			IPMIVersion:    0x02,
+			ManufacturerID: 674,
+			ProductID:      0x0100,
+		},
+		Channel: 1,
+		LAN: map[uint8][]byte{
+			ipmi.LANParamIPSource:       {ipmi.IPSourceDHCP},
+			ipmi.LANParamIPAddress:      net.ParseIP("192.168.0.120").To4(),
+			ipmi.LANParamSubnetMask:     net.ParseIP("255.255.255.0").To4(),
+			ipmi.LANParamDefaultGateway: net.ParseIP("192.168.0.1").To4(),
+			ipmi.LANParamMACAddress:     {0x18, 0x66, 0xda, 0x5f, 0x2e, 0x10},
+		},
+		FRU: EncodeFRU(&ipmi.FRU{
+			ChassisType:         0x17,
+			ChassisSerial:       "FK2F47K",
+			BoardManufacturer:   "DELL",
+			BoardProductName:    "072T6D",
+			BoardSerial:         "CN7475164K0187",
+			ProductManufacturer: "DELL",
+			ProductName:         "PowerEdge R730",
+			ProductSerial:       "FK2F47K",
+		}),
+		Sensors: []*Sensor{
+			{
+				Number: 0x04, Name: "Inlet Temp", Type: 0x01, BaseUnit: 1, M: 1,
+				Readable: 0x1B, Thresholds: [6]uint8{0, 3, 8, 42, 47, 0}, Reading: 23,
+			},
+			{
+				Number: 0x30, Name: "Fan1 RPM", Type: 0x04, BaseUnit: 18, M: 120,
+				Readable: 0x02, Thresholds: [6]uint8{0, 5, 0, 0, 0, 0}, Reading: 49,
+			},
+		},
+		handlers: make(map[uint16]HandlerFunc),
+	}
+	copy(b.GUID[:], "44454c4c4b001037")
+	b.Users = make([]*User, 16)
+	for i := range b.Users {
+		b.Users[i] = &User{Privilege: ipmi.PrivilegeNoAccess}
+	}
+	b.Users[1] = &User{
+		Name:          "root",
+		Password:      "calvin",
+		Enabled:       true,
+		Privilege:     ipmi.PrivilegeAdministrator,
+		Callin:        true,
+		LinkAuth:      true,
+		IPMIMessaging: true,
+	}
+	return &b
+}
+
+// HandleFunc 注册命令处理函数，覆盖模拟BMC的默认处理。
+func (b *BMC) HandleFunc(netfn ipmi.NetFn, cmd uint8, h HandlerFunc) {
+	b.Lock()
+	defer b.Unlock()
+	b.handlers[uint16(netfn)<<8|uint16(cmd)] = h
+}
+
+// Handle 处理IPMI命令并返回完成码及响应数据
+func (b *BMC) Handle(netfn ipmi.NetFn, cmd uint8, req []byte) (code uint8, resp []byte) {
+	b.Lock()
+	h, ok := b.handlers[uint16(netfn)<<8|uint16(cmd)]
+	if ok {
+		b.Unlock()
+		return h(req)
+	}
+	defer b.Unlock()
+
+	switch netfn {
+	case ipmi.NetFnApp:
+		return b.app(cmd, req)
+	case ipmi.NetFnChassis:
+		return b.chassis(cmd, req)
+	case ipmi.NetFnTransport:
+		return b.transport(cmd, req)
+	case ipmi.NetFnStorage:
+		return b.storage(cmd, req)
+	case ipmi.NetFnSensorEvent:
+		return b.sensorEvent(cmd, req)
+	}
+	return ipmi.CompletionInvalidCommand, nil
+}
+
+// user 返回指定ID的用户
+func (b *BMC) user(id uint8) *User {
+	id &= 0x3F
+	if id == 0 || int(id) > len(b.Users) {
+		return nil
+	}
+	return b.Users[id-1]
+}
+
+// FindUser 返回指定用户名的已启用用户的副本
+func (b *BMC) FindUser(name string) *User {
+	b.Lock()
+	defer b.Unlock()
+	for _, u := range b.Users {
+		if u.Name == name && u.Enabled {
+			cp := *u
+			return &cp
+		}
+	}
+	return nil
+}
+
+// UserByID 返回指定ID的用户的副本，用户不存在时返回nil。
+func (b *BMC) UserByID(id uint8) *User {
+	b.Lock()
+	defer b.Unlock()
+	if u := b.user(id); u != nil {
+		cp := *u
+		return &cp
+	}
+	return nil
+}
+
+// IsPoweredOn 返回是否已上电
+func (b *BMC) IsPoweredOn() bool {
+	b.Lock()
+	defer b.Unlock()
+	return b.PowerOn
+}
+
+// CurrentBootFlags 返回当前引导标志的副本
+func (b *BMC) CurrentBootFlags() []byte {
+	b.Lock()
+	defer b.Unlock()
+	return append([]byte(nil), b.BootFlags...)
+}
+
+// SetSEL 替换全部SEL记录
+func (b *BMC) SetSEL(records [][]byte) {
+	b.Lock()
+	defer b.Unlock()
+	b.SEL = records
+}
+
+// SELLen 返回SEL记录数
+func (b *BMC) SELLen() int {
+	b.Lock()
+	defer b.Unlock()
+	return len(b.SEL)
+}
+
+// ColdResetCount 返回BMC冷重启次数
+func (b *BMC) ColdResetCount() int {
+	b.Lock()
+	defer b.Unlock()
+	return b.ColdResets
+}
+
+func (b *BMC) app(cmd uint8, req []byte) (uint8, []byte) {
+	switch cmd {
+	case ipmi.CmdGetDeviceID:
+		d := b.DeviceID
+		return 0, []byte{
+			d.DeviceID, d.DeviceRevision, d.FirmwareMajor, d.FirmwareMinor, d.IPMIVersion, 0xBF,
+			uint8(d.ManufacturerID), uint8(d.ManufacturerID >> 8), uint8(d.ManufacturerID >> 16),
+			uint8(d.ProductID), uint8(d.ProductID >> 8),
+		}
+	case ipmi.CmdColdReset:
+		b.ColdResets++
+		return 0, nil
+	case ipmi.CmdGetChannelInfo:
+		if len(req) < 1 {
+			return ipmi.CompletionRequestLength, nil
+		}
+		ch := req[0] & 0x0F
+		if ch == 0x0E {
+			ch = b.Channel
+		}
+		if ch != b.Channel {
+			return ipmi.CompletionInvalidDataField, nil
+		}
+		return 0, []byte{ch, ipmi.MediumLAN, 0x01, 0x80, 0xF2, 0x1B, 0x00, 0x00, 0x00}
+	case ipmi.CmdGetUserAccess:
+		if len(req) < 2 {
+			return ipmi.CompletionRequestLength, nil
+		}
+		u := b.user(req[1])
+		if u == nil {
+			return ipmi.CompletionParameterOutOfRange, nil
+		}
+		var enabled uint8
+		for _, v := range b.Users {
+			if v.Enabled {
+				enabled++
+			}
+		}
+		access := u.Privilege & 0x0F
+		if !u.Callin {
+			access |= 0x40
+		}
+		if u.LinkAuth {
+			access |= 0x20
+		}
+		if u.IPMIMessaging {
+			access |= 0x10
+		}
+		return 0, []byte{uint8(len(b.Users)), enabled, 0x01, access}
+	case ipmi.CmdSetUserAccess:
+		if len(req) < 3 {
+			return ipmi.CompletionRequestLength, nil
+		}
+		u := b.user(req[1])
+		if u == nil {
+			return ipmi.CompletionParameterOutOfRange, nil
+		}
+		if req[0]&0x80 != 0 {
+			u.Callin = req[0]&0x40 == 0
+			u.LinkAuth = req[0]&0x20 != 0
+			u.IPMIMessaging = req[0]&0x10 != 0
+		}
+		u.Privilege = req[2] & 0x0F
+		return 0, nil
+	case ipmi.CmdGetUserName:
+		if len(req) < 1 || b.user(req[0]) == nil {
+			return ipmi.CompletionParameterOutOfRange, nil
+		}
+		name := make([]byte, 16)
+		copy(name, b.user(req[0]).Name)
+		return 0, name
+	case ipmi.CmdSetUserName:
+		if len(req) < 17 {
+			return ipmi.CompletionRequestLength, nil
+		}
+		u := b.user(req[0])
+		if u == nil || req[0]&0x3F == 1 { // 1号用户名不可修改
+			return ipmi.CompletionInvalidDataField, nil
+		}
+		u.Name = string(bytes.TrimRight(req[1:17], "\x00"))
+		return 0, nil
+	case ipmi.CmdSetUserPassword:
+		if len(req) < 2 {
+			return ipmi.CompletionRequestLength, nil
+		}
+		u := b.user(req[0])
+		if u == nil {
+			return ipmi.CompletionParameterOutOfRange, nil
+		}
+		switch req[1] & 0x03 {
+		case 0x00:
+			u.Enabled = false
+		case 0x01:
+			u.Enabled = true
+		case 0x02:
+			size := 16
+			if req[0]&0x80 != 0 {
+				size = 20
+			}
+			if len(req) != 2+size {
+				return ipmi.CompletionRequestLength, nil
+			}
+			u.Password = string(bytes.TrimRight(req[2:], "\x00"))
+		case 0x03:
+			if string(bytes.TrimRight(req[2:], "\x00")) != u.Password {
+				return 0x80, nil
+			}
+		}
+		return 0, nil
+	}
+	return ipmi.CompletionInvalidCommand, nil
+}
+
+func (b *BMC) chassis(cmd uint8, req []byte) (uint8, []byte) {
+	switch cmd {
+	case ipmi.CmdGetChassisStatus:
+		var state uint8
+		if b.PowerOn {
+			state = 0x01
+		}
+		return 0, []byte{state, 0x00, 0x00, 0x00}
+	case ipmi.CmdChassisControl:
+		if len(req) < 1 {
+			return ipmi.CompletionRequestLength, nil
+		}
+		switch ipmi.ChassisControl(req[0]) {
+		case ipmi.ControlPowerDown, ipmi.ControlSoftShutdown:
+			b.PowerOn = false
+		case ipmi.ControlPowerUp:
+			b.PowerOn = true
+		case ipmi.ControlPowerCycle, ipmi.ControlHardReset:
+			if !b.PowerOn {
+				return 0xD5, nil // 当前状态下不支持该命令
+			}
+		default:
+			return ipmi.CompletionInvalidDataField, nil
+		}
+		return 0, nil
+	case ipmi.CmdSetSystemBootOptions:
+		if len(req) < 1 {
+			return ipmi.CompletionRequestLength, nil
+		}
+		if req[0]&0x7F == 0x05 {
+			b.BootFlags = append([]byte(nil), req[1:]...)
+		}
+		return 0, nil
+	}
+	return ipmi.CompletionInvalidCommand, nil
+}
+
+func (b *BMC) transport(cmd uint8, req []byte) (uint8, []byte) {
+	if len(req) < 2 {
+		return ipmi.CompletionRequestLength, nil
+	}
+	if ch := req[0] & 0x0F; ch != b.Channel && ch != 0x0E {
+		return ipmi.CompletionInvalidDataField, nil
+	}
+	switch cmd {
+	case ipmi.CmdGetLANConfigParameters:
+		value, ok := b.LAN[req[1]]
+		if !ok {
+			return 0x80, nil // 参数不支持
+		}
+		return 0, append([]byte{lanParamRevision}, value...)
+	case ipmi.CmdSetLANConfigParameters:
+		b.LAN[req[1]] = append([]byte(nil), req[2:]...)
+		return 0, nil
+	}
+	return ipmi.CompletionInvalidCommand, nil
+}
+
+func (b *BMC) storage(cmd uint8, req []byte) (uint8, []byte) {
+	switch cmd {
+	case ipmi.CmdGetFRUInventoryAreaInfo:
+		if len(req) < 1 || req[0] != 0 || b.FRU == nil {
+			return ipmi.CompletionNotPresent, nil
+		}
+		return 0, []byte{uint8(len(b.FRU)), uint8(len(b.FRU) >> 8), 0x00}
+	case ipmi.CmdReadFRUData:
+		if len(req) < 4 || req[0] != 0 || b.FRU == nil {
+			return ipmi.CompletionNotPresent, nil
+		}
+		off, n := int(binary.LittleEndian.Uint16(req[1:3])), int(req[3])
+		if n > maxFRURead {
+			return ipmi.CompletionRequestTooLong, nil
+		}
+		if off >= len(b.FRU) {
+			return ipmi.CompletionParameterOutOfRange, nil
+		}
+		if off+n > len(b.FRU) {
+			n = len(b.FRU) - off
+		}
+		return 0, append([]byte{uint8(n)}, b.FRU[off:off+n]...)
+	case ipmi.CmdGetSELInfo:
+		return 0, []byte{0x51, uint8(len(b.SEL)), uint8(len(b.SEL) >> 8), 0xFF, 0xFF, 0, 0, 0, 0, 0, 0, 0, 0, 0x02}
+	case ipmi.CmdGetSELEntry:
+		return b.getSELEntry(req)
+	case ipmi.CmdReserveSEL, ipmi.CmdReserveSDRRepository:
+		b.resv++
+		return 0, []byte{uint8(b.resv), uint8(b.resv >> 8)}
+	case ipmi.CmdClearSEL:
+		if len(req) < 6 || binary.LittleEndian.Uint16(req[0:2]) != b.resv {
+			return ipmi.CompletionReservationCanceled, nil
+		}
+		if string(req[2:5]) != "CLR" {
+			return ipmi.CompletionInvalidDataField, nil
+		}
+		if req[5] == 0xAA {
+			b.SEL = nil
+		}
+		return 0, []byte{0x01} // 清除完成
+	case ipmi.CmdGetSDRRepositoryInfo:
+		return 0, []byte{0x51, uint8(len(b.Sensors)), uint8(len(b.Sensors) >> 8), 0xFF, 0xFF, 0, 0, 0, 0, 0, 0, 0, 0, 0x02}
+	case ipmi.CmdGetSDR:
+		return b.getSDR(req)
+	}
+	return ipmi.CompletionInvalidCommand, nil
+}
+
+// getSELEntry 按记录中的记录ID查找SEL记录，0000h为首条记录，FFFFh为末条记录。
+func (b *BMC) getSELEntry(req []byte) (uint8, []byte) {
+	if len(req) < 6 {
+		return ipmi.CompletionRequestLength, nil
+	}
+	id := binary.LittleEndian.Uint16(req[2:4])
+	for i, rec := range b.SEL {
+		switch {
+		case id == 0x0000 && i == 0, id == 0xFFFF && i == len(b.SEL)-1, binary.LittleEndian.Uint16(rec[0:2]) == id:
+		default:
+			continue
+		}
+		next := uint16(0xFFFF)
+		if i+1 < len(b.SEL) {
+			next = binary.LittleEndian.Uint16(b.SEL[i+1][0:2])
+		}
+		return 0, append([]byte{uint8(next), uint8(next >> 8)}, rec...)
+	}
+	return ipmi.CompletionNotPresent, nil
+}
+
+// getSDR 以传感器在Sensors中的位置（从1开始）作为记录ID返回SDR记录
+func (b *BMC) getSDR(req []byte) (uint8, []byte) {
+	if len(req) < 6 {
+		return ipmi.CompletionRequestLength, nil
+	}
+	id, off, n := int(binary.LittleEndian.Uint16(req[2:4])), int(req[4]), int(req[5])
+	if off > 0 && binary.LittleEndian.Uint16(req[0:2]) != b.resv {
+		return ipmi.CompletionReservationCanceled, nil
+	}
+	if id == 0 {
+		id = 1
+	}
+	if id > len(b.Sensors) {
+		return ipmi.CompletionNotPresent, nil
+	}
+	rec := b.Sensors[id-1].record(uint16(id))
+	if n == 0xFF {
+		n = len(rec) - off
+	}
+	if off+n > len(rec) {
+		return ipmi.CompletionCannotReturnBytes, nil
+	}
+	next := uint16(id + 1)
+	if id == len(b.Sensors) {
+		next = 0xFFFF
+	}
+	return 0, append([]byte{uint8(next), uint8(next >> 8)}, rec[off:off+n]...)
+}
+
+// record 返回传感器的全量传感器记录
+func (s *Sensor) record(id uint16) []byte {
+	rec := make([]byte, 48, 48+len(s.Name))
+	binary.LittleEndian.PutUint16(rec[0:2], id)
+	rec[2], rec[3] = 0x51, 0x01
+	rec[5], rec[7], rec[8], rec[9] = 0x20, s.Number, 0x07, 0x01
+	rec[12], rec[13] = s.Type, 0x01
+	rec[18], rec[19] = s.Readable, s.Readable
+	rec[20], rec[21] = s.UnitsFlags, s.BaseUnit
+	rec[24], rec[25] = uint8(s.M), uint8(s.M>>8&0x03)<<6
+	rec[26], rec[27] = uint8(s.B), uint8(s.B>>8&0x03)<<6
+	rec[29] = uint8(s.RExp&0x0F)<<4 | uint8(s.BExp&0x0F)
+	rec[36] = s.Thresholds[ipmi.UpperNonRecoverable]
+	rec[37] = s.Thresholds[ipmi.UpperCritical]
+	rec[38] = s.Thresholds[ipmi.UpperNonCritical]
+	rec[39] = s.Thresholds[ipmi.LowerNonRecoverable]
+	rec[40] = s.Thresholds[ipmi.LowerCritical]
+	rec[41] = s.Thresholds[ipmi.LowerNonCritical]
+	rec[47] = 0xC0 | uint8(len(s.Name))
+	rec = append(rec, s.Name...)
+	rec[4] = uint8(len(rec) - 5)
+	return rec
+}
+
+func (b *BMC) sensorEvent(cmd uint8, req []byte) (uint8, []byte) {
+	if cmd != ipmi.CmdGetSensorReading {
+		return ipmi.CompletionInvalidCommand, nil
+	}
+	if len(req) < 1 {
+		return ipmi.CompletionRequestLength, nil
+	}
+	for _, s := range b.Sensors {
+		if s.Number != req[0] {
+			continue
+		}
+		flags := uint8(0x40)
+		if s.Unavailable {
+			flags |= 0x20
+		}
+		var status uint8
+		for t, bit := range map[ipmi.Threshold]uint8{
+			ipmi.LowerNonCritical:    0x01,
+			ipmi.LowerCritical:       0x02,
+			ipmi.LowerNonRecoverable: 0x04,
+		} {
+			if s.Readable&bit != 0 && s.Reading <= s.Thresholds[t] {
+				status |= bit
+			}
+		}
+		for t, bit := range map[ipmi.Threshold]uint8{
+			ipmi.UpperNonCritical:    0x08,
+			ipmi.UpperCritical:       0x10,
+			ipmi.UpperNonRecoverable: 0x20,
+		} {
+			if s.Readable&bit != 0 && s.Reading >= s.Thresholds[t] {
+				status |= bit
+			}
+		}
+		return 0, []byte{s.Reading, flags, status}
+	}
+	return ipmi.CompletionNotPresent, nil
+}
+
+// EncodeFRU 以8位ASCII字段编码FRU的机箱、主板及产品信息区域
+func EncodeFRU(fru *ipmi.FRU) []byte {
+	area := func(prefix []byte, fields ...string) []byte {
+		b := append([]byte{0x01, 0x00}, prefix...)
+		for _, f := range fields {
+			b = append(b, 0xC0|uint8(len(f)))
+			b = append(b, f...)
+		}
+		b = append(b, 0xC1)
+		for (len(b)+1)%8 != 0 {
+			b = append(b, 0x00)
+		}
+		b[1] = uint8((len(b) + 1) / 8)
+		return append(b, checksum(b))
+	}
+	chassis := area([]byte{fru.ChassisType}, fru.ChassisPartNumber, fru.ChassisSerial)
+	board := area([]byte{0x19, 0x00, 0x00, 0x00},
+		fru.BoardManufacturer, fru.BoardProductName, fru.BoardSerial, fru.BoardPartNumber, "")
+	product := area([]byte{0x19},
+		fru.ProductManufacturer, fru.ProductName, fru.ProductPartNumber, fru.ProductVersion,
+		fru.ProductSerial, fru.ProductAssetTag, "")
+
+	hdr := []byte{0x01, 0x00, 0x01, uint8(1 + len(chassis)/8), uint8(1 + (len(chassis)+len(board))/8), 0x00, 0x00}
+	hdr = append(hdr, checksum(hdr))
+	data := append(hdr, chassis...)
+	data = append(data, board...)
+	return append(data, product...)
+}
+
+func checksum(b []byte) uint8 {
+	var c uint8
+	for _, v := range b {
+		c += v
+	}
+	return -c
+}
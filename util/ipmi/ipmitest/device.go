@@ -0,0 +1,54 @@
+package ipmitest
+
+import (
+	"github.com/licairong/cloudboot-provider-framework/util/ipmi"
+)
+
+var _ ipmi.Transport = (*Device)(nil)
+
+// Device 模拟的本机IPMI设备（如/dev/ipmi0），由模拟BMC直接响应请求，实现ipmi.Transport。
+type Device struct {
+	*BMC
+	requests int
+	closes   int
+}
+
+// NewDevice 返回模拟的本机IPMI设备。bmc为nil时使用NewBMC的返回值。
+func NewDevice(bmc *BMC) *Device {
+	if bmc == nil {
+		bmc = NewBMC()
+	}
+	return &Device{BMC: bmc}
+}
+
+// Exchange 由模拟BMC处理请求并返回完成码及响应数据
+func (d *Device) Exchange(netfn ipmi.NetFn, cmd uint8, data []byte) (uint8, []byte, error) {
+	d.Lock()
+	d.requests++
+	d.Unlock()
+
+	code, resp := d.Handle(netfn, cmd, data)
+	return code, resp, nil
+}
+
+// Close 关闭设备。与真实设备一致，关闭后再次发送请求时重新打开。
+func (d *Device) Close() error {
+	d.Lock()
+	defer d.Unlock()
+	d.closes++
+	return nil
+}
+
+// Requests 返回设备已处理的请求数
+func (d *Device) Requests() int {
+	d.Lock()
+	defer d.Unlock()
+	return d.requests
+}
+
+// Closes 返回设备被关闭的次数
+func (d *Device) Closes() int {
+	d.Lock()
+	defer d.Unlock()
+	return d.closes
+}
@@ -0,0 +1,385 @@
+package ipmitest
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"net"
+	"sync"
+
+	"github.com/licairong/cloudboot-provider-framework/util/ipmi"
+)
+
+// Server 以RMCP+协议在本地UDP端口上响应IPMI请求的模拟BMC服务
+type Server struct {
+	*BMC
+
+	conn     *net.UDPConn
+	mux      sync.Mutex
+	nextID   uint32
+	opened   int
+	sessions map[uint32]*session // BMC会话ID -> 会话
+	done     chan struct{}
+}
+
+// session 模拟BMC上的RMCP+会话
+type session struct {
+	consoleID, bmcID uint32
+	auth, integrity  uint8
+	confidentiality  uint8
+	hash             func() hash.Hash
+	privilege        uint8
+	user             *User
+	role             uint8
+	name             []byte
+	rm, rc           []byte
+	k1, k2           []byte
+	seq              uint32
+	active           bool
+}
+
+// NewServer 启动以RMCP+协议提供服务的模拟BMC。bmc为nil时使用NewBMC的返回值。
+func NewServer(bmc *BMC) *Server {
+	if bmc == nil {
+		bmc = NewBMC()
+	}
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		panic("ipmitest: failed to listen on a port: " + err.Error())
+	}
+	s := &Server{
+		BMC:      bmc,
+		conn:     conn,
+		nextID:   0x0200,
+		sessions: make(map[uint32]*session),
+		done:     make(chan struct{}),
+	}
+	go s.serve()
+	return s
+}
+
+// Addr 返回服务监听的地址，格式为'host:port'。
+func (s *Server) Addr() string {
+	return s.conn.LocalAddr().String()
+}
+
+// Close 停止服务
+func (s *Server) Close() error {
+	err := s.conn.Close()
+	<-s.done
+	return err
+}
+
+// Opened 返回已成功建立的会话总数
+func (s *Server) Opened() int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.opened
+}
+
+// Active 返回当前活动的会话数
+func (s *Server) Active() int {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	var n int
+	for _, sess := range s.sessions {
+		if sess.active {
+			n++
+		}
+	}
+	return n
+}
+
+// ExpireSessions 丢弃全部会话，模拟BMC因会话超时而关闭会话。
+func (s *Server) ExpireSessions() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.sessions = make(map[uint32]*session)
+}
+
+func (s *Server) serve() {
+	defer close(s.done)
+	buf := make([]byte, 1024)
+	for {
+		n, addr, err := s.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		if resp := s.handle(append([]byte(nil), buf[:n]...)); resp != nil {
+			_, _ = s.conn.WriteToUDP(resp, addr)
+		}
+	}
+}
+
+func (s *Server) handle(pkt []byte) []byte {
+	if len(pkt) < 14 || pkt[0] != 0x06 || pkt[3] != 0x07 {
+		return nil
+	}
+	switch pkt[4] {
+	case 0x00:
+		return s.handleV15(pkt)
+	case 0x06:
+	default:
+		return nil
+	}
+	if len(pkt) < 16 {
+		return nil
+	}
+	length := int(binary.LittleEndian.Uint16(pkt[14:16]))
+	if len(pkt) < 16+length {
+		return nil
+	}
+	payload := pkt[16 : 16+length]
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	switch pkt[5] & 0x3F {
+	case 0x10:
+		return s.openSession(payload)
+	case 0x12:
+		return s.rakp1(payload)
+	case 0x14:
+		return s.rakp3(payload)
+	case 0x00:
+		return s.message(pkt, payload)
+	}
+	return nil
+}
+
+// handleV15 响应会话建立前的Get Channel Authentication Capabilities请求
+func (s *Server) handleV15(pkt []byte) []byte {
+	if len(pkt) < 14+int(pkt[13]) {
+		return nil
+	}
+	msg := pkt[14 : 14+int(pkt[13])]
+	if len(msg) < 7 || msg[1]>>2 != uint8(ipmi.NetFnApp) || msg[5] != ipmi.CmdGetChannelAuthCapabilities {
+		return nil
+	}
+	resp := response(msg, 0, []byte{s.Channel, 0x80, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00})
+	out := []byte{0x06, 0x00, 0xFF, 0x07, 0x00, 0, 0, 0, 0, 0, 0, 0, 0, uint8(len(resp))}
+	return append(out, resp...)
+}
+
+func (s *Server) openSession(p []byte) []byte {
+	if len(p) < 32 {
+		return nil
+	}
+	sess := &session{
+		consoleID:       binary.LittleEndian.Uint32(p[4:8]),
+		privilege:       p[1],
+		auth:            p[12],
+		integrity:       p[20],
+		confidentiality: p[28],
+	}
+	status := uint8(0)
+	switch sess.auth {
+	case 0x01:
+		sess.hash = sha1.New
+	case 0x03:
+		sess.hash = sha256.New
+	default:
+		status = 0x04
+	}
+	if sess.integrity != 0 && sess.integrity != 0x01 && sess.integrity != 0x04 {
+		status = 0x05
+	}
+	if sess.confidentiality > 0x01 {
+		status = 0x10
+	}
+
+	resp := []byte{p[0], status, sess.privilege, 0}
+	resp = appendUint32(resp, sess.consoleID)
+	if status == 0 {
+		s.nextID++
+		sess.bmcID = s.nextID
+		s.sessions[sess.bmcID] = sess
+	}
+	resp = appendUint32(resp, sess.bmcID)
+	resp = append(resp, p[8:32]...)
+	return s.pack(nil, 0x11, resp)
+}
+
+func (s *Server) rakp1(p []byte) []byte {
+	if len(p) < 28 || len(p) < 28+int(p[27]) {
+		return nil
+	}
+	sess := s.sessions[binary.LittleEndian.Uint32(p[4:8])]
+	if sess == nil {
+		return nil
+	}
+	sess.rm = append([]byte(nil), p[8:24]...)
+	sess.role = p[24]
+	sess.name = append([]byte(nil), p[28:28+int(p[27])]...)
+
+	resp := []byte{p[0], 0, 0, 0}
+	resp = appendUint32(resp, sess.consoleID)
+	if sess.user = s.FindUser(string(sess.name)); sess.user == nil {
+		resp[1] = 0x0D // 用户名未授权
+		return s.pack(nil, 0x13, resp)
+	}
+	if sess.role&0x0F > sess.user.Privilege {
+		resp[1] = 0x0A // 请求的权限级别未授权
+		return s.pack(nil, 0x13, resp)
+	}
+	sess.rc = make([]byte, 16)
+	_, _ = rand.Read(sess.rc)
+	resp = append(resp, sess.rc...)
+	resp = append(resp, s.GUID[:]...)
+	resp = append(resp, sess.mac([]byte(sess.user.Password),
+		appendUint32(appendUint32(nil, sess.consoleID), sess.bmcID),
+		sess.rm, sess.rc, s.GUID[:], []byte{sess.role, uint8(len(sess.name))}, sess.name)...)
+	return s.pack(nil, 0x13, resp)
+}
+
+func (s *Server) rakp3(p []byte) []byte {
+	if len(p) < 8 {
+		return nil
+	}
+	sess := s.sessions[binary.LittleEndian.Uint32(p[4:8])]
+	if sess == nil || sess.user == nil {
+		return nil
+	}
+	resp := []byte{p[0], 0, 0, 0}
+	resp = appendUint32(resp, sess.consoleID)
+
+	key := []byte(sess.user.Password)
+	name := append([]byte{sess.role, uint8(len(sess.name))}, sess.name...)
+	expected := sess.mac(key, sess.rc, appendUint32(nil, sess.consoleID), name)
+	if p[1] != 0 || !hmac.Equal(expected, p[8:]) {
+		resp[1] = 0x0F // 完整性校验值无效
+		delete(s.sessions, sess.bmcID)
+		return s.pack(nil, 0x15, resp)
+	}
+
+	sik := sess.mac(key, sess.rm, sess.rc, name)
+	sess.k1 = sess.mac(sik, bytes.Repeat([]byte{0x01}, 20))
+	sess.k2 = sess.mac(sik, bytes.Repeat([]byte{0x02}, 20))
+	icvLen := 12
+	if sess.auth == 0x03 {
+		icvLen = 16
+	}
+	resp = append(resp, sess.mac(sik, sess.rm, appendUint32(nil, sess.bmcID), s.GUID[:])[:icvLen]...)
+	sess.active = true
+	s.opened++
+	return s.pack(nil, 0x15, resp)
+}
+
+// message 处理会话中的IPMI消息
+func (s *Server) message(pkt, payload []byte) []byte {
+	sess := s.sessions[binary.LittleEndian.Uint32(pkt[6:10])]
+	if sess == nil || !sess.active {
+		return nil
+	}
+	if pkt[5]&0x40 != 0 {
+		n := len(pkt) - sess.authCodeLen()
+		if n < 16 || !hmac.Equal(sess.mac(sess.k1, pkt[4:n])[:sess.authCodeLen()], pkt[n:]) {
+			return nil
+		}
+	}
+	if pkt[5]&0x80 != 0 {
+		if len(payload) < 32 || len(payload)%16 != 0 {
+			return nil
+		}
+		block, _ := aes.NewCipher(sess.k2[:16])
+		plain := make([]byte, len(payload)-16)
+		cipher.NewCBCDecrypter(block, payload[:16]).CryptBlocks(plain, payload[16:])
+		payload = plain[:len(plain)-int(plain[len(plain)-1])-1]
+	}
+	if len(payload) < 7 {
+		return nil
+	}
+
+	netfn, cmd, data := ipmi.NetFn(payload[1]>>2), payload[5], payload[6:len(payload)-1]
+	var code uint8
+	var resp []byte
+	switch {
+	case netfn == ipmi.NetFnApp && cmd == ipmi.CmdSetSessionPrivilegeLevel:
+		if len(data) > 0 && data[0] != 0 {
+			if data[0] > sess.user.Privilege {
+				code = 0x81 // 请求的权限级别超过用户权限
+			} else {
+				sess.privilege = data[0]
+			}
+		}
+		resp = []byte{sess.privilege}
+	case netfn == ipmi.NetFnApp && cmd == ipmi.CmdCloseSession:
+		defer delete(s.sessions, sess.bmcID)
+	default:
+		s.mux.Unlock()
+		code, resp = s.Handle(netfn, cmd, data)
+		s.mux.Lock()
+	}
+	return s.pack(sess, 0x00, response(payload, code, resp))
+}
+
+// pack 以RMCP+会话头封装载荷
+func (s *Server) pack(sess *session, ptype uint8, payload []byte) []byte {
+	var sid, seq uint32
+	if sess != nil {
+		sess.seq++
+		sid, seq = sess.consoleID, sess.seq
+		if sess.confidentiality == 0x01 {
+			block, _ := aes.NewCipher(sess.k2[:16])
+			pad := (16 - (len(payload)+1)%16) % 16
+			plain := append([]byte(nil), payload...)
+			for i := 1; i <= pad; i++ {
+				plain = append(plain, uint8(i))
+			}
+			plain = append(plain, uint8(pad))
+			enc := make([]byte, 16+len(plain))
+			_, _ = rand.Read(enc[:16])
+			cipher.NewCBCEncrypter(block, enc[:16]).CryptBlocks(enc[16:], plain)
+			payload = enc
+			ptype |= 0x80
+		}
+		if sess.integrity != 0 {
+			ptype |= 0x40
+		}
+	}
+	out := []byte{0x06, 0x00, 0xFF, 0x07, 0x06, ptype}
+	out = appendUint32(out, sid)
+	out = appendUint32(out, seq)
+	out = append(out, uint8(len(payload)), uint8(len(payload)>>8))
+	out = append(out, payload...)
+	if ptype&0x40 != 0 {
+		pad := (4 - (len(out)-4+2)%4) % 4
+		out = append(out, bytes.Repeat([]byte{0xFF}, pad)...)
+		out = append(out, uint8(pad), 0x07)
+		out = append(out, sess.mac(sess.k1, out[4:])[:sess.authCodeLen()]...)
+	}
+	return out
+}
+
+func (sess *session) mac(key []byte, data ...[]byte) []byte {
+	h := hmac.New(sess.hash, key)
+	for i := range data {
+		h.Write(data[i])
+	}
+	return h.Sum(nil)
+}
+
+func (sess *session) authCodeLen() int {
+	if sess.integrity == 0x04 {
+		return 16
+	}
+	return 12
+}
+
+// response 根据请求消息构造响应消息
+func response(req []byte, code uint8, data []byte) []byte {
+	msg := []byte{req[3], (req[1]>>2 + 1) << 2, 0}
+	msg[2] = checksum(msg[:2])
+	msg = append(msg, req[0], req[4], req[5], code)
+	msg = append(msg, data...)
+	return append(msg, checksum(msg[3:]))
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, uint8(v), uint8(v>>8), uint8(v>>16), uint8(v>>24))
+}
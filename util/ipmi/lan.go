@@ -0,0 +1,477 @@
+package ipmi
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultPort RMCP+默认端口
+	DefaultPort = 623
+	// DefaultTimeout 单次请求等待响应的默认超时时间
+	DefaultTimeout = 2 * time.Second
+	// DefaultRetries 请求超时后的默认重发次数
+	DefaultRetries = 2
+)
+
+const (
+	rmcpVersion   = 0x06
+	rmcpSeqNoAck  = 0xFF
+	rmcpClassIPMI = 0x07
+
+	authTypeNone     = 0x00
+	authTypeRMCPPlus = 0x06
+
+	payloadIPMI                = 0x00
+	payloadOpenSessionRequest  = 0x10
+	payloadOpenSessionResponse = 0x11
+	payloadRAKP1               = 0x12
+	payloadRAKP2               = 0x13
+	payloadRAKP3               = 0x14
+	payloadRAKP4               = 0x15
+
+	payloadEncrypted     = 0x80
+	payloadAuthenticated = 0x40
+	payloadTypeMask      = 0x3F
+
+	// nameOnlyLookup RAKP1中的角色标志位，表示仅按用户名（不含权限级别）查找用户。
+	nameOnlyLookup = 0x10
+)
+
+// Client IPMI客户端。默认基于RMCP+，首次发送请求时建立会话，此后的请求复用该会话。
+// 指定Transport时经由其发送请求（如本机IPMI设备），忽略LAN会话相关的字段。
+type Client struct {
+	Transport Transport // 请求的传输方式，为nil时使用RMCP+会话。
+	SDRCache  string    // SDR缓存文件路径，为空时不缓存。
+
+	Hostname    string        // BMC地址，可带端口，默认端口623。
+	Username    string        // 用户名
+	Password    string        // 密码
+	CipherSuite int           // 加密套件ID，支持1、2、3、17。
+	Privilege   uint8         // 请求的会话权限级别
+	Timeout     time.Duration // 单次请求等待响应的超时时间
+	Retries     int           // 请求超时后的重发次数
+
+	mux  sync.Mutex
+	sess *session
+}
+
+// NewClient 返回使用默认加密套件、以管理员权限建立会话的客户端。
+func NewClient(hostname, username, password string) *Client {
+	return &Client{
+		Hostname:    hostname,
+		Username:    username,
+		Password:    password,
+		CipherSuite: DefaultCipherSuite,
+		Privilege:   PrivilegeAdministrator,
+		Timeout:     DefaultTimeout,
+		Retries:     DefaultRetries,
+	}
+}
+
+// Open 建立会话。会话已建立或指定了Transport时直接返回。
+func (c *Client) Open() error {
+	if c.Transport != nil {
+		return nil
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.open()
+}
+
+func (c *Client) open() error {
+	if c.sess != nil {
+		return nil
+	}
+	if c.Hostname == "" {
+		return ErrNoHostname
+	}
+	if len(c.Username) > 16 || len(c.Password) > 20 {
+		return errors.New("ipmi: username or password too long")
+	}
+	suite, err := lookupCipherSuite(c.CipherSuite)
+	if err != nil {
+		return err
+	}
+
+	addr := c.Hostname
+	if _, _, err = net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, strconv.Itoa(DefaultPort))
+	}
+	conn, err := net.Dial("udp", addr)
+	if err != nil {
+		return err
+	}
+	sess := &session{
+		conn:      conn,
+		suite:     suite,
+		username:  []byte(c.Username),
+		password:  []byte(c.Password),
+		privilege: c.Privilege,
+		timeout:   c.Timeout,
+		retries:   c.Retries,
+	}
+	if err = sess.open(); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	c.sess = sess
+	return nil
+}
+
+// Close 关闭会话或Transport
+func (c *Client) Close() error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.Transport != nil {
+		return c.Transport.Close()
+	}
+	if c.sess == nil {
+		return nil
+	}
+	err := c.sess.close()
+	c.sess = nil
+	return err
+}
+
+// Send 发送请求并返回响应数据（不含完成码）。完成码非0时返回CompletionError。
+// 若复用的会话已失效（如已被BMC超时关闭），则重新建立会话并重试一次。
+func (c *Client) Send(netfn NetFn, cmd uint8, data []byte) ([]byte, error) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	exchange := c.exchange
+	if c.Transport != nil {
+		exchange = c.Transport.Exchange
+	}
+	code, resp, err := exchange(netfn, cmd, data)
+	if err != nil {
+		return nil, err
+	}
+	if code != CompletionOK {
+		return nil, &CompletionError{NetFn: netfn, Cmd: cmd, Code: code}
+	}
+	return resp, nil
+}
+
+// exchange 经由RMCP+会话发送请求
+func (c *Client) exchange(netfn NetFn, cmd uint8, data []byte) (uint8, []byte, error) {
+	reused := c.sess != nil
+	if err := c.open(); err != nil {
+		return 0, nil, err
+	}
+	resp, err := c.sess.send(netfn, cmd, data)
+	if err == ErrTimeout && reused {
+		_ = c.sess.conn.Close()
+		c.sess = nil
+		if err = c.open(); err != nil {
+			return 0, nil, err
+		}
+		resp, err = c.sess.send(netfn, cmd, data)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.code, resp.data, nil
+}
+
+// session RMCP+会话
+type session struct {
+	conn      net.Conn
+	suite     *cipherSuite
+	username  []byte
+	password  []byte
+	privilege uint8
+	timeout   time.Duration
+	retries   int
+
+	consoleID uint32 // 远程控制台会话ID（SIDm）
+	bmcID     uint32 // BMC会话ID（SIDc）
+	seq       uint32 // 会话序列号
+	rqSeq     uint8  // 请求序列号
+	k1, k2    []byte // 完整性及加密密钥
+	active    bool
+}
+
+// open 通过Open Session及RAKP1-4握手建立会话，并设置会话权限级别。
+func (s *session) open() (err error) {
+	if err = s.authCapabilities(); err != nil {
+		return err
+	}
+	if s.consoleID, err = randomID(); err != nil {
+		return err
+	}
+
+	const tag = 0x00
+	req := []byte{tag, s.privilege, 0, 0}
+	req = appendUint32(req, s.consoleID)
+	req = append(req, 0x00, 0, 0, 0x08, s.suite.auth, 0, 0, 0)
+	req = append(req, 0x01, 0, 0, 0x08, s.suite.integrity, 0, 0, 0)
+	req = append(req, 0x02, 0, 0, 0x08, s.suite.confidentiality, 0, 0, 0)
+	resp, err := s.handshake(payloadOpenSessionRequest, req, payloadOpenSessionResponse, tag)
+	if err != nil {
+		return err
+	}
+	if resp[1] != 0 {
+		return &AuthError{Stage: "open session", Status: resp[1]}
+	}
+	if len(resp) < 12 || binary.LittleEndian.Uint32(resp[4:8]) != s.consoleID {
+		return errors.New("ipmi: invalid open session response")
+	}
+	s.bmcID = binary.LittleEndian.Uint32(resp[8:12])
+
+	rm := make([]byte, 16)
+	if _, err = rand.Read(rm); err != nil {
+		return err
+	}
+	role := s.privilege | nameOnlyLookup
+	name := append([]byte{role, uint8(len(s.username))}, s.username...)
+
+	req = []byte{tag, 0, 0, 0}
+	req = appendUint32(req, s.bmcID)
+	req = append(req, rm...)
+	req = append(req, role, 0, 0, uint8(len(s.username)))
+	req = append(req, s.username...)
+	if resp, err = s.handshake(payloadRAKP1, req, payloadRAKP2, tag); err != nil {
+		return err
+	}
+	if resp[1] != 0 {
+		return &AuthError{Stage: "RAKP2", Status: resp[1]}
+	}
+	if len(resp) < 40+s.suite.hash().Size() {
+		return &AuthError{Stage: "RAKP2", Status: 0x0F}
+	}
+	rc, guid := resp[8:24], resp[24:40]
+	ids := appendUint32(appendUint32(nil, s.consoleID), s.bmcID)
+	if !hmac.Equal(s.suite.mac(s.password, ids, rm, rc, guid, name), resp[40:40+s.suite.hash().Size()]) {
+		return &AuthError{Stage: "RAKP2", Status: 0x0F} // 密码错误
+	}
+	sik := s.suite.mac(s.password, rm, rc, name)
+	s.k1, s.k2 = s.suite.keys(sik)
+
+	req = []byte{tag, 0, 0, 0}
+	req = appendUint32(req, s.bmcID)
+	req = append(req, s.suite.mac(s.password, rc, appendUint32(nil, s.consoleID), name)...)
+	if resp, err = s.handshake(payloadRAKP3, req, payloadRAKP4, tag); err != nil {
+		return err
+	}
+	if resp[1] != 0 {
+		return &AuthError{Stage: "RAKP4", Status: resp[1]}
+	}
+	icv := s.suite.mac(sik, rm, appendUint32(nil, s.bmcID), guid)[:s.suite.icvLen]
+	if len(resp) < 8+s.suite.icvLen || !hmac.Equal(icv, resp[8:8+s.suite.icvLen]) {
+		return &AuthError{Stage: "RAKP4", Status: 0x0F}
+	}
+	s.active = true
+
+	if s.privilege <= PrivilegeUser {
+		return nil
+	}
+	r, err := s.send(NetFnApp, CmdSetSessionPrivilegeLevel, []byte{s.privilege})
+	if err != nil {
+		return err
+	}
+	if r.code != CompletionOK {
+		return &CompletionError{NetFn: NetFnApp, Cmd: CmdSetSessionPrivilegeLevel, Code: r.code}
+	}
+	return nil
+}
+
+// authCapabilities 以IPMI v1.5无认证数据包获取通道认证能力，确认BMC支持IPMI v2.0。
+func (s *session) authCapabilities() error {
+	msg := encodeRequest(NetFnApp, CmdGetChannelAuthCapabilities, 0, []byte{0x8E, s.privilege}) // 0x8E: 当前通道，请求v2.0扩展能力。
+	raw, err := s.exchange(func() ([]byte, error) {
+		pkt := append(rmcpHeader(), authTypeNone, 0, 0, 0, 0, 0, 0, 0, 0, uint8(len(msg)))
+		return append(pkt, msg...), nil
+	}, func(pkt []byte) ([]byte, bool) {
+		if len(pkt) < 14 || pkt[3] != rmcpClassIPMI || pkt[4] != authTypeNone || len(pkt) < 14+int(pkt[13]) {
+			return nil, false
+		}
+		r, err := decodeResponse(pkt[14 : 14+int(pkt[13])])
+		if err != nil || r.cmd != CmdGetChannelAuthCapabilities {
+			return nil, false
+		}
+		return pkt[14 : 14+int(pkt[13])], true
+	})
+	if err != nil {
+		return err
+	}
+	r, _ := decodeResponse(raw)
+	if r.code != CompletionOK {
+		return &CompletionError{NetFn: NetFnApp, Cmd: CmdGetChannelAuthCapabilities, Code: r.code}
+	}
+	if len(r.data) < 4 || r.data[1]&0x80 == 0 || r.data[3]&0x02 == 0 {
+		return errors.New("ipmi: BMC does not support IPMI v2.0 RMCP+")
+	}
+	return nil
+}
+
+// handshake 发送会话建立阶段的载荷并返回相应类型的响应载荷
+func (s *session) handshake(reqType uint8, payload []byte, respType, tag uint8) ([]byte, error) {
+	return s.exchange(func() ([]byte, error) {
+		return s.pack(reqType, payload)
+	}, func(pkt []byte) ([]byte, bool) {
+		ptype, _, p, err := s.unpack(pkt)
+		if err != nil || ptype != respType || len(p) < 8 || p[0] != tag {
+			return nil, false
+		}
+		return p, true
+	})
+}
+
+// send 在会话中发送IPMI请求
+func (s *session) send(netfn NetFn, cmd uint8, data []byte) (*response, error) {
+	s.rqSeq = (s.rqSeq + 1) & 0x3F
+	seq := s.rqSeq
+	msg := encodeRequest(netfn, cmd, seq, data)
+	raw, err := s.exchange(func() ([]byte, error) {
+		return s.pack(payloadIPMI, msg)
+	}, func(pkt []byte) ([]byte, bool) {
+		ptype, sid, p, err := s.unpack(pkt)
+		if err != nil || ptype != payloadIPMI || sid != s.consoleID {
+			return nil, false
+		}
+		r, err := decodeResponse(p)
+		if err != nil || r.seq != seq || r.cmd != cmd || r.netfn != netfn+1 {
+			return nil, false // 丢弃过期的响应
+		}
+		return p, true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeResponse(raw)
+}
+
+// close 关闭会话及连接
+func (s *session) close() error {
+	if s.active {
+		s.retries = 0
+		_, _ = s.send(NetFnApp, CmdCloseSession, appendUint32(nil, s.bmcID))
+		s.active = false
+	}
+	return s.conn.Close()
+}
+
+// exchange 发送数据包并等待匹配的响应，超时后重发。build在每次发送时调用，以保证会话序列号递增。
+func (s *session) exchange(build func() ([]byte, error), match func(pkt []byte) ([]byte, bool)) ([]byte, error) {
+	buf := make([]byte, 1024)
+	for attempt := 0; attempt <= s.retries; attempt++ {
+		pkt, err := build()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = s.conn.Write(pkt); err != nil {
+			return nil, err
+		}
+		if err = s.conn.SetReadDeadline(time.Now().Add(s.timeout)); err != nil {
+			return nil, err
+		}
+		for {
+			n, err := s.conn.Read(buf)
+			if ne, ok := err.(net.Error); ok && ne.Timeout() {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			if p, ok := match(buf[:n]); ok {
+				return append([]byte(nil), p...), nil
+			}
+		}
+	}
+	return nil, ErrTimeout
+}
+
+// pack 以RMCP+会话头封装载荷。会话激活后，IPMI消息载荷按加密套件加密并附加完整性校验值。
+func (s *session) pack(ptype uint8, payload []byte) (pkt []byte, err error) {
+	var sid, seq uint32
+	if ptype == payloadIPMI && s.active {
+		s.seq++
+		sid, seq = s.bmcID, s.seq
+		if s.suite.confidentiality != confidentialityNone {
+			if payload, err = encrypt(s.k2, payload); err != nil {
+				return nil, err
+			}
+			ptype |= payloadEncrypted
+		}
+		if s.suite.integrity != integrityNone {
+			ptype |= payloadAuthenticated
+		}
+	}
+	pkt = append(rmcpHeader(), authTypeRMCPPlus, ptype)
+	pkt = appendUint32(pkt, sid)
+	pkt = appendUint32(pkt, seq)
+	pkt = append(pkt, uint8(len(payload)), uint8(len(payload)>>8))
+	pkt = append(pkt, payload...)
+	if ptype&payloadAuthenticated != 0 {
+		// 完整性填充：使会话头至Next Header字段的长度为4的倍数
+		pad := (4 - (len(pkt)-4+2)%4) % 4
+		for i := 0; i < pad; i++ {
+			pkt = append(pkt, 0xFF)
+		}
+		pkt = append(pkt, uint8(pad), rmcpClassIPMI)
+		pkt = append(pkt, s.suite.mac(s.k1, pkt[4:])[:s.suite.authCodeLen]...)
+	}
+	return pkt, nil
+}
+
+// unpack 解析RMCP+数据包，校验完整性并解密载荷。
+func (s *session) unpack(pkt []byte) (ptype uint8, sid uint32, payload []byte, err error) {
+	if len(pkt) < 16 || pkt[0] != rmcpVersion || pkt[3] != rmcpClassIPMI || pkt[4] != authTypeRMCPPlus {
+		return 0, 0, nil, errors.New("ipmi: not a RMCP+ packet")
+	}
+	ptype, sid = pkt[5], binary.LittleEndian.Uint32(pkt[6:10])
+	length := int(binary.LittleEndian.Uint16(pkt[14:16]))
+	if len(pkt) < 16+length {
+		return 0, 0, nil, errors.New("ipmi: truncated RMCP+ packet")
+	}
+	payload = pkt[16 : 16+length]
+
+	if ptype&payloadAuthenticated != 0 {
+		n := len(pkt) - s.suite.authCodeLen
+		if !s.active || s.suite.integrity == integrityNone || n < 16+length+2 {
+			return 0, 0, nil, errors.New("ipmi: unexpected authenticated packet")
+		}
+		if !hmac.Equal(s.suite.mac(s.k1, pkt[4:n])[:s.suite.authCodeLen], pkt[n:]) {
+			return 0, 0, nil, errors.New("ipmi: integrity check failed")
+		}
+	}
+	if ptype&payloadEncrypted != 0 {
+		if !s.active || s.suite.confidentiality == confidentialityNone {
+			return 0, 0, nil, errors.New("ipmi: unexpected encrypted packet")
+		}
+		if payload, err = decrypt(s.k2, payload); err != nil {
+			return 0, 0, nil, err
+		}
+	}
+	return ptype & payloadTypeMask, sid, payload, nil
+}
+
+func rmcpHeader() []byte {
+	return []byte{rmcpVersion, 0x00, rmcpSeqNoAck, rmcpClassIPMI}
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+	return append(b, uint8(v), uint8(v>>8), uint8(v>>16), uint8(v>>24))
+}
+
+// randomID 返回非0的随机会话ID
+func randomID() (uint32, error) {
+	b := make([]byte, 4)
+	for {
+		if _, err := rand.Read(b); err != nil {
+			return 0, fmt.Errorf("ipmi: %s", err)
+		}
+		if id := binary.LittleEndian.Uint32(b); id != 0 {
+			return id, nil
+		}
+	}
+}
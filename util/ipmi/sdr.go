@@ -0,0 +1,350 @@
+package ipmi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// sdrFullSensor SDR记录类型-全量传感器
+	sdrFullSensor = 0x01
+	// sdrHeaderSize SDR记录头长度
+	sdrHeaderSize = 5
+	// sdrChunkSize 单次读取SDR记录体的最大字节数
+	sdrChunkSize = 16
+	// sdrLastRecord 最后一条记录的下一记录ID
+	sdrLastRecord = 0xFFFF
+)
+
+// Threshold 传感器阈值
+type Threshold int
+
+const (
+	// LowerNonRecoverable 下限-不可恢复
+	LowerNonRecoverable Threshold = iota
+	// LowerCritical 下限-严重
+	LowerCritical
+	// LowerNonCritical 下限-非严重
+	LowerNonCritical
+	// UpperNonCritical 上限-非严重
+	UpperNonCritical
+	// UpperCritical 上限-严重
+	UpperCritical
+	// UpperNonRecoverable 上限-不可恢复
+	UpperNonRecoverable
+)
+
+// thresholdBits 阈值在可读阈值掩码及阈值状态中对应的位
+var thresholdBits = [...]uint8{
+	LowerNonRecoverable: 0x04,
+	LowerCritical:       0x02,
+	LowerNonCritical:    0x01,
+	UpperNonCritical:    0x08,
+	UpperCritical:       0x10,
+	UpperNonRecoverable: 0x20,
+}
+
+// unitNames 传感器基本单位名称，与ipmitool一致。
+var unitNames = [...]string{
+	"unspecified", "degrees C", "degrees F", "degrees K", "Volts", "Amps", "Watts", "Joules",
+	"Coulombs", "VA", "Nits", "lumen", "lux", "Candela", "kPa", "PSI", "Newton", "CFM", "RPM", "Hz",
+	"microsecond", "millisecond", "second", "minute", "hour", "day", "week",
+}
+
+// SensorRecord 全量传感器记录（SDR类型01h）
+type SensorRecord struct {
+	RecordID       uint16
+	OwnerID        uint8
+	OwnerLUN       uint8
+	Number         uint8
+	EntityID       uint8
+	EntityInstance uint8
+	SensorType     uint8
+	EventType      uint8 // 事件/读数类型码，01h表示基于阈值的传感器。
+	Name           string
+	UnitsFlags     uint8 // 传感器单位1：读数格式、百分比等。
+	BaseUnit       uint8
+	ModifierUnit   uint8
+	M              int16
+	B              int16
+	BExp           int8
+	RExp           int8
+	Readable       uint8    // 可读阈值掩码
+	Thresholds     [6]uint8 // 原始阈值，按Threshold索引。
+}
+
+// parseSensorRecord 解析全量传感器记录
+func parseSensorRecord(rec []byte) *SensorRecord {
+	if len(rec) < 48 || rec[3] != sdrFullSensor {
+		return nil
+	}
+	r := SensorRecord{
+		RecordID:       binary.LittleEndian.Uint16(rec[0:2]),
+		OwnerID:        rec[5],
+		OwnerLUN:       rec[6] & 0x03,
+		Number:         rec[7],
+		EntityID:       rec[8],
+		EntityInstance: rec[9] & 0x7F,
+		SensorType:     rec[12],
+		EventType:      rec[13],
+		Readable:       rec[18] & 0x3F,
+		UnitsFlags:     rec[20],
+		BaseUnit:       rec[21],
+		ModifierUnit:   rec[22],
+		M:              tenBits(rec[24], rec[25]),
+		B:              tenBits(rec[26], rec[27]),
+		RExp:           fourBits(rec[29] >> 4),
+		BExp:           fourBits(rec[29] & 0x0F),
+	}
+	r.Thresholds[UpperNonRecoverable] = rec[36]
+	r.Thresholds[UpperCritical] = rec[37]
+	r.Thresholds[UpperNonCritical] = rec[38]
+	r.Thresholds[LowerNonRecoverable] = rec[39]
+	r.Thresholds[LowerCritical] = rec[40]
+	r.Thresholds[LowerNonCritical] = rec[41]
+	if n := int(rec[47] & 0x1F); 48+n <= len(rec) {
+		r.Name = strings.TrimRight(string(rec[48:48+n]), "\x00")
+	}
+	return &r
+}
+
+// tenBits 返回10位二进制补码表示的M或B值
+func tenBits(ls, ms uint8) int16 {
+	v := int16(ls) | int16(ms&0xC0)<<2
+	if v&0x200 != 0 {
+		v -= 0x400
+	}
+	return v
+}
+
+// fourBits 返回4位二进制补码表示的指数
+func fourBits(v uint8) int8 {
+	if v&0x08 != 0 {
+		return int8(v) - 16
+	}
+	return int8(v)
+}
+
+// Analog 返回传感器是否提供模拟读数
+func (r *SensorRecord) Analog() bool {
+	return r.EventType == 0x01 && r.UnitsFlags>>6 != 0x03
+}
+
+// Convert 将原始读数按线性公式 y = (M*x + B*10^BExp) * 10^RExp 转换为实际值
+func (r *SensorRecord) Convert(raw uint8) float64 {
+	var x float64
+	switch r.UnitsFlags >> 6 {
+	case 0x01: // 反码
+		x = float64(int8(raw))
+		if int8(raw) < 0 {
+			x++
+		}
+	case 0x02: // 补码
+		x = float64(int8(raw))
+	default:
+		x = float64(raw)
+	}
+	y := (float64(r.M)*x + float64(r.B)*math.Pow10(int(r.BExp))) * math.Pow10(int(r.RExp))
+	return math.Round(y*1000) / 1000
+}
+
+// Threshold 返回阈值的实际值。阈值不可读时返回false。
+func (r *SensorRecord) Threshold(t Threshold) (float64, bool) {
+	if r.Readable&thresholdBits[t] == 0 {
+		return 0, false
+	}
+	return r.Convert(r.Thresholds[t]), true
+}
+
+// Units 返回传感器单位名称
+func (r *SensorRecord) Units() string {
+	if r.UnitsFlags&0x01 != 0 {
+		return "percent"
+	}
+	if int(r.BaseUnit) < len(unitNames) {
+		return unitNames[r.BaseUnit]
+	}
+	return "unknown"
+}
+
+// SensorReading 传感器读数
+type SensorReading struct {
+	Raw             uint8
+	Available       bool
+	ThresholdStatus uint8 // 越过的阈值，按位与thresholdBits对应。
+}
+
+// Crossed 返回读数是否已越过指定阈值
+func (r *SensorReading) Crossed(t Threshold) bool {
+	return r.ThresholdStatus&thresholdBits[t] != 0
+}
+
+// GetSensorReading 返回传感器读数
+func (c *Client) GetSensorReading(number uint8) (*SensorReading, error) {
+	data, err := c.Send(NetFnSensorEvent, CmdGetSensorReading, []byte{number})
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 2 {
+		return nil, errShortResponse(CmdGetSensorReading)
+	}
+	r := SensorReading{
+		Raw:       data[0],
+		Available: data[1]&0x20 == 0 && data[1]&0x40 != 0, // 读数可用且已启用扫描
+	}
+	if len(data) > 2 {
+		r.ThresholdStatus = data[2] & 0x3F
+	}
+	return &r, nil
+}
+
+// SensorRecords 返回SDR仓库中的全量传感器记录。
+// 若指定了SDRCache，则优先使用缓存文件中的记录，缓存不存在或已过期时重新读取并写入缓存。
+func (c *Client) SensorRecords() ([]*SensorRecord, error) {
+	var (
+		records [][]byte
+		err     error
+	)
+	if c.SDRCache != "" {
+		records, err = c.cachedSDR()
+	} else {
+		records, err = c.SDR()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var items []*SensorRecord
+	for _, rec := range records {
+		if r := parseSensorRecord(rec); r != nil {
+			items = append(items, r)
+		}
+	}
+	return items, nil
+}
+
+// SDR 返回SDR仓库中的全部原始记录
+func (c *Client) SDR() ([][]byte, error) {
+	var resv uint16
+	data, err := c.Send(NetFnStorage, CmdReserveSDRRepository, nil)
+	if err == nil && len(data) >= 2 {
+		resv = binary.LittleEndian.Uint16(data)
+	} else if err != nil && !IsCompletion(err, CompletionInvalidCommand) { // 部分BMC不支持预留
+		return nil, err
+	}
+
+	var records [][]byte
+	for id := uint16(0); id != sdrLastRecord; {
+		next, rec, err := c.getSDR(resv, id)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+		if next == id {
+			break
+		}
+		id = next
+	}
+	return records, nil
+}
+
+// SDRRecordCount 返回SDR仓库中的记录数
+func (c *Client) SDRRecordCount() (int, error) {
+	data, err := c.Send(NetFnStorage, CmdGetSDRRepositoryInfo, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(data) < 3 {
+		return 0, errShortResponse(CmdGetSDRRepositoryInfo)
+	}
+	return int(binary.LittleEndian.Uint16(data[1:3])), nil
+}
+
+// cachedSDR 返回缓存文件中的SDR记录。缓存的记录数与SDR仓库不一致时视为过期。
+func (c *Client) cachedSDR() ([][]byte, error) {
+	if records, err := ReadSDRCache(c.SDRCache); err == nil && len(records) > 0 {
+		count, err := c.SDRRecordCount()
+		if err != nil || count == len(records) { // 无法获取仓库信息时信任缓存
+			return records, nil
+		}
+	}
+	records, err := c.SDR()
+	if err != nil {
+		return nil, err
+	}
+	return records, WriteSDRCache(c.SDRCache, records)
+}
+
+// ReadSDRCache 读取SDR缓存文件，文件格式与'ipmitool sdr dump'一致，即首尾相连的原始SDR记录。
+func ReadSDRCache(path string) ([][]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var records [][]byte
+	for len(data) > 0 {
+		if len(data) < sdrHeaderSize || len(data) < sdrHeaderSize+int(data[4]) {
+			return nil, fmt.Errorf("ipmi: truncated sdr cache %s", path)
+		}
+		n := sdrHeaderSize + int(data[4])
+		records = append(records, data[:n:n])
+		data = data[n:]
+	}
+	return records, nil
+}
+
+// WriteSDRCache 将SDR记录写入缓存文件。先写入临时文件再重命名，避免并发读取到不完整的缓存。
+func WriteSDRCache(path string, records [][]byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	for _, rec := range records {
+		if _, err = f.Write(rec); err != nil {
+			_ = f.Close()
+			return err
+		}
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// getSDR 读取一条SDR记录，先读取记录头，再分段读取记录体。
+func (c *Client) getSDR(resv, id uint16) (next uint16, rec []byte, err error) {
+	read := func(off, n int) ([]byte, error) {
+		data, err := c.Send(NetFnStorage, CmdGetSDR, []byte{uint8(resv), uint8(resv >> 8), uint8(id), uint8(id >> 8), uint8(off), uint8(n)})
+		if err != nil {
+			return nil, err
+		}
+		if len(data) < 2+n {
+			return nil, errShortResponse(CmdGetSDR)
+		}
+		next = binary.LittleEndian.Uint16(data[0:2])
+		return append([]byte(nil), data[2:2+n]...), nil
+	}
+
+	if rec, err = read(0, sdrHeaderSize); err != nil {
+		return 0, nil, err
+	}
+	size := sdrHeaderSize + int(rec[4])
+	for len(rec) < size {
+		n := size - len(rec)
+		if n > sdrChunkSize {
+			n = sdrChunkSize
+		}
+		data, err := read(len(rec), n)
+		if err != nil {
+			return 0, nil, err
+		}
+		rec = append(rec, data...)
+	}
+	return next, rec, nil
+}
@@ -0,0 +1,487 @@
+package ipmi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SELRecordSize SEL记录长度
+const SELRecordSize = 16
+
+// SEL记录类型
+const (
+	// SELSystemEvent 系统事件记录
+	SELSystemEvent = 0x02
+	// SELOEMTimestamped 带时间戳的OEM记录类型下限（C0h-DFh）
+	SELOEMTimestamped = 0xC0
+	// SELOEMNonTimestamped 不带时间戳的OEM记录类型下限（E0h-FFh）
+	SELOEMNonTimestamped = 0xE0
+)
+
+const (
+	// selPreInit 不大于该值的时间戳为BMC初始化前的相对时间
+	selPreInit = 0x20000000
+	// selUnspecified 未指定的时间戳
+	selUnspecified = 0xFFFFFFFF
+	// selLastID 最后一条记录的下一记录ID
+	selLastID = 0xFFFF
+	// eventTypeThreshold 阈值类事件
+	eventTypeThreshold = 0x01
+	// eventTypeSensorSpecific 传感器特定类事件
+	eventTypeSensorSpecific = 0x6F
+)
+
+// Severity 事件严重性级别
+type Severity int
+
+const (
+	// SeverityUnknown 未知
+	SeverityUnknown Severity = iota
+	// SeverityInformation 信息
+	SeverityInformation
+	// SeverityWarning 警告
+	SeverityWarning
+	// SeverityCritical 严重
+	SeverityCritical
+)
+
+// SELRecord SEL记录
+type SELRecord struct {
+	ID             uint16
+	Type           uint8
+	Timestamp      time.Time // BMC初始化前（Pre-Init）及不带时间戳的记录为零值
+	GeneratorID    uint16
+	EvMRev         uint8
+	SensorType     uint8
+	SensorNumber   uint8
+	Deassertion    bool
+	EventType      uint8
+	EventData      [3]uint8
+	ManufacturerID uint32 // 带时间戳的OEM记录的厂商ID
+	OEMData        []byte
+}
+
+// ParseSELRecord 解析16字节的SEL记录
+func ParseSELRecord(data []byte) (*SELRecord, error) {
+	if len(data) < SELRecordSize {
+		return nil, fmt.Errorf("ipmi: sel record too short (%d bytes)", len(data))
+	}
+	r := SELRecord{
+		ID:   binary.LittleEndian.Uint16(data[0:2]),
+		Type: data[2],
+	}
+	if r.Type < SELOEMNonTimestamped {
+		if ts := binary.LittleEndian.Uint32(data[3:7]); ts > selPreInit && ts != selUnspecified {
+			r.Timestamp = time.Unix(int64(ts), 0).UTC()
+		}
+	}
+	switch {
+	case r.Type >= SELOEMNonTimestamped:
+		r.OEMData = append([]byte(nil), data[3:SELRecordSize]...)
+	case r.Type >= SELOEMTimestamped:
+		r.ManufacturerID = uint32(data[7]) | uint32(data[8])<<8 | uint32(data[9])<<16
+		r.OEMData = append([]byte(nil), data[10:SELRecordSize]...)
+	default:
+		r.GeneratorID = binary.LittleEndian.Uint16(data[7:9])
+		r.EvMRev = data[9]
+		r.SensorType = data[10]
+		r.SensorNumber = data[11]
+		r.Deassertion = data[12]&0x80 != 0
+		r.EventType = data[12] & 0x7F
+		copy(r.EventData[:], data[13:16])
+	}
+	return &r, nil
+}
+
+// OEM 返回是否为OEM记录
+func (r *SELRecord) OEM() bool {
+	return r.Type >= SELOEMTimestamped
+}
+
+// Offset 返回事件偏移量
+func (r *SELRecord) Offset() uint8 {
+	return r.EventData[0] & 0x0F
+}
+
+// SensorTypeName 返回传感器类型名称，与ipmitool一致。
+func (r *SELRecord) SensorTypeName() string {
+	if int(r.SensorType) < len(sensorTypeNames) {
+		return sensorTypeNames[r.SensorType]
+	}
+	if r.SensorType >= 0xC0 {
+		return "OEM reserved"
+	}
+	return "Unknown"
+}
+
+// event 返回事件描述及严重性级别
+func (r *SELRecord) event() (desc string, severity Severity) {
+	var events map[uint8]eventDesc
+	switch {
+	case r.EventType == eventTypeThreshold:
+		events = thresholdEvents
+	case r.EventType == eventTypeSensorSpecific:
+		events = sensorSpecificEvents[r.SensorType]
+	case r.EventType >= 0x02 && r.EventType <= 0x0C:
+		events = genericEvents[r.EventType]
+	}
+	e, ok := events[r.Offset()]
+	if !ok {
+		return fmt.Sprintf("Event type 0x%02x offset 0x%02x", r.EventType, r.Offset()), SeverityUnknown
+	}
+	if r.Deassertion {
+		return e.desc, SeverityInformation
+	}
+	return e.desc, e.severity
+}
+
+// Event 返回事件描述，如'Upper Critical going high'。
+func (r *SELRecord) Event() string {
+	if r.OEM() {
+		return ""
+	}
+	desc, _ := r.event()
+	return desc
+}
+
+// Severity 返回事件严重性级别。事件解除（Deasserted）为信息级别，OEM记录为未知级别。
+func (r *SELRecord) Severity() Severity {
+	if r.OEM() {
+		return SeverityUnknown
+	}
+	_, severity := r.event()
+	return severity
+}
+
+// Message 返回与'ipmitool sel elist'一致的事件描述，如'Temperature #0x30 | Upper Critical going high | Asserted'。
+func (r *SELRecord) Message() string {
+	if r.OEM() {
+		if r.Type >= SELOEMNonTimestamped {
+			return fmt.Sprintf("OEM record %02x | %x", r.Type, r.OEMData)
+		}
+		return fmt.Sprintf("OEM record %02x | %06x | %x", r.Type, r.ManufacturerID, r.OEMData)
+	}
+	state := "Asserted"
+	if r.Deassertion {
+		state = "Deasserted"
+	}
+	return strings.Join([]string{fmt.Sprintf("%s #0x%02x", r.SensorTypeName(), r.SensorNumber), r.Event(), state}, " | ")
+}
+
+// SELRecords 读取SEL中记录ID大于since的全部记录，since为0时读取全部记录。
+// 若since对应的记录仍存在则从该记录开始读取，否则从头读取并过滤。
+func (c *Client) SELRecords(since uint16) ([]*SELRecord, error) {
+	id := uint16(0)
+	if since > 0 {
+		if next, _, err := c.getSELEntry(since); err == nil {
+			id = next
+		} else if !IsCompletion(err, CompletionNotPresent) {
+			return nil, err
+		}
+		if id == selLastID {
+			return nil, nil
+		}
+	}
+
+	var records []*SELRecord
+	for n := 0; n < selLastID; n++ {
+		next, data, err := c.getSELEntry(id)
+		if err != nil {
+			if id == 0 && IsCompletion(err, CompletionNotPresent) {
+				return nil, nil // SEL为空
+			}
+			return nil, err
+		}
+		r, err := ParseSELRecord(data)
+		if err != nil {
+			return nil, err
+		}
+		if r.ID > since {
+			records = append(records, r)
+		}
+		if next == selLastID || next == id {
+			break
+		}
+		id = next
+	}
+	return records, nil
+}
+
+// getSELEntry 读取一条完整的SEL记录，返回下一记录ID及记录数据。
+func (c *Client) getSELEntry(id uint16) (next uint16, rec []byte, err error) {
+	data, err := c.Send(NetFnStorage, CmdGetSELEntry, []byte{0, 0, uint8(id), uint8(id >> 8), 0, 0xFF})
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(data) < 2+SELRecordSize {
+		return 0, nil, errShortResponse(CmdGetSELEntry)
+	}
+	return binary.LittleEndian.Uint16(data[0:2]), data[2 : 2+SELRecordSize], nil
+}
+
+// eventDesc 事件描述及断言时的严重性级别
+type eventDesc struct {
+	desc     string
+	severity Severity
+}
+
+// sensorTypeNames 传感器类型名称，与ipmitool一致。
+var sensorTypeNames = [...]string{
+	"reserved", "Temperature", "Voltage", "Current", "Fan", "Physical Security", "Platform Security",
+	"Processor", "Power Supply", "Power Unit", "Cooling Device", "Other", "Memory", "Drive Slot / Bay",
+	"POST Memory Resize", "System Firmwares", "Event Logging Disabled", "Watchdog1", "System Event",
+	"Critical Interrupt", "Button", "Module / Board", "Microcontroller", "Add-in Card", "Chassis",
+	"Chip Set", "Other FRU", "Cable / Interconnect", "Terminator", "System Boot Initiated", "Boot Error",
+	"OS Boot", "OS Critical Stop", "Slot / Connector", "System ACPI Power State", "Watchdog2",
+	"Platform Alert", "Entity Presence", "Monitor ASIC", "LAN", "Management Subsys Health", "Battery",
+	"Session Audit", "Version Change", "FRU State",
+}
+
+// thresholdEvents 阈值类事件
+var thresholdEvents = map[uint8]eventDesc{
+	0x00: {"Lower Non-critical going low", SeverityWarning},
+	0x01: {"Lower Non-critical going high", SeverityWarning},
+	0x02: {"Lower Critical going low", SeverityCritical},
+	0x03: {"Lower Critical going high", SeverityCritical},
+	0x04: {"Lower Non-recoverable going low", SeverityCritical},
+	0x05: {"Lower Non-recoverable going high", SeverityCritical},
+	0x06: {"Upper Non-critical going low", SeverityWarning},
+	0x07: {"Upper Non-critical going high", SeverityWarning},
+	0x08: {"Upper Critical going low", SeverityCritical},
+	0x09: {"Upper Critical going high", SeverityCritical},
+	0x0A: {"Upper Non-recoverable going low", SeverityCritical},
+	0x0B: {"Upper Non-recoverable going high", SeverityCritical},
+}
+
+// genericEvents 通用离散类事件，按事件类型索引。
+var genericEvents = map[uint8]map[uint8]eventDesc{
+	0x02: {
+		0x00: {"Transition to Idle", SeverityInformation},
+		0x01: {"Transition to Active", SeverityInformation},
+		0x02: {"Transition to Busy", SeverityInformation},
+	},
+	0x03: {
+		0x00: {"State Deasserted", SeverityInformation},
+		0x01: {"State Asserted", SeverityInformation},
+	},
+	0x04: {
+		0x00: {"Predictive Failure Deasserted", SeverityInformation},
+		0x01: {"Predictive Failure Asserted", SeverityWarning},
+	},
+	0x05: {
+		0x00: {"Limit Not Exceeded", SeverityInformation},
+		0x01: {"Limit Exceeded", SeverityWarning},
+	},
+	0x06: {
+		0x00: {"Performance Met", SeverityInformation},
+		0x01: {"Performance Lags", SeverityWarning},
+	},
+	0x07: {
+		0x00: {"Transition to OK", SeverityInformation},
+		0x01: {"Transition to Non-critical from OK", SeverityWarning},
+		0x02: {"Transition to Critical from less severe", SeverityCritical},
+		0x03: {"Transition to Non-recoverable from less severe", SeverityCritical},
+		0x04: {"Transition to Non-critical from more severe", SeverityWarning},
+		0x05: {"Transition to Critical from Non-recoverable", SeverityCritical},
+		0x06: {"Transition to Non-recoverable", SeverityCritical},
+		0x07: {"Monitor", SeverityInformation},
+		0x08: {"Informational", SeverityInformation},
+	},
+	0x08: {
+		0x00: {"Device Absent", SeverityInformation},
+		0x01: {"Device Present", SeverityInformation},
+	},
+	0x09: {
+		0x00: {"Device Disabled", SeverityInformation},
+		0x01: {"Device Enabled", SeverityInformation},
+	},
+	0x0A: {
+		0x00: {"Transition to Running", SeverityInformation},
+		0x01: {"Transition to In Test", SeverityInformation},
+		0x02: {"Transition to Power Off", SeverityInformation},
+		0x03: {"Transition to On Line", SeverityInformation},
+		0x04: {"Transition to Off Line", SeverityInformation},
+		0x05: {"Transition to Off Duty", SeverityInformation},
+		0x06: {"Transition to Degraded", SeverityWarning},
+		0x07: {"Transition to Power Save", SeverityInformation},
+		0x08: {"Install Error", SeverityCritical},
+	},
+	0x0B: {
+		0x00: {"Fully Redundant", SeverityInformation},
+		0x01: {"Redundancy Lost", SeverityCritical},
+		0x02: {"Redundancy Degraded", SeverityWarning},
+		0x03: {"Non-Redundant: Sufficient from Redundant", SeverityWarning},
+		0x04: {"Non-Redundant: Sufficient from Insufficient", SeverityWarning},
+		0x05: {"Non-Redundant: Insufficient Resources", SeverityCritical},
+		0x06: {"Redundancy Degraded from Fully Redundant", SeverityWarning},
+		0x07: {"Redundancy Degraded from Non-Redundant", SeverityWarning},
+	},
+	0x0C: {
+		0x00: {"D0 Power State", SeverityInformation},
+		0x01: {"D1 Power State", SeverityInformation},
+		0x02: {"D2 Power State", SeverityInformation},
+		0x03: {"D3 Power State", SeverityInformation},
+	},
+}
+
+// sensorSpecificEvents 传感器特定类事件，按传感器类型索引。
+var sensorSpecificEvents = map[uint8]map[uint8]eventDesc{
+	0x05: { // Physical Security
+		0x00: {"General Chassis intrusion", SeverityCritical},
+		0x01: {"Drive Bay intrusion", SeverityCritical},
+		0x02: {"I/O Card area intrusion", SeverityCritical},
+		0x03: {"Processor area intrusion", SeverityCritical},
+		0x04: {"System unplugged from LAN", SeverityWarning},
+		0x05: {"Unauthorized dock", SeverityWarning},
+		0x06: {"FAN area intrusion", SeverityCritical},
+	},
+	0x07: { // Processor
+		0x00: {"IERR", SeverityCritical},
+		0x01: {"Thermal Trip", SeverityCritical},
+		0x02: {"FRB1/BIST failure", SeverityCritical},
+		0x03: {"FRB2/Hang in POST failure", SeverityCritical},
+		0x04: {"FRB3/Processor startup/init failure", SeverityCritical},
+		0x05: {"Configuration Error", SeverityCritical},
+		0x06: {"SM BIOS Uncorrectable CPU-complex Error", SeverityCritical},
+		0x07: {"Presence detected", SeverityInformation},
+		0x08: {"Disabled", SeverityWarning},
+		0x09: {"Terminator presence detected", SeverityInformation},
+		0x0A: {"Throttled", SeverityWarning},
+		0x0B: {"Uncorrectable machine check exception", SeverityCritical},
+		0x0C: {"Correctable machine check error", SeverityWarning},
+	},
+	0x08: { // Power Supply
+		0x00: {"Presence detected", SeverityInformation},
+		0x01: {"Failure detected", SeverityCritical},
+		0x02: {"Predictive failure", SeverityWarning},
+		0x03: {"Power Supply AC lost", SeverityCritical},
+		0x04: {"AC lost or out-of-range", SeverityCritical},
+		0x05: {"AC out-of-range, but present", SeverityWarning},
+		0x06: {"Config Error", SeverityCritical},
+	},
+	0x09: { // Power Unit
+		0x00: {"Power off/down", SeverityInformation},
+		0x01: {"Power cycle", SeverityInformation},
+		0x02: {"240VA power down", SeverityWarning},
+		0x03: {"Interlock power down", SeverityWarning},
+		0x04: {"AC lost", SeverityCritical},
+		0x05: {"Soft-power control failure", SeverityCritical},
+		0x06: {"Failure detected", SeverityCritical},
+		0x07: {"Predictive failure", SeverityWarning},
+	},
+	0x0C: { // Memory
+		0x00: {"Correctable ECC", SeverityWarning},
+		0x01: {"Uncorrectable ECC", SeverityCritical},
+		0x02: {"Parity", SeverityCritical},
+		0x03: {"Memory Scrub Failed", SeverityCritical},
+		0x04: {"Memory Device Disabled", SeverityWarning},
+		0x05: {"Correctable ECC logging limit reached", SeverityWarning},
+		0x06: {"Presence Detected", SeverityInformation},
+		0x07: {"Configuration Error", SeverityCritical},
+		0x08: {"Spare", SeverityInformation},
+		0x09: {"Throttled", SeverityWarning},
+		0x0A: {"Critical Overtemperature", SeverityCritical},
+	},
+	0x0D: { // Drive Slot / Bay
+		0x00: {"Drive Present", SeverityInformation},
+		0x01: {"Drive Fault", SeverityCritical},
+		0x02: {"Predictive Failure", SeverityWarning},
+		0x03: {"Hot Spare", SeverityInformation},
+		0x04: {"Parity Check In Progress", SeverityInformation},
+		0x05: {"In Critical Array", SeverityCritical},
+		0x06: {"In Failed Array", SeverityCritical},
+		0x07: {"Rebuild In Progress", SeverityWarning},
+		0x08: {"Rebuild Aborted", SeverityCritical},
+	},
+	0x0F: { // System Firmwares
+		0x00: {"System Firmware Error", SeverityCritical},
+		0x01: {"System Firmware Hang", SeverityCritical},
+		0x02: {"System Firmware Progress", SeverityInformation},
+	},
+	0x10: { // Event Logging Disabled
+		0x00: {"Correctable memory error logging disabled", SeverityWarning},
+		0x01: {"Event logging disabled", SeverityWarning},
+		0x02: {"Log area reset/cleared", SeverityInformation},
+		0x03: {"All event logging disabled", SeverityWarning},
+		0x04: {"Log full", SeverityWarning},
+		0x05: {"Log almost full", SeverityWarning},
+	},
+	0x12: { // System Event
+		0x00: {"System Reconfigured", SeverityInformation},
+		0x01: {"OEM System boot event", SeverityInformation},
+		0x02: {"Undetermined system hardware failure", SeverityCritical},
+		0x03: {"Entry added to auxiliary log", SeverityInformation},
+		0x04: {"PEF Action", SeverityInformation},
+		0x05: {"Timestamp Clock Sync", SeverityInformation},
+	},
+	0x13: { // Critical Interrupt
+		0x00: {"NMI/Diag Interrupt", SeverityCritical},
+		0x01: {"Bus Timeout", SeverityCritical},
+		0x02: {"I/O Channel check NMI", SeverityCritical},
+		0x03: {"Software NMI", SeverityCritical},
+		0x04: {"PCI PERR", SeverityCritical},
+		0x05: {"PCI SERR", SeverityCritical},
+		0x06: {"EISA failsafe timeout", SeverityCritical},
+		0x07: {"Bus Correctable error", SeverityWarning},
+		0x08: {"Bus Uncorrectable error", SeverityCritical},
+		0x09: {"Fatal NMI", SeverityCritical},
+		0x0A: {"Bus Fatal Error", SeverityCritical},
+		0x0B: {"Bus Degraded", SeverityWarning},
+	},
+	0x14: { // Button
+		0x00: {"Power Button pressed", SeverityInformation},
+		0x01: {"Sleep Button pressed", SeverityInformation},
+		0x02: {"Reset Button pressed", SeverityInformation},
+		0x03: {"FRU Latch", SeverityInformation},
+		0x04: {"FRU Service", SeverityInformation},
+	},
+	0x1D: { // System Boot Initiated
+		0x00: {"Initiated by power up", SeverityInformation},
+		0x01: {"Initiated by hard reset", SeverityInformation},
+		0x02: {"Initiated by warm reset", SeverityInformation},
+		0x03: {"User requested PXE boot", SeverityInformation},
+		0x04: {"Automatic boot to diagnostic", SeverityInformation},
+	},
+	0x1F: { // OS Boot
+		0x00: {"A: boot completed", SeverityInformation},
+		0x01: {"C: boot completed", SeverityInformation},
+		0x02: {"PXE boot completed", SeverityInformation},
+		0x03: {"Diagnostic boot completed", SeverityInformation},
+		0x04: {"CD-ROM boot completed", SeverityInformation},
+		0x05: {"ROM boot completed", SeverityInformation},
+		0x06: {"boot completed - device not specified", SeverityInformation},
+	},
+	0x20: { // OS Critical Stop
+		0x00: {"Stop during OS load/init", SeverityCritical},
+		0x01: {"Run-time stop", SeverityCritical},
+		0x02: {"OS graceful stop", SeverityInformation},
+		0x03: {"OS graceful shutdown", SeverityInformation},
+		0x04: {"PEF initiated soft shutdown", SeverityInformation},
+		0x05: {"Agent not responding", SeverityWarning},
+	},
+	0x23: { // Watchdog2
+		0x00: {"Timer expired", SeverityWarning},
+		0x01: {"Hard reset", SeverityWarning},
+		0x02: {"Power down", SeverityWarning},
+		0x03: {"Power cycle", SeverityWarning},
+		0x08: {"Timer interrupt", SeverityInformation},
+	},
+	0x25: { // Entity Presence
+		0x00: {"Present", SeverityInformation},
+		0x01: {"Absent", SeverityInformation},
+		0x02: {"Disabled", SeverityInformation},
+	},
+	0x29: { // Battery
+		0x00: {"Low", SeverityWarning},
+		0x01: {"Failed", SeverityCritical},
+		0x02: {"Presence Detected", SeverityInformation},
+	},
+	0x2A: { // Session Audit
+		0x00: {"Session Activated", SeverityInformation},
+		0x01: {"Session Deactivated", SeverityInformation},
+		0x02: {"Invalid Username or Password", SeverityWarning},
+		0x03: {"Invalid password disable", SeverityWarning},
+	},
+}
@@ -0,0 +1,271 @@
+// Package redfish 基于会话认证的Redfish客户端
+package redfish
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ServiceRoot Redfish服务根路径
+	ServiceRoot = "/redfish/v1"
+	// sessionsPath 会话集合路径
+	sessionsPath = "/redfish/v1/SessionService/Sessions"
+	// authTokenHeader 会话令牌请求头
+	authTokenHeader = "X-Auth-Token"
+)
+
+// StatusError BMC返回的非成功状态
+type StatusError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("redfish: %s %s: unexpected status %d: %s", e.Method, e.Path, e.StatusCode, e.Body)
+}
+
+// IsStatus 返回err是否为指定状态码的StatusError
+func IsStatus(err error, code int) bool {
+	e, ok := err.(*StatusError)
+	return ok && e.StatusCode == code
+}
+
+// Client Redfish客户端。
+// 首次请求时创建会话，会话失效（401）时自动重新登录一次；BMC不支持会话服务时使用Basic认证。
+type Client struct {
+	Endpoint   string       // BMC地址，如'https://10.0.0.1'。未指定协议时使用https。
+	Username   string       // 用户名
+	Password   string       // 密码
+	HTTPClient *http.Client // 为nil时使用忽略证书校验的默认客户端
+
+	mux     sync.Mutex
+	token   string // 会话令牌
+	session string // 会话资源路径，登出时删除。
+	basic   bool   // 是否使用Basic认证
+}
+
+// NewClient 返回Redfish客户端实例
+func NewClient(endpoint, username, password string) *Client {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	return &Client{
+		Endpoint: strings.TrimSuffix(endpoint, "/"),
+		Username: username,
+		Password: password,
+	}
+}
+
+var defaultHTTPClient = &http.Client{
+	Timeout: 60 * time.Second,
+	Transport: &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // BMC普遍使用自签名证书
+	},
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return defaultHTTPClient
+}
+
+// Login 创建会话
+func (c *Client) Login() error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.login()
+}
+
+func (c *Client) login() error {
+	body, _ := json.Marshal(map[string]string{"UserName": c.Username, "Password": c.Password})
+	resp, err := c.send(http.MethodPost, sessionsPath, body, false)
+	if err != nil {
+		if IsStatus(err, http.StatusNotFound) || IsStatus(err, http.StatusMethodNotAllowed) {
+			c.basic = true
+			return nil
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	c.token = resp.Header.Get(authTokenHeader)
+	c.session = resp.Header.Get("Location")
+	if c.session == "" {
+		var s struct {
+			ID string `json:"@odata.id"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&s)
+		c.session = s.ID
+	}
+	return nil
+}
+
+// Logout 删除会话。未登录时不做任何操作。
+func (c *Client) Logout() error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if c.token == "" {
+		return nil
+	}
+	session := c.session
+	if i := strings.Index(session, ServiceRoot); i > 0 {
+		session = session[i:] // Location可能为绝对URL
+	}
+	defer func() { c.token, c.session = "", "" }()
+	if session == "" {
+		return nil
+	}
+	resp, err := c.send(http.MethodDelete, session, nil, true)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Get 获取资源并将JSON响应反序列化至v
+func (c *Client) Get(path string, v interface{}) error {
+	return c.Do(http.MethodGet, path, nil, v)
+}
+
+// Post 以JSON格式提交body，v非nil时将响应反序列化至v。
+func (c *Client) Post(path string, body, v interface{}) error {
+	return c.Do(http.MethodPost, path, body, v)
+}
+
+// Patch 以JSON格式更新资源
+func (c *Client) Patch(path string, body interface{}) error {
+	return c.Do(http.MethodPatch, path, body, nil)
+}
+
+// Do 发送认证请求。v非nil时将JSON响应反序列化至v。
+func (c *Client) Do(method, path string, body, v interface{}) error {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	relogin := c.token != ""
+	if c.token == "" && !c.basic && c.Username != "" {
+		if err := c.login(); err != nil {
+			return err
+		}
+	}
+	resp, err := c.send(method, path, data, true)
+	if IsStatus(err, http.StatusUnauthorized) && relogin {
+		// 会话超时后重新登录
+		if err = c.login(); err != nil {
+			return err
+		}
+		resp, err = c.send(method, path, data, true)
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if v == nil {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// send 发送请求，非2xx状态返回StatusError。
+func (c *Client) send(method, path string, body []byte, auth bool) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, c.Endpoint+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if auth {
+		if c.basic {
+			req.SetBasicAuth(c.Username, c.Password)
+		} else if c.token != "" {
+			req.Header.Set(authTokenHeader, c.token)
+		}
+	}
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+		return nil, &StatusError{Method: method, Path: path, StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(data))}
+	}
+	return resp, nil
+}
+
+// ODataID 资源链接
+type ODataID struct {
+	ID string `json:"@odata.id"`
+}
+
+// Collection 资源集合
+type Collection struct {
+	Members []ODataID `json:"Members"`
+}
+
+// Members 返回资源集合中的成员路径
+func (c *Client) Members(path string) ([]string, error) {
+	var coll Collection
+	if err := c.Get(path, &coll); err != nil {
+		return nil, err
+	}
+	items := make([]string, 0, len(coll.Members))
+	for _, m := range coll.Members {
+		items = append(items, m.ID)
+	}
+	return items, nil
+}
+
+// probeTimeout 探测Redfish服务的超时时间
+const probeTimeout = 10 * time.Second
+
+// Probe 以匿名方式获取服务根，返回BMC支持的Redfish版本。httpClient为nil时使用默认客户端及探测超时时间。
+func Probe(endpoint string, httpClient *http.Client) (version string, err error) {
+	c := NewClient(endpoint, "", "")
+	if c.HTTPClient = httpClient; httpClient == nil {
+		client := *defaultHTTPClient
+		client.Timeout = probeTimeout
+		c.HTTPClient = &client
+	}
+	var root struct {
+		RedfishVersion string `json:"RedfishVersion"`
+	}
+	if err = c.Get(ServiceRoot, &root); err != nil {
+		return "", err
+	}
+	if root.RedfishVersion == "" {
+		return "", fmt.Errorf("redfish: %s is not a redfish service root", c.Endpoint+ServiceRoot)
+	}
+	return root.RedfishVersion, nil
+}
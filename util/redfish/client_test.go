@@ -0,0 +1,81 @@
+package redfish
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClient(t *testing.T) {
+	Convey("Redfish客户端", t, func() {
+		var logins int
+		var deleted []string
+		token := ""
+		sessionService := true
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == sessionsPath && r.Method == http.MethodPost:
+				if !sessionService {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				logins++
+				token = fmt.Sprintf("token-%d", logins)
+				w.Header().Set(authTokenHeader, token)
+				w.Header().Set("Location", "http://"+r.Host+sessionsPath+"/1")
+				w.WriteHeader(http.StatusCreated)
+			case r.Method == http.MethodDelete:
+				deleted = append(deleted, r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			case r.Header.Get(authTokenHeader) == token && token != "":
+				_, _ = w.Write([]byte(`{"Members":[{"@odata.id":"/redfish/v1/Systems/1"}]}`))
+			default:
+				if user, pass, ok := r.BasicAuth(); ok && user == "root" && pass == "calvin" {
+					_, _ = w.Write([]byte(`{"Members":[]}`))
+					return
+				}
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}))
+		defer srv.Close()
+
+		c := NewClient(srv.URL, "root", "calvin")
+
+		Convey("首次请求时登录", func() {
+			members, err := c.Members("/redfish/v1/Systems")
+			So(err, ShouldBeNil)
+			So(members, ShouldResemble, []string{"/redfish/v1/Systems/1"})
+			So(logins, ShouldEqual, 1)
+
+			// Location为绝对URL时仅删除会话路径
+			So(c.Logout(), ShouldBeNil)
+			So(deleted, ShouldResemble, []string{sessionsPath + "/1"})
+			So(c.Logout(), ShouldBeNil)
+			So(len(deleted), ShouldEqual, 1)
+		})
+
+		Convey("会话失效后重新登录", func() {
+			So(c.Login(), ShouldBeNil)
+			token = "expired"
+			_, err := c.Members("/redfish/v1/Systems")
+			So(err, ShouldBeNil)
+			So(logins, ShouldEqual, 2)
+		})
+
+		Convey("不支持会话服务时使用Basic认证", func() {
+			sessionService = false
+			members, err := c.Members("/redfish/v1/Systems")
+			So(err, ShouldBeNil)
+			So(members, ShouldBeEmpty)
+		})
+
+		Convey("非成功状态", func() {
+			c := NewClient(srv.URL, "", "")
+			err := c.Get("/redfish/v1/Systems", nil)
+			So(IsStatus(err, http.StatusUnauthorized), ShouldBeTrue)
+		})
+	})
+}
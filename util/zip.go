@@ -2,20 +2,22 @@ package util
 
 import (
 	"archive/zip"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 func UnZip(dst, src string) (err error) {
 	// 打开压缩文件，这个 zip 包有个方便的 ReadCloser 类型
 	// 这个里面有个方便的 OpenReader 函数，可以比 tar 的时候省去一个打开文件的步骤
 	zr, err := zip.OpenReader(src)
-	defer zr.Close()
 	if err != nil {
 		return
 	}
+	defer zr.Close()
 
 	// 如果解压后不是放在当前目录就按照保存目录去创建目录
 	if dst != "" {
@@ -26,7 +28,10 @@ func UnZip(dst, src string) (err error) {
 
 	// 遍历 zr ，将文件写入到磁盘
 	for _, file := range zr.File {
-		path := filepath.Join(dst, file.Name)
+		path, err := safeJoin(dst, file.Name)
+		if err != nil {
+			return err
+		}
 
 		// 如果是目录，就创建目录
 		if file.FileInfo().IsDir() {
@@ -43,6 +48,10 @@ func UnZip(dst, src string) (err error) {
 			return err
 		}
 
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+
 		// 创建要写出的文件对应的 Write
 		fw, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_TRUNC, file.Mode())
 		if err != nil {
@@ -62,3 +71,19 @@ func UnZip(dst, src string) (err error) {
 	}
 	return nil
 }
+
+// ErrIllegalZipEntry 压缩文件中存在路径越出解压目录的条目（zip slip）
+var ErrIllegalZipEntry = errors.New("illegal zip entry")
+
+// safeJoin 返回压缩文件条目的解压路径。若条目路径越出解压目录，则返回ErrIllegalZipEntry。
+func safeJoin(dst, name string) (string, error) {
+	if filepath.IsAbs(name) || strings.HasPrefix(name, "/") || strings.Contains(name, "\\") {
+		return "", fmt.Errorf("%w: %s", ErrIllegalZipEntry, name)
+	}
+	path := filepath.Join(dst, name)
+	rel, err := filepath.Rel(filepath.Join(dst, "."), path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%w: %s", ErrIllegalZipEntry, name)
+	}
+	return path, nil
+}
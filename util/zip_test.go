@@ -0,0 +1,61 @@
+package util
+
+import (
+	"archive/zip"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// writeZip 生成包含指定条目的压缩文件
+func writeZip(name string, entries map[string]string) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for entry, content := range entries {
+		w, err := zw.Create(entry)
+		if err != nil {
+			return err
+		}
+		if _, err = w.Write([]byte(content)); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+func TestUnZip(t *testing.T) {
+	Convey("解压缩", t, func() {
+		dir, err := ioutil.TempDir("", "unzip")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		dst := filepath.Join(dir, "dst")
+
+		Convey("解压至目标目录", func() {
+			src := filepath.Join(dir, "ok.zip")
+			So(writeZip(src, map[string]string{"bin/provider": "hello"}), ShouldBeNil)
+			So(UnZip(dst, src), ShouldBeNil)
+			data, err := ioutil.ReadFile(filepath.Join(dst, "bin", "provider"))
+			So(err, ShouldBeNil)
+			So(string(data), ShouldEqual, "hello")
+		})
+
+		Convey("拒绝越出目标目录的条目", func() {
+			for _, entry := range []string{"../evil", "bin/../../evil", "/etc/evil"} {
+				src := filepath.Join(dir, "evil.zip")
+				So(writeZip(src, map[string]string{entry: "hello"}), ShouldBeNil)
+				So(errors.Is(UnZip(dst, src), ErrIllegalZipEntry), ShouldBeTrue)
+			}
+			_, err := os.Stat(filepath.Join(dir, "evil"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,359 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+)
+
+const (
+	// StatusPending 步骤状态-待执行
+	StatusPending = "pending"
+	// StatusRunning 步骤状态-执行中
+	StatusRunning = "running"
+	// StatusSucceeded 步骤状态-成功
+	StatusSucceeded = "succeeded"
+	// StatusFailed 步骤状态-失败
+	StatusFailed = "failed"
+	// StatusSkipped 步骤状态-因依赖的步骤未成功而跳过
+	StatusSkipped = "skipped"
+)
+
+var (
+	// ErrRebootRequired 步骤执行成功，但需重启设备后才能继续执行后续步骤。
+	ErrRebootRequired = errors.New("reboot required")
+	// ErrStepFailed 存在执行失败的步骤
+	ErrStepFailed = errors.New("step failed")
+	// ErrCyclicDependency 步骤间存在循环依赖
+	ErrCyclicDependency = errors.New("cyclic step dependency")
+)
+
+// StopGrace 步骤超时后等待其返回的时间
+var StopGrace = 10 * time.Second
+
+// Step 工作流步骤
+type Step struct {
+	// Name 步骤名称，在工作流内唯一。
+	Name string
+	// DependsOn 依赖的步骤。所依赖的步骤均成功后方执行当前步骤。
+	DependsOn []string
+	// Retries 失败后的重试次数
+	Retries int
+	// RetryInterval 重试间隔
+	RetryInterval time.Duration
+	// Timeout 单次执行的超时时间，为0时不限制。
+	Timeout time.Duration
+	// Always 每次运行工作流均执行，即便此前已执行成功。用于恢复自检结果、插件连接等进程内状态。
+	Always bool
+	// Run 步骤实现。超时后Run所绑定的ctx将被取消，Run应尽快返回；
+	// 取消后StopGrace内仍未返回的步骤不再重试，以免与仍在执行的上一次执行并发。
+	// 返回ErrRebootRequired时，步骤被视为成功，工作流保存状态后停止，待重启后继续执行。
+	Run func(ctx context.Context) error
+}
+
+// StepState 持久化的步骤状态
+type StepState struct {
+	Status     string    `json:"status"`
+	Attempts   int       `json:"attempts"`
+	Error      string    `json:"error,omitempty"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+// State 持久化的工作流状态
+type State struct {
+	Steps     map[string]*StepState `json:"steps"`
+	UpdatedAt time.Time             `json:"updated_at"`
+}
+
+// Result 单个步骤在本次运行中的执行结果
+type Result struct {
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+	Resumed  bool   `json:"resumed"` // 此前已执行成功，本次运行未执行。
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+// Summary 工作流运行汇总
+type Summary struct {
+	Steps []*Result `json:"steps"`
+}
+
+// Failed 返回执行失败的步骤名称
+func (s *Summary) Failed() []string {
+	var names []string
+	for _, r := range s.Steps {
+		if r.Status == StatusFailed {
+			names = append(names, r.Name)
+		}
+	}
+	return names
+}
+
+// Engine 可在设备重启后从上次完成的步骤处继续执行的工作流引擎。
+// 每个步骤执行前后均将状态保存至StateFile。
+type Engine struct {
+	StateFile string
+	Log       util.Logger
+	steps     []*Step
+	index     map[string]*Step
+}
+
+// New 返回以stateFile保存状态的工作流引擎
+func New(stateFile string) *Engine {
+	return &Engine{StateFile: stateFile, index: make(map[string]*Step)}
+}
+
+// Register 按声明顺序注册步骤。无依赖关系的步骤按注册顺序执行。
+func (e *Engine) Register(steps ...*Step) error {
+	for _, s := range steps {
+		if s.Name == "" || s.Run == nil {
+			return util.NewInvalidOptionError("step", s.Name)
+		}
+		if _, ok := e.index[s.Name]; ok {
+			return util.NewInvalidOptionError("step", s.Name)
+		}
+		e.index[s.Name] = s
+		e.steps = append(e.steps, s)
+	}
+	return nil
+}
+
+// order 返回满足依赖关系的执行顺序。依赖关系不影响的步骤保持注册顺序。
+func (e *Engine) order() ([]*Step, error) {
+	for _, s := range e.steps {
+		for _, dep := range s.DependsOn {
+			if _, ok := e.index[dep]; !ok {
+				return nil, util.NewInvalidOptionError(s.Name+".depends_on", dep)
+			}
+		}
+	}
+	done := make(map[string]bool, len(e.steps))
+	ordered := make([]*Step, 0, len(e.steps))
+	for len(ordered) < len(e.steps) {
+		var progressed bool
+		for _, s := range e.steps {
+			if done[s.Name] || !allDone(done, s.DependsOn) {
+				continue
+			}
+			done[s.Name], progressed = true, true
+			ordered = append(ordered, s)
+			break
+		}
+		if !progressed {
+			var pending []string
+			for _, s := range e.steps {
+				if !done[s.Name] {
+					pending = append(pending, s.Name)
+				}
+			}
+			return nil, fmt.Errorf("%w: %s", ErrCyclicDependency, strings.Join(pending, ","))
+		}
+	}
+	return ordered, nil
+}
+
+func allDone(done map[string]bool, names []string) bool {
+	for _, name := range names {
+		if !done[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadState 读取工作流状态文件。文件不存在时返回空状态。
+func LoadState(file string) (*State, error) {
+	state := State{Steps: make(map[string]*StepState)}
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return &state, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	if state.Steps == nil {
+		state.Steps = make(map[string]*StepState)
+	}
+	return &state, nil
+}
+
+// save 将工作流状态写入临时文件后重命名，避免重启时留下不完整的状态文件。
+func (e *Engine) save(state *State) error {
+	state.UpdatedAt = time.Now()
+	data, err := json.MarshalIndent(state, "", "    ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(e.StateFile), 0755); err != nil {
+		return err
+	}
+	tmp := e.StateFile + ".tmp"
+	if err = ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, e.StateFile)
+}
+
+// Reset 清除工作流状态，下次运行时将从头执行全部步骤。
+func (e *Engine) Reset() error {
+	if err := os.Remove(e.StateFile); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Run 运行工作流。此前已成功的步骤（Always步骤除外）不再执行；所依赖的步骤未成功的步骤将被跳过。
+// 存在失败步骤时返回包装了ErrStepFailed的错误；步骤要求重启时返回ErrRebootRequired，后续步骤待重启后执行。
+func (e *Engine) Run(ctx context.Context) (*Summary, error) {
+	steps, err := e.order()
+	if err != nil {
+		return nil, err
+	}
+	state, err := LoadState(e.StateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary Summary
+	for _, s := range steps {
+		st, ok := state.Steps[s.Name]
+		if !ok {
+			st = &StepState{Status: StatusPending}
+			state.Steps[s.Name] = st
+		}
+		if st.Status == StatusSucceeded && !s.Always {
+			summary.Steps = append(summary.Steps, &Result{Name: s.Name, Status: st.Status, Attempts: st.Attempts, Resumed: true})
+			continue
+		}
+		if failed := e.unsatisfied(state, s); failed != "" {
+			*st = StepState{Status: StatusSkipped, Error: fmt.Sprintf("dependency %s not succeeded", failed)}
+			summary.Steps = append(summary.Steps, &Result{Name: s.Name, Status: st.Status, Error: st.Error})
+			if err = e.save(state); err != nil {
+				return &summary, err
+			}
+			continue
+		}
+
+		*st = StepState{Status: StatusRunning, StartedAt: time.Now()}
+		if err = e.save(state); err != nil {
+			return &summary, err
+		}
+		e.infof("==> step %s", s.Name)
+		stepErr := e.runStep(ctx, s, st)
+		st.FinishedAt = time.Now()
+		st.Status, st.Error = StatusSucceeded, ""
+		if stepErr != nil && !errors.Is(stepErr, ErrRebootRequired) {
+			st.Status, st.Error = StatusFailed, stepErr.Error()
+			e.errorf("step %s failed after %d attempt(s): %s", s.Name, st.Attempts, stepErr)
+		}
+		summary.Steps = append(summary.Steps, &Result{
+			Name:     s.Name,
+			Status:   st.Status,
+			Attempts: st.Attempts,
+			Duration: st.FinishedAt.Sub(st.StartedAt).Round(time.Millisecond).String(),
+			Error:    st.Error,
+		})
+		if err = e.save(state); err != nil {
+			return &summary, err
+		}
+		if errors.Is(stepErr, ErrRebootRequired) {
+			return &summary, ErrRebootRequired
+		}
+		if ctx.Err() != nil {
+			return &summary, ctx.Err()
+		}
+	}
+	if failed := summary.Failed(); len(failed) > 0 {
+		return &summary, fmt.Errorf("%w: %s", ErrStepFailed, strings.Join(failed, ","))
+	}
+	return &summary, nil
+}
+
+// unsatisfied 返回首个未成功的依赖步骤名称，依赖均已成功时返回空字符串。
+func (e *Engine) unsatisfied(state *State, s *Step) string {
+	for _, dep := range s.DependsOn {
+		if st, ok := state.Steps[dep]; !ok || st.Status != StatusSucceeded {
+			return dep
+		}
+	}
+	return ""
+}
+
+// runStep 执行步骤，失败后按步骤配置重试。
+func (e *Engine) runStep(ctx context.Context, s *Step, st *StepState) (err error) {
+	for {
+		st.Attempts++
+		var running bool
+		if running, err = attempt(ctx, s); err == nil || errors.Is(err, ErrRebootRequired) || st.Attempts > s.Retries {
+			return err
+		}
+		if running {
+			e.errorf("step %s attempt %d still running after %s, not retrying", s.Name, st.Attempts, err)
+			return err
+		}
+		e.errorf("step %s attempt %d: %s", s.Name, st.Attempts, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(s.RetryInterval):
+		}
+	}
+}
+
+// attempt 执行一次步骤。步骤panic时返回错误。
+// 超时或ctx被取消后最多等待StopGrace，步骤仍未返回时running为true。
+func attempt(ctx context.Context, s *Step) (running bool, err error) {
+	if s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout)
+		defer cancel()
+	}
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if re := recover(); re != nil {
+				done <- fmt.Errorf("panic: %v", re)
+			}
+		}()
+		done <- s.Run(ctx)
+	}()
+	select {
+	case err = <-done:
+		return false, err
+	case <-ctx.Done():
+	}
+	grace := time.NewTimer(StopGrace)
+	defer grace.Stop()
+	select {
+	case <-done:
+		return false, ctx.Err()
+	case <-grace.C:
+		return true, ctx.Err()
+	}
+}
+
+func (e *Engine) infof(format string, v ...interface{}) {
+	if e.Log != nil {
+		e.Log.Infof(format, v...)
+	}
+}
+
+func (e *Engine) errorf(format string, v ...interface{}) {
+	if e.Log != nil {
+		e.Log.Errorf(format, v...)
+	}
+}
@@ -0,0 +1,170 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/licairong/cloudboot-provider-framework/util"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// recorder 记录步骤执行顺序
+type recorder struct {
+	calls []string
+}
+
+func (r *recorder) step(name string, deps ...string) *Step {
+	return &Step{Name: name, DependsOn: deps, Run: func(ctx context.Context) error {
+		r.calls = append(r.calls, name)
+		return nil
+	}}
+}
+
+func TestEngine(t *testing.T) {
+	Convey("工作流引擎", t, func() {
+		dir, err := ioutil.TempDir("", "workflow")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(dir)
+		file := filepath.Join(dir, "state", "workflow.json")
+		var r recorder
+
+		Convey("按依赖关系执行", func() {
+			e := New(file)
+			So(e.Register(r.step("collect", "load"), r.step("selfcheck"), r.step("load", "selfcheck")), ShouldBeNil)
+			summary, err := e.Run(context.Background())
+			So(err, ShouldBeNil)
+			So(r.calls, ShouldResemble, []string{"selfcheck", "load", "collect"})
+			So(summary.Failed(), ShouldBeEmpty)
+
+			state, err := LoadState(file)
+			So(err, ShouldBeNil)
+			So(state.Steps["collect"].Status, ShouldEqual, StatusSucceeded)
+		})
+
+		Convey("非法依赖", func() {
+			e := New(file)
+			So(e.Register(r.step("a", "b"), r.step("b", "a")), ShouldBeNil)
+			_, err := e.Run(context.Background())
+			So(errors.Is(err, ErrCyclicDependency), ShouldBeTrue)
+
+			e = New(file)
+			So(e.Register(r.step("a", "missing")), ShouldBeNil)
+			_, err = e.Run(context.Background())
+			So(util.IsInvalidOptionError(err), ShouldBeTrue)
+
+			So(e.Register(r.step("a")), ShouldNotBeNil)
+		})
+
+		Convey("重启后从上次完成的步骤处继续执行", func() {
+			rebooted := false
+			steps := func() []*Step {
+				return []*Step{
+					{Name: "selfcheck", Always: true, Run: r.step("selfcheck").Run},
+					{Name: "raid", DependsOn: []string{"selfcheck"}, Run: func(ctx context.Context) error {
+						r.calls = append(r.calls, "raid")
+						if !rebooted {
+							rebooted = true
+							return ErrRebootRequired
+						}
+						return nil
+					}},
+					r.step("report", "raid"),
+				}
+			}
+
+			e := New(file)
+			So(e.Register(steps()...), ShouldBeNil)
+			_, err := e.Run(context.Background())
+			So(err, ShouldEqual, ErrRebootRequired)
+			So(r.calls, ShouldResemble, []string{"selfcheck", "raid"})
+
+			e = New(file)
+			So(e.Register(steps()...), ShouldBeNil)
+			summary, err := e.Run(context.Background())
+			So(err, ShouldBeNil)
+			So(r.calls, ShouldResemble, []string{"selfcheck", "raid", "selfcheck", "report"})
+			So(summary.Steps[1].Resumed, ShouldBeTrue)
+
+			Convey("重置后从头执行", func() {
+				So(e.Reset(), ShouldBeNil)
+				_, err = e.Run(context.Background())
+				So(err, ShouldBeNil)
+				So(r.calls[4:], ShouldResemble, []string{"selfcheck", "raid", "report"})
+			})
+		})
+
+		Convey("失败重试并跳过依赖的步骤", func() {
+			var attempts int
+			e := New(file)
+			So(e.Register(
+				&Step{Name: "install", Retries: 2, Run: func(ctx context.Context) error {
+					attempts++
+					return errors.New("network unreachable")
+				}},
+				r.step("collect", "install"),
+				r.step("selfcheck"),
+			), ShouldBeNil)
+			summary, err := e.Run(context.Background())
+			So(errors.Is(err, ErrStepFailed), ShouldBeTrue)
+			So(attempts, ShouldEqual, 3)
+			So(r.calls, ShouldResemble, []string{"selfcheck"})
+			So(summary.Failed(), ShouldResemble, []string{"install"})
+			So(summary.Steps[0].Error, ShouldEqual, "network unreachable")
+			So(summary.Steps[1].Status, ShouldEqual, StatusSkipped)
+		})
+
+		Convey("单次执行超时", func() {
+			e := New(file)
+			So(e.Register(&Step{Name: "apply", Timeout: 50 * time.Millisecond, Run: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}}), ShouldBeNil)
+			summary, err := e.Run(context.Background())
+			So(errors.Is(err, ErrStepFailed), ShouldBeTrue)
+			So(summary.Steps[0].Error, ShouldEqual, context.DeadlineExceeded.Error())
+		})
+
+		Convey("超时的步骤返回后方重试", func() {
+			defer func(grace time.Duration) { StopGrace = grace }(StopGrace)
+			StopGrace = 50 * time.Millisecond
+
+			var attempts int32
+			release := make(chan struct{})
+			defer close(release)
+			e := New(file)
+			So(e.Register(
+				&Step{Name: "load-provider", Retries: 2, Timeout: 10 * time.Millisecond, Run: func(ctx context.Context) error {
+					atomic.AddInt32(&attempts, 1)
+					<-ctx.Done()
+					return ctx.Err()
+				}},
+				&Step{Name: "install", Retries: 2, Timeout: 10 * time.Millisecond, Run: func(ctx context.Context) error {
+					atomic.AddInt32(&attempts, 10)
+					<-release // 忽略ctx
+					return nil
+				}},
+			), ShouldBeNil)
+			summary, err := e.Run(context.Background())
+			So(errors.Is(err, ErrStepFailed), ShouldBeTrue)
+			So(summary.Steps[0].Attempts, ShouldEqual, 3)
+			So(summary.Steps[1].Attempts, ShouldEqual, 1)
+			So(atomic.LoadInt32(&attempts), ShouldEqual, 13)
+		})
+
+		Convey("步骤panic", func() {
+			e := New(file)
+			So(e.Register(&Step{Name: "collect", Run: func(ctx context.Context) error {
+				panic("nil pointer")
+			}}), ShouldBeNil)
+			summary, err := e.Run(context.Background())
+			So(errors.Is(err, ErrStepFailed), ShouldBeTrue)
+			So(summary.Steps[0].Error, ShouldEqual, "panic: nil pointer")
+		})
+	})
+}